@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"flag"
 	"log/slog"
 	"net/http"
 	"os"
@@ -14,6 +15,9 @@ import (
 )
 
 func main() {
+	seedOnly := flag.Bool("seed", false, "seed the database with development sample data and exit")
+	flag.Parse()
+
 	// Setup structured logging
 	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
 		Level: slog.LevelInfo,
@@ -26,6 +30,10 @@ func main() {
 		logger.Error("failed to load config", "error", err)
 		os.Exit(1)
 	}
+	if err := cfg.Validate(); err != nil {
+		logger.Error("invalid config", "error", err)
+		os.Exit(1)
+	}
 
 	logger.Info("starting server",
 		"port", cfg.Server.Port,
@@ -40,16 +48,19 @@ func main() {
 	}
 	defer router.Close()
 
+	if *seedOnly {
+		if err := router.Seed(context.Background()); err != nil {
+			logger.Error("failed to seed database", "error", err)
+			router.Close()
+			os.Exit(1)
+		}
+		return
+	}
+
 	handler := router.Setup()
 
 	// Create server
-	server := &http.Server{
-		Addr:         ":" + cfg.Server.Port,
-		Handler:      handler,
-		ReadTimeout:  15 * time.Second,
-		WriteTimeout: 15 * time.Second,
-		IdleTimeout:  60 * time.Second,
-	}
+	server := newServer(cfg.Server, handler)
 
 	// Start server in goroutine
 	go func() {
@@ -77,3 +88,16 @@ func main() {
 
 	logger.Info("server stopped")
 }
+
+// newServer builds the HTTP server from configuration. Requests whose headers
+// exceed MaxHeaderBytes are rejected by net/http with 431.
+func newServer(serverConfig config.ServerConfig, handler http.Handler) *http.Server {
+	return &http.Server{
+		Addr:           ":" + serverConfig.Port,
+		Handler:        handler,
+		ReadTimeout:    15 * time.Second,
+		WriteTimeout:   15 * time.Second,
+		IdleTimeout:    60 * time.Second,
+		MaxHeaderBytes: serverConfig.MaxHeaderBytes,
+	}
+}
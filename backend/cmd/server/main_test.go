@@ -0,0 +1,57 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/alexlee0213/realworld-conduit/backend/internal/config"
+)
+
+func TestNewServer(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	t.Run("sets MaxHeaderBytes from config", func(t *testing.T) {
+		server := newServer(config.ServerConfig{Port: "8080", MaxHeaderBytes: 4096}, ok)
+
+		if server.MaxHeaderBytes != 4096 {
+			t.Errorf("expected MaxHeaderBytes 4096, got %d", server.MaxHeaderBytes)
+		}
+		if server.Addr != ":8080" {
+			t.Errorf("expected addr :8080, got %s", server.Addr)
+		}
+	})
+
+	t.Run("rejects requests with oversized headers", func(t *testing.T) {
+		ts := httptest.NewUnstartedServer(ok)
+		ts.Config = newServer(config.ServerConfig{MaxHeaderBytes: 1024}, ok)
+		ts.Start()
+		defer ts.Close()
+
+		req, _ := http.NewRequest(http.MethodGet, ts.URL, nil)
+		req.Header.Set("X-Large", strings.Repeat("a", 8192))
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusRequestHeaderFieldsTooLarge {
+			t.Errorf("expected status %d, got %d", http.StatusRequestHeaderFieldsTooLarge, resp.StatusCode)
+		}
+
+		req, _ = http.NewRequest(http.MethodGet, ts.URL, nil)
+		resp, err = http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("expected status %d for small headers, got %d", http.StatusOK, resp.StatusCode)
+		}
+	})
+}
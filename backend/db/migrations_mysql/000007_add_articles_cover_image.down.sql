@@ -0,0 +1,2 @@
+-- Rollback: Drop cover image column
+ALTER TABLE articles DROP COLUMN cover_image;
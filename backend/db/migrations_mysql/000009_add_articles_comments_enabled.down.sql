@@ -0,0 +1,2 @@
+-- Rollback: Drop comments_enabled column
+ALTER TABLE articles DROP COLUMN comments_enabled;
@@ -0,0 +1,7 @@
+-- Slug redirects table: Old article slugs that now resolve to a renamed article
+CREATE TABLE IF NOT EXISTS slug_redirects (
+    old_slug VARCHAR(255) PRIMARY KEY,
+    article_id BIGINT NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,10 @@
+-- Article revisions table: Previous versions of an article, one per update
+CREATE TABLE IF NOT EXISTS article_revisions (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    article_id BIGINT NOT NULL,
+    title TEXT NOT NULL,
+    description TEXT NOT NULL,
+    body MEDIUMTEXT NOT NULL,
+    updated_at DATETIME(6) NOT NULL,
+    FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
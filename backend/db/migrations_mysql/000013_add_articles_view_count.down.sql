@@ -0,0 +1,2 @@
+-- Rollback: Drop view_count column
+ALTER TABLE articles DROP COLUMN view_count;
@@ -0,0 +1,2 @@
+-- View counter: incremented when a non-author reads the article (opt-in)
+ALTER TABLE articles ADD COLUMN view_count INTEGER NOT NULL DEFAULT 0;
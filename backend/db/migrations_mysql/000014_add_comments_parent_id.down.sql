@@ -0,0 +1,3 @@
+-- Rollback: Drop parent_id column and its foreign key
+ALTER TABLE comments DROP FOREIGN KEY fk_comments_parent_id;
+ALTER TABLE comments DROP COLUMN parent_id;
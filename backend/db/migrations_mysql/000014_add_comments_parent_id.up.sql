@@ -0,0 +1,4 @@
+-- Threaded replies: the comment this one replies to (NULL for top-level comments)
+ALTER TABLE comments
+    ADD COLUMN parent_id BIGINT NULL,
+    ADD CONSTRAINT fk_comments_parent_id FOREIGN KEY (parent_id) REFERENCES comments(id) ON DELETE SET NULL;
@@ -0,0 +1,2 @@
+-- Rollback: Drop comment reports table
+DROP TABLE IF EXISTS comment_reports;
@@ -0,0 +1,12 @@
+-- Comment reports table: Users flagging abusive comments, one report per user per comment
+CREATE TABLE IF NOT EXISTS comment_reports (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    comment_id BIGINT NOT NULL,
+    reporter_id BIGINT NOT NULL,
+    reason TEXT NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    UNIQUE (comment_id, reporter_id),
+    FOREIGN KEY (comment_id) REFERENCES comments(id) ON DELETE CASCADE,
+    FOREIGN KEY (reporter_id) REFERENCES users(id) ON DELETE CASCADE,
+    INDEX idx_comment_reports_created_at (created_at DESC)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,2 @@
+-- Rollback: Drop refresh tokens table
+DROP TABLE IF EXISTS refresh_tokens;
@@ -0,0 +1,2 @@
+-- Rollback: Drop password resets table
+DROP TABLE IF EXISTS password_resets;
@@ -0,0 +1,9 @@
+-- Revoked tokens table: Blocklist of access token IDs (the jti claim)
+-- Rows are only needed until the token would have expired anyway, so expired
+-- entries are purged periodically
+CREATE TABLE IF NOT EXISTS revoked_tokens (
+    jti VARCHAR(255) PRIMARY KEY,
+    expires_at DATETIME(6) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    INDEX idx_revoked_tokens_expires_at (expires_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,2 @@
+-- Rollback: Nothing to undo (emails stay lowercased)
+SELECT 1;
@@ -0,0 +1,5 @@
+-- Normalize user emails: Emails are compared case-insensitively
+-- Existing addresses are trimmed and lowercased to match what the API now
+-- stores. The column's case-insensitive collation already keeps case variants
+-- out of the unique index, so no extra index is needed here.
+UPDATE users SET email = LOWER(TRIM(email)) WHERE BINARY email <> BINARY LOWER(TRIM(email));
@@ -0,0 +1,2 @@
+-- Rollback: Drop blocks table
+DROP TABLE IF EXISTS blocks;
@@ -0,0 +1,14 @@
+-- Blocks table: Users that a user has blocked
+-- A blocked user's articles are hidden from the blocker's listings, and the
+-- blocked user can't follow the blocker. MySQL rejects CHECK constraints on
+-- columns with cascading foreign keys, so self-blocks are prevented by the
+-- service alone.
+CREATE TABLE IF NOT EXISTS blocks (
+    blocker_id BIGINT NOT NULL,
+    blocked_id BIGINT NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (blocker_id, blocked_id),
+    FOREIGN KEY (blocker_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (blocked_id) REFERENCES users(id) ON DELETE CASCADE,
+    INDEX idx_blocks_blocked_id (blocked_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
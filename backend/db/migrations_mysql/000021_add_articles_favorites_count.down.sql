@@ -0,0 +1,2 @@
+-- Rollback: Drop favorites_count column and index
+ALTER TABLE articles DROP INDEX idx_articles_favorites_count, DROP COLUMN favorites_count;
@@ -0,0 +1,2 @@
+-- Rollback: Drop cover image column
+ALTER TABLE articles DROP COLUMN IF EXISTS cover_image;
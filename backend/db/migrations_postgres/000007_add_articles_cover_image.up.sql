@@ -0,0 +1,2 @@
+-- Cover image: explicitly set or extracted from the first image in the body
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS cover_image TEXT NOT NULL DEFAULT '';
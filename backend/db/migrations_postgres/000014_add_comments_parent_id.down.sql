@@ -0,0 +1,3 @@
+-- Rollback: Drop parent_id column and index
+DROP INDEX IF EXISTS idx_comments_parent_id;
+ALTER TABLE comments DROP COLUMN IF EXISTS parent_id;
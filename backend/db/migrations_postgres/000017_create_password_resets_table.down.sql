@@ -0,0 +1,3 @@
+-- Rollback: Drop password resets table and index
+DROP INDEX IF EXISTS idx_password_resets_user_id;
+DROP TABLE IF EXISTS password_resets;
@@ -0,0 +1,3 @@
+-- Rollback: Drop revoked tokens table and index
+DROP INDEX IF EXISTS idx_revoked_tokens_expires_at;
+DROP TABLE IF EXISTS revoked_tokens;
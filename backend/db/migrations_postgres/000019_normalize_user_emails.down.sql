@@ -0,0 +1,2 @@
+-- Rollback: Drop the case-insensitive email index (emails stay lowercased)
+DROP INDEX IF EXISTS idx_users_email_lower;
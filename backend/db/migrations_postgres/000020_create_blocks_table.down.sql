@@ -0,0 +1,3 @@
+-- Rollback: Drop blocks table and index
+DROP INDEX IF EXISTS idx_blocks_blocked_id;
+DROP TABLE IF EXISTS blocks;
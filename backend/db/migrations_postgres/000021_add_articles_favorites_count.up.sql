@@ -0,0 +1,7 @@
+-- Denormalized favorites count, maintained alongside favorites inserts/deletes
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS favorites_count INTEGER NOT NULL DEFAULT 0;
+
+-- Backfill from existing favorites
+UPDATE articles SET favorites_count = (SELECT COUNT(*) FROM favorites f WHERE f.article_id = articles.id);
+
+CREATE INDEX IF NOT EXISTS idx_articles_favorites_count ON articles(favorites_count);
@@ -0,0 +1,2 @@
+-- Rollback: Drop published column
+ALTER TABLE articles DROP COLUMN published;
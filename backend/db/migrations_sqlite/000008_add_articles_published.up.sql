@@ -0,0 +1,2 @@
+-- Published state: drafts (published = 0) may have an empty description/body
+ALTER TABLE articles ADD COLUMN published BOOLEAN NOT NULL DEFAULT 1;
@@ -0,0 +1,2 @@
+-- Per-article comment toggle controlled by the author
+ALTER TABLE articles ADD COLUMN comments_enabled BOOLEAN NOT NULL DEFAULT 1;
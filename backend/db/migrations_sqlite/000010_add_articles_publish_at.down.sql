@@ -0,0 +1,2 @@
+-- Rollback: Drop publish_at column
+ALTER TABLE articles DROP COLUMN publish_at;
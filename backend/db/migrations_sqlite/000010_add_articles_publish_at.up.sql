@@ -0,0 +1,2 @@
+-- Scheduled publishing: hidden from non-authors until publish_at (NULL = immediately)
+ALTER TABLE articles ADD COLUMN publish_at TIMESTAMP;
@@ -0,0 +1,3 @@
+-- Rollback: Drop slug redirects table and index
+DROP INDEX IF EXISTS idx_slug_redirects_article_id;
+DROP TABLE IF EXISTS slug_redirects;
@@ -0,0 +1,9 @@
+-- Slug redirects table: Old article slugs that now resolve to a renamed article
+CREATE TABLE IF NOT EXISTS slug_redirects (
+    old_slug TEXT PRIMARY KEY,
+    article_id INTEGER NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_slug_redirects_article_id ON slug_redirects(article_id);
@@ -0,0 +1,3 @@
+-- Rollback: Drop article revisions table and index
+DROP INDEX IF EXISTS idx_article_revisions_article_id;
+DROP TABLE IF EXISTS article_revisions;
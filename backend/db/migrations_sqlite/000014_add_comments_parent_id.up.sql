@@ -0,0 +1,4 @@
+-- Threaded replies: the comment this one replies to (NULL for top-level comments)
+ALTER TABLE comments ADD COLUMN parent_id INTEGER REFERENCES comments(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_comments_parent_id ON comments(parent_id);
@@ -0,0 +1,3 @@
+-- Rollback: Drop comment reports table and index
+DROP INDEX IF EXISTS idx_comment_reports_created_at;
+DROP TABLE IF EXISTS comment_reports;
@@ -0,0 +1,10 @@
+-- Revoked tokens table: Blocklist of access token IDs (the jti claim)
+-- Rows are only needed until the token would have expired anyway, so expired
+-- entries are purged periodically
+CREATE TABLE IF NOT EXISTS revoked_tokens (
+    jti TEXT PRIMARY KEY,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_revoked_tokens_expires_at ON revoked_tokens(expires_at);
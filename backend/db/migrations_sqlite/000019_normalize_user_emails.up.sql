@@ -0,0 +1,10 @@
+-- Normalize user emails: Emails are compared case-insensitively
+-- Existing addresses are trimmed and lowercased to match what the API now
+-- stores, and a unique index on LOWER(email) keeps case variants out.
+-- NOTE: If two existing accounts differ only by email case this migration
+-- fails on the unique constraint. Merge or change one of the accounts, e.g.
+--   SELECT LOWER(email), COUNT(*) FROM users GROUP BY LOWER(email) HAVING COUNT(*) > 1;
+-- and then rerun it.
+UPDATE users SET email = LOWER(TRIM(email)) WHERE email <> LOWER(TRIM(email));
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email_lower ON users(LOWER(email));
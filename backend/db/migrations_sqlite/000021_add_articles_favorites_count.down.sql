@@ -0,0 +1,3 @@
+-- Rollback: Drop favorites_count column and index
+DROP INDEX IF EXISTS idx_articles_favorites_count;
+ALTER TABLE articles DROP COLUMN favorites_count;
@@ -4,17 +4,30 @@ import (
 	"encoding/json"
 	"log/slog"
 	"net/http"
+	"net/url"
+	"path"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
+	"github.com/alexlee0213/realworld-conduit/backend/internal/feed"
+	"github.com/alexlee0213/realworld-conduit/backend/internal/markdown"
 	"github.com/alexlee0213/realworld-conduit/backend/internal/service"
+	"github.com/alexlee0213/realworld-conduit/backend/internal/timefmt"
 )
 
 // ArticleHandler handles article-related HTTP requests
 type ArticleHandler struct {
 	articleService *service.ArticleService
 	logger         *slog.Logger
+
+	// deleteReturnsBody answers deletes with 200 and a JSON body instead of 204
+	deleteReturnsBody bool
+	// wordsPerMinute is the reading speed behind readingTime
+	wordsPerMinute int
+	// publicURL is the site's base URL for feed links (empty uses the request host)
+	publicURL string
 }
 
 // NewArticleHandler creates a new ArticleHandler instance
@@ -22,25 +35,57 @@ func NewArticleHandler(articleService *service.ArticleService, logger *slog.Logg
 	return &ArticleHandler{
 		articleService: articleService,
 		logger:         logger,
+		wordsPerMinute: domain.DefaultWordsPerMinute,
 	}
 }
 
+// SetDeleteReturnsBody makes delete endpoints return 200 with a JSON
+// confirmation instead of 204 No Content
+func (h *ArticleHandler) SetDeleteReturnsBody(enabled bool) {
+	h.deleteReturnsBody = enabled
+}
+
+// SetWordsPerMinute sets the reading speed used to compute readingTime
+func (h *ArticleHandler) SetWordsPerMinute(wordsPerMinute int) {
+	h.wordsPerMinute = wordsPerMinute
+}
+
+// SetPublicURL sets the public base URL used for links in feeds
+func (h *ArticleHandler) SetPublicURL(publicURL string) {
+	h.publicURL = strings.TrimRight(publicURL, "/")
+}
+
 // CreateArticleRequest represents the create article request body
 type CreateArticleRequest struct {
 	Article struct {
-		Title       string   `json:"title"`
-		Description string   `json:"description"`
-		Body        string   `json:"body"`
-		TagList     []string `json:"tagList,omitempty"`
+		Title       string     `json:"title"`
+		Slug        string     `json:"slug,omitempty"`
+		Description string     `json:"description"`
+		Body        string     `json:"body"`
+		CoverImage  string     `json:"coverImage,omitempty"`
+		TagList     []string   `json:"tagList,omitempty"`
+		Published   *bool      `json:"published,omitempty"`
+		PublishAt   *time.Time `json:"publishAt,omitempty"`
 	} `json:"article"`
 }
 
 // UpdateArticleRequest represents the update article request body
 type UpdateArticleRequest struct {
 	Article struct {
-		Title       *string `json:"title,omitempty"`
-		Description *string `json:"description,omitempty"`
-		Body        *string `json:"body,omitempty"`
+		Title          *string    `json:"title,omitempty"`
+		RegenerateSlug *bool      `json:"regenerateSlug,omitempty"`
+		Description    *string    `json:"description,omitempty"`
+		Body           *string    `json:"body,omitempty"`
+		CoverImage     *string    `json:"coverImage,omitempty"`
+		Published      *bool      `json:"published,omitempty"`
+		PublishAt      *time.Time `json:"publishAt,omitempty"`
+	} `json:"article"`
+}
+
+// CommentsSettingRequest represents the comments setting request body
+type CommentsSettingRequest struct {
+	Article struct {
+		CommentsEnabled *bool `json:"commentsEnabled"`
 	} `json:"article"`
 }
 
@@ -53,20 +98,29 @@ type ArticleResponse struct {
 type ArticlesResponse struct {
 	Articles      []ArticleResponseBody `json:"articles"`
 	ArticlesCount int                   `json:"articlesCount"`
+	NextCursor    string                `json:"nextCursor,omitempty"`
 }
 
 // ArticleResponseBody represents the article data in responses
 type ArticleResponseBody struct {
-	Slug           string              `json:"slug"`
-	Title          string              `json:"title"`
-	Description    string              `json:"description"`
-	Body           string              `json:"body"`
-	TagList        []string            `json:"tagList"`
-	CreatedAt      string              `json:"createdAt"`
-	UpdatedAt      string              `json:"updatedAt"`
-	Favorited      bool                `json:"favorited"`
-	FavoritesCount int                 `json:"favoritesCount"`
-	Author         ProfileResponseBody `json:"author"`
+	Slug            string              `json:"slug"`
+	Title           string              `json:"title"`
+	Description     string              `json:"description"`
+	Body            string              `json:"body"`
+	BodyHTML        string              `json:"bodyHtml"`
+	ReadingTime     int                 `json:"readingTime"`
+	CoverImage      string              `json:"coverImage"`
+	TagList         []string            `json:"tagList"`
+	CreatedAt       string              `json:"createdAt"`
+	UpdatedAt       string              `json:"updatedAt"`
+	Favorited       bool                `json:"favorited"`
+	FavoritesCount  int                 `json:"favoritesCount"`
+	ViewCount       int                 `json:"viewCount"`
+	CommentsCount   int                 `json:"commentsCount"`
+	CommentsEnabled bool                `json:"commentsEnabled"`
+	Published       bool                `json:"published"`
+	PublishAt       *string             `json:"publishAt"`
+	Author          ProfileResponseBody `json:"author"`
 }
 
 // ProfileResponseBody represents the author profile in article responses
@@ -77,11 +131,26 @@ type ProfileResponseBody struct {
 	Following bool   `json:"following"`
 }
 
+// ArticleStatsResponse represents the article stats response
+type ArticleStatsResponse struct {
+	Stats *domain.ArticleStats `json:"stats"`
+}
+
+// ArticleRevisionsResponse represents the article revisions list response
+type ArticleRevisionsResponse struct {
+	Revisions []*domain.ArticleRevision `json:"revisions"`
+}
+
 // TagsResponse represents the tags list response
 type TagsResponse struct {
 	Tags []string `json:"tags"`
 }
 
+// PopularTagsResponse represents the popular tags response
+type PopularTagsResponse struct {
+	Tags []domain.TagCount `json:"tags"`
+}
+
 // CreateArticle handles POST /api/articles
 func (h *ArticleHandler) CreateArticle(w http.ResponseWriter, r *http.Request) {
 	userID, ok := r.Context().Value(UserIDContextKey).(int64)
@@ -93,15 +162,20 @@ func (h *ArticleHandler) CreateArticle(w http.ResponseWriter, r *http.Request) {
 	var req CreateArticleRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.logger.Debug("failed to decode create article request", "error", err)
-		h.writeError(w, http.StatusUnprocessableEntity, "body", "invalid request body")
+		status, message := decodeErrorResponse(err)
+		h.writeError(w, status, "body", message)
 		return
 	}
 
 	input := &domain.CreateArticleInput{
 		Title:       req.Article.Title,
+		Slug:        strings.TrimSpace(req.Article.Slug),
 		Description: req.Article.Description,
 		Body:        req.Article.Body,
+		CoverImage:  req.Article.CoverImage,
 		TagList:     req.Article.TagList,
+		Published:   req.Article.Published,
+		PublishAt:   req.Article.PublishAt,
 	}
 
 	article, err := h.articleService.CreateArticle(r.Context(), userID, input)
@@ -114,13 +188,19 @@ func (h *ArticleHandler) CreateArticle(w http.ResponseWriter, r *http.Request) {
 }
 
 // GetArticle handles GET /api/articles/{slug}
+// Supports ?fields= to return only selected fields
 func (h *ArticleHandler) GetArticle(w http.ResponseWriter, r *http.Request) {
-	slug := h.extractSlugFromPath(r.URL.Path, "/api/articles/")
+	slug := r.PathValue("slug")
 	if slug == "" {
 		h.writeError(w, http.StatusNotFound, "article", "article not found")
 		return
 	}
 
+	fields, ok := h.parseFieldsParam(w, r)
+	if !ok {
+		return
+	}
+
 	// Get optional current user ID for favorited status
 	var currentUserID *int64
 	if userID, ok := r.Context().Value(UserIDContextKey).(int64); ok {
@@ -128,11 +208,32 @@ func (h *ArticleHandler) GetArticle(w http.ResponseWriter, r *http.Request) {
 	}
 
 	article, err := h.articleService.GetArticleBySlug(r.Context(), slug, currentUserID)
+	if err == domain.ErrArticleNotFound {
+		// The article may have been renamed; send old links to its new slug,
+		// keeping whichever prefix (/api or the versioned one) was requested
+		if newSlug, redirectErr := h.articleService.ResolveSlugRedirect(r.Context(), slug); redirectErr == nil {
+			location := path.Dir(r.URL.Path) + "/" + url.PathEscape(newSlug)
+			if r.URL.RawQuery != "" {
+				location += "?" + r.URL.RawQuery
+			}
+			http.Redirect(w, r, location, http.StatusMovedPermanently)
+			return
+		}
+	}
 	if err != nil {
 		h.handleServiceError(w, err)
 		return
 	}
 
+	if fields != nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]any{
+			"article": selectArticleFields(h.toArticleResponseBody(article), fields),
+		})
+		return
+	}
+
 	h.writeArticleResponse(w, http.StatusOK, article)
 }
 
@@ -144,7 +245,7 @@ func (h *ArticleHandler) UpdateArticle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	slug := h.extractSlugFromPath(r.URL.Path, "/api/articles/")
+	slug := r.PathValue("slug")
 	if slug == "" {
 		h.writeError(w, http.StatusNotFound, "article", "article not found")
 		return
@@ -153,14 +254,19 @@ func (h *ArticleHandler) UpdateArticle(w http.ResponseWriter, r *http.Request) {
 	var req UpdateArticleRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.logger.Debug("failed to decode update article request", "error", err)
-		h.writeError(w, http.StatusUnprocessableEntity, "body", "invalid request body")
+		status, message := decodeErrorResponse(err)
+		h.writeError(w, status, "body", message)
 		return
 	}
 
 	input := &domain.UpdateArticleInput{
-		Title:       req.Article.Title,
-		Description: req.Article.Description,
-		Body:        req.Article.Body,
+		Title:          req.Article.Title,
+		RegenerateSlug: req.Article.RegenerateSlug,
+		Description:    req.Article.Description,
+		Body:           req.Article.Body,
+		CoverImage:     req.Article.CoverImage,
+		Published:      req.Article.Published,
+		PublishAt:      req.Article.PublishAt,
 	}
 
 	article, err := h.articleService.UpdateArticle(r.Context(), slug, userID, input)
@@ -180,7 +286,7 @@ func (h *ArticleHandler) DeleteArticle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	slug := h.extractSlugFromPath(r.URL.Path, "/api/articles/")
+	slug := r.PathValue("slug")
 	if slug == "" {
 		h.writeError(w, http.StatusNotFound, "article", "article not found")
 		return
@@ -192,11 +298,19 @@ func (h *ArticleHandler) DeleteArticle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	w.WriteHeader(http.StatusNoContent)
+	writeDeleted(w, r, h.deleteReturnsBody, DeleteResponseBody{Type: "article", Slug: slug})
 }
 
 // ListArticles handles GET /api/articles
+// Pages with ?limit=&offset= or, for large lists, with the keyset cursor
+// ?before=<createdAt>,<id> taken from a previous response's nextCursor.
+// When both are given, before takes precedence and offset is ignored.
 func (h *ArticleHandler) ListArticles(w http.ResponseWriter, r *http.Request) {
+	fields, ok := h.parseFieldsParam(w, r)
+	if !ok {
+		return
+	}
+
 	// Get optional current user ID for favorited status
 	var currentUserID *int64
 	if userID, ok := r.Context().Value(UserIDContextKey).(int64); ok {
@@ -208,8 +322,20 @@ func (h *ArticleHandler) ListArticles(w http.ResponseWriter, r *http.Request) {
 		Tag:       r.URL.Query().Get("tag"),
 		Author:    r.URL.Query().Get("author"),
 		Favorited: r.URL.Query().Get("favorited"),
-		Limit:     h.parseIntParam(r.URL.Query().Get("limit"), 20),
-		Offset:    h.parseIntParam(r.URL.Query().Get("offset"), 0),
+		Query:     strings.TrimSpace(r.URL.Query().Get("q")),
+		Sort:      domain.ParseArticleSort(r.URL.Query().Get("sort")),
+		// Drafts are only ever the requester's own (see the repository)
+		IncludeDrafts: r.URL.Query().Get("includeDrafts") == "true",
+		Limit:         h.parseIntParam(r.URL.Query().Get("limit"), 20),
+		Offset:        h.parseIntParam(r.URL.Query().Get("offset"), 0),
+	}
+	if before := r.URL.Query().Get("before"); before != "" {
+		cursor, err := domain.ParseArticleCursor(before)
+		if err != nil {
+			h.writeError(w, http.StatusUnprocessableEntity, "before", "is not a valid cursor")
+			return
+		}
+		params.Before = cursor
 	}
 
 	articles, total, err := h.articleService.ListArticles(r.Context(), params, currentUserID)
@@ -218,7 +344,16 @@ func (h *ArticleHandler) ListArticles(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	h.writeArticlesResponse(w, http.StatusOK, articles, total)
+	// A full newest-first page may have more after it
+	nextCursor := ""
+	if params.Sort == domain.ArticleSortNewest && len(articles) > 0 && len(articles) == params.Limit {
+		nextCursor = domain.CursorFor(articles[len(articles)-1]).String()
+	}
+	if params.Before == nil {
+		setPaginationLinks(w, r, params.Limit, params.Offset, total)
+	}
+
+	h.writeArticlesResponse(w, http.StatusOK, articles, total, nextCursor, fields)
 }
 
 // GetFeed handles GET /api/articles/feed
@@ -229,6 +364,11 @@ func (h *ArticleHandler) GetFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	fields, ok := h.parseFieldsParam(w, r)
+	if !ok {
+		return
+	}
+
 	// Parse query parameters
 	params := &domain.ArticleFeedParams{
 		Limit:  h.parseIntParam(r.URL.Query().Get("limit"), 20),
@@ -241,7 +381,137 @@ func (h *ArticleHandler) GetFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	h.writeArticlesResponse(w, http.StatusOK, articles, total)
+	setPaginationLinks(w, r, params.Limit, params.Offset, total)
+
+	h.writeArticlesResponse(w, http.StatusOK, articles, total, "", fields)
+}
+
+// GetFriendsFavorites handles GET /api/articles/friends-favorites
+func (h *ArticleHandler) GetFriendsFavorites(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(UserIDContextKey).(int64)
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "token", "authorization required")
+		return
+	}
+
+	fields, ok := h.parseFieldsParam(w, r)
+	if !ok {
+		return
+	}
+
+	// Parse query parameters
+	params := &domain.ArticleFeedParams{
+		Limit:  h.parseIntParam(r.URL.Query().Get("limit"), 20),
+		Offset: h.parseIntParam(r.URL.Query().Get("offset"), 0),
+	}
+
+	articles, total, err := h.articleService.GetFriendsFavorites(r.Context(), userID, params)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeArticlesResponse(w, http.StatusOK, articles, total, "", fields)
+}
+
+// GetRelatedArticles handles GET /api/articles/{slug}/related
+// Supports ?limit= (default 5)
+func (h *ArticleHandler) GetRelatedArticles(w http.ResponseWriter, r *http.Request) {
+	fields, ok := h.parseFieldsParam(w, r)
+	if !ok {
+		return
+	}
+
+	var currentUserID *int64
+	if userID, ok := r.Context().Value(UserIDContextKey).(int64); ok {
+		currentUserID = &userID
+	}
+
+	limit := h.parseIntParam(r.URL.Query().Get("limit"), service.DefaultRelatedArticlesLimit)
+	articles, err := h.articleService.GetRelatedArticles(r.Context(), r.PathValue("slug"), limit, currentUserID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeArticlesResponse(w, http.StatusOK, articles, len(articles), "", fields)
+}
+
+// ListFavoritedArticles handles GET /api/profiles/{username}/favorites
+// Query params: limit (default 20), offset (default 0)
+func (h *ArticleHandler) ListFavoritedArticles(w http.ResponseWriter, r *http.Request) {
+	fields, ok := h.parseFieldsParam(w, r)
+	if !ok {
+		return
+	}
+
+	// Get optional current user ID for favorited status
+	var currentUserID *int64
+	if userID, ok := r.Context().Value(UserIDContextKey).(int64); ok {
+		currentUserID = &userID
+	}
+
+	limit := h.parseIntParam(r.URL.Query().Get("limit"), 20)
+	offset := h.parseIntParam(r.URL.Query().Get("offset"), 0)
+
+	articles, total, err := h.articleService.ListFavoritedArticles(r.Context(), r.PathValue("username"), currentUserID, limit, offset)
+	if err == domain.ErrUserNotFound {
+		h.writeError(w, http.StatusNotFound, "profile", "profile not found")
+		return
+	}
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	setPaginationLinks(w, r, limit, offset, total)
+
+	h.writeArticlesResponse(w, http.StatusOK, articles, total, "", fields)
+}
+
+// authorFeedLimit is the number of articles in an author's RSS feed
+const authorFeedLimit = 20
+
+// GetAuthorFeed handles GET /api/profiles/{username}/feed.rss
+func (h *ArticleHandler) GetAuthorFeed(w http.ResponseWriter, r *http.Request) {
+	articles, author, err := h.articleService.ListAuthorArticles(r.Context(), r.PathValue("username"), authorFeedLimit)
+	if err == domain.ErrUserNotFound {
+		h.writeError(w, http.StatusNotFound, "profile", "profile not found")
+		return
+	}
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	baseURL := h.baseURL(r)
+	out, err := feed.RSS(feed.Channel{
+		Title:       author.Username + " on Conduit",
+		Link:        baseURL + "/profile/" + url.PathEscape(author.Username),
+		Description: "Latest articles by " + author.Username,
+	}, articles, func(article *domain.Article) string {
+		return baseURL + "/article/" + url.PathEscape(article.Slug)
+	})
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", feed.ContentTypeRSS)
+	w.WriteHeader(http.StatusOK)
+	w.Write(out)
+}
+
+// baseURL returns the configured public URL, or one derived from the request
+func (h *ArticleHandler) baseURL(r *http.Request) string {
+	if h.publicURL != "" {
+		return h.publicURL
+	}
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host
 }
 
 // GetTags handles GET /api/tags
@@ -258,6 +528,38 @@ func (h *ArticleHandler) GetTags(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(resp)
 }
 
+// SearchTags handles GET /api/tags/search
+// Query params: prefix (tag names starting with it, case-insensitive)
+func (h *ArticleHandler) SearchTags(w http.ResponseWriter, r *http.Request) {
+	tags, err := h.articleService.SearchTags(r.Context(), r.URL.Query().Get("prefix"))
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	resp := TagsResponse{Tags: tags}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// GetPopularTags handles GET /api/tags/popular
+// Query params: limit (default 20)
+func (h *ArticleHandler) GetPopularTags(w http.ResponseWriter, r *http.Request) {
+	limit := h.parseIntParam(r.URL.Query().Get("limit"), 20)
+
+	tags, err := h.articleService.GetPopularTags(r.Context(), limit)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	resp := PopularTagsResponse{Tags: tags}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(resp)
+}
+
 // FavoriteArticle handles POST /api/articles/{slug}/favorite
 func (h *ArticleHandler) FavoriteArticle(w http.ResponseWriter, r *http.Request) {
 	userID, ok := r.Context().Value(UserIDContextKey).(int64)
@@ -266,7 +568,7 @@ func (h *ArticleHandler) FavoriteArticle(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	slug := h.extractSlugForFavorite(r.URL.Path)
+	slug := r.PathValue("slug")
 	if slug == "" {
 		h.writeError(w, http.StatusNotFound, "article", "article not found")
 		return
@@ -289,7 +591,7 @@ func (h *ArticleHandler) UnfavoriteArticle(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	slug := h.extractSlugForFavorite(r.URL.Path)
+	slug := r.PathValue("slug")
 	if slug == "" {
 		h.writeError(w, http.StatusNotFound, "article", "article not found")
 		return
@@ -304,24 +606,133 @@ func (h *ArticleHandler) UnfavoriteArticle(w http.ResponseWriter, r *http.Reques
 	h.writeArticleResponse(w, http.StatusOK, article)
 }
 
-// extractSlugForFavorite extracts the slug from favorite endpoint paths
-// Path format: /api/articles/{slug}/favorite
-func (h *ArticleHandler) extractSlugForFavorite(path string) string {
-	// Remove prefix: /api/articles/
-	path = strings.TrimPrefix(path, "/api/articles/")
-	// Remove suffix: /favorite
-	path = strings.TrimSuffix(path, "/favorite")
-	return strings.TrimSpace(path)
+// GetArticleStats handles GET /api/articles/{slug}/stats
+func (h *ArticleHandler) GetArticleStats(w http.ResponseWriter, r *http.Request) {
+	var currentUserID *int64
+	if userID, ok := r.Context().Value(UserIDContextKey).(int64); ok {
+		currentUserID = &userID
+	}
+
+	stats, err := h.articleService.GetArticleStats(r.Context(), r.PathValue("slug"), currentUserID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(ArticleStatsResponse{Stats: stats})
+}
+
+// RecomputeCountsResponse reports the counters a recompute corrected
+type RecomputeCountsResponse struct {
+	Recomputation *domain.CountsRecomputation `json:"recomputation"`
+}
+
+// RecomputeCounts handles POST /api/admin/recompute-counts
+// Restricted to maintainers
+func (h *ArticleHandler) RecomputeCounts(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(UserIDContextKey).(int64)
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "token", "authorization required")
+		return
+	}
+
+	result, err := h.articleService.RecomputeCounts(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(RecomputeCountsResponse{Recomputation: result})
+}
+
+// ListFavoritingUsers handles GET /api/articles/{slug}/favorited-by
+// Query params: limit (default 20), offset (default 0)
+func (h *ArticleHandler) ListFavoritingUsers(w http.ResponseWriter, r *http.Request) {
+	var currentUserID *int64
+	if userID, ok := r.Context().Value(UserIDContextKey).(int64); ok {
+		currentUserID = &userID
+	}
+
+	limit := h.parseIntParam(r.URL.Query().Get("limit"), 20)
+	offset := h.parseIntParam(r.URL.Query().Get("offset"), 0)
+
+	profiles, total, err := h.articleService.ListFavoritingUsers(r.Context(), r.PathValue("slug"), currentUserID, limit, offset)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	setPaginationLinks(w, r, limit, offset, total)
+	writeProfilesResponse(w, profiles, total)
+}
+
+// GetArticleRevisions handles GET /api/articles/{slug}/revisions
+func (h *ArticleHandler) GetArticleRevisions(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(UserIDContextKey).(int64)
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "token", "authorization required")
+		return
+	}
+
+	revisions, err := h.articleService.ListRevisions(r.Context(), r.PathValue("slug"), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(ArticleRevisionsResponse{Revisions: revisions})
 }
 
-// extractSlugFromPath extracts the slug from the URL path
-func (h *ArticleHandler) extractSlugFromPath(path, prefix string) string {
-	slug := strings.TrimPrefix(path, prefix)
-	// Remove any trailing slashes or additional path segments
-	if idx := strings.Index(slug, "/"); idx != -1 {
-		slug = slug[:idx]
+// UpdateCommentsSetting handles PUT /api/articles/{slug}/comments-setting
+func (h *ArticleHandler) UpdateCommentsSetting(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(UserIDContextKey).(int64)
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "token", "authorization required")
+		return
 	}
-	return strings.TrimSpace(slug)
+
+	slug := r.PathValue("slug")
+	if slug == "" {
+		h.writeError(w, http.StatusNotFound, "article", "article not found")
+		return
+	}
+
+	var req CommentsSettingRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Debug("failed to decode comments setting request", "error", err)
+		status, message := decodeErrorResponse(err)
+		h.writeError(w, status, "body", message)
+		return
+	}
+	if req.Article.CommentsEnabled == nil {
+		h.writeError(w, http.StatusUnprocessableEntity, "commentsEnabled", "can't be blank")
+		return
+	}
+
+	article, err := h.articleService.SetCommentsEnabled(r.Context(), slug, userID, *req.Article.CommentsEnabled)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeArticleResponse(w, http.StatusOK, article)
+}
+
+// parseFieldsParam parses ?fields=, writing a 422 and returning false if it
+// names unknown fields
+func (h *ArticleHandler) parseFieldsParam(w http.ResponseWriter, r *http.Request) ([]string, bool) {
+	fields, unknown := parseArticleFields(r.URL.Query().Get("fields"))
+	if len(unknown) > 0 {
+		h.writeError(w, http.StatusUnprocessableEntity, "fields", "unknown fields: "+strings.Join(unknown, ", "))
+		return nil, false
+	}
+	return fields, true
 }
 
 // parseIntParam parses an integer query parameter with a default value
@@ -347,8 +758,29 @@ func (h *ArticleHandler) writeArticleResponse(w http.ResponseWriter, status int,
 	json.NewEncoder(w).Encode(resp)
 }
 
-// writeArticlesResponse writes a list of articles response
-func (h *ArticleHandler) writeArticlesResponse(w http.ResponseWriter, status int, articles []*domain.Article, total int) {
+// writeArticlesResponse writes a list of articles response, limited to the
+// selected fields when fields is non-nil
+func (h *ArticleHandler) writeArticlesResponse(w http.ResponseWriter, status int, articles []*domain.Article, total int, nextCursor string, fields []string) {
+	if fields != nil {
+		selected := make([]map[string]any, 0, len(articles))
+		for _, article := range articles {
+			selected = append(selected, selectArticleFields(h.toArticleResponseBody(article), fields))
+		}
+
+		resp := map[string]any{
+			"articles":      selected,
+			"articlesCount": total,
+		}
+		if nextCursor != "" {
+			resp["nextCursor"] = nextCursor
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(resp)
+		return
+	}
+
 	articleBodies := make([]ArticleResponseBody, 0, len(articles))
 	for _, article := range articles {
 		articleBodies = append(articleBodies, h.toArticleResponseBody(article))
@@ -357,6 +789,7 @@ func (h *ArticleHandler) writeArticlesResponse(w http.ResponseWriter, status int
 	resp := ArticlesResponse{
 		Articles:      articleBodies,
 		ArticlesCount: total,
+		NextCursor:    nextCursor,
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -372,15 +805,26 @@ func (h *ArticleHandler) toArticleResponseBody(article *domain.Article) ArticleR
 	}
 
 	body := ArticleResponseBody{
-		Slug:           article.Slug,
-		Title:          article.Title,
-		Description:    article.Description,
-		Body:           article.Body,
-		TagList:        tagList,
-		CreatedAt:      article.CreatedAt.UTC().Format("2006-01-02T15:04:05.000Z"),
-		UpdatedAt:      article.UpdatedAt.UTC().Format("2006-01-02T15:04:05.000Z"),
-		Favorited:      article.Favorited,
-		FavoritesCount: article.FavoritesCount,
+		Slug:            article.Slug,
+		Title:           article.Title,
+		Description:     article.Description,
+		Body:            article.Body,
+		BodyHTML:        markdown.Render(article.Body),
+		ReadingTime:     domain.ReadingTime(article.Body, h.wordsPerMinute),
+		CoverImage:      article.CoverImage,
+		TagList:         tagList,
+		CreatedAt:       timefmt.FormatRFC3339Millis(article.CreatedAt),
+		UpdatedAt:       timefmt.FormatRFC3339Millis(article.UpdatedAt),
+		Favorited:       article.Favorited,
+		FavoritesCount:  article.FavoritesCount,
+		ViewCount:       article.ViewCount,
+		CommentsCount:   article.CommentsCount,
+		CommentsEnabled: article.CommentsEnabled,
+		Published:       article.Published,
+	}
+	if article.PublishAt != nil {
+		publishAt := timefmt.FormatRFC3339Millis(*article.PublishAt)
+		body.PublishAt = &publishAt
 	}
 
 	// Add author profile if available
@@ -389,7 +833,7 @@ func (h *ArticleHandler) toArticleResponseBody(article *domain.Article) ArticleR
 			Username:  article.Author.Username,
 			Bio:       article.Author.Bio,
 			Image:     article.Author.Image,
-			Following: false, // TODO: Implement following status
+			Following: article.AuthorFollowing,
 		}
 	}
 
@@ -429,6 +873,8 @@ func (h *ArticleHandler) handleServiceError(w http.ResponseWriter, err error) {
 			h.writeError(w, http.StatusNotFound, "article", "article not found")
 		} else if err == domain.ErrArticleAlreadyExists {
 			h.writeError(w, http.StatusUnprocessableEntity, "slug", "has already been taken")
+		} else if err == domain.ErrAccountTooNew {
+			h.writeError(w, http.StatusForbidden, "account_too_new", "your account is too new to post")
 		} else if err == domain.ErrForbidden {
 			h.writeError(w, http.StatusForbidden, "article", "you are not authorized to perform this action")
 		} else if err == domain.ErrUnauthorized {
@@ -5,10 +5,13 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"encoding/xml"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -33,7 +36,10 @@ func setupArticleTestDB(t *testing.T) *sql.DB {
 	db.Exec("DROP TABLE IF EXISTS tags")
 	db.Exec("DROP TABLE IF EXISTS favorites")
 	db.Exec("DROP TABLE IF EXISTS articles")
+	db.Exec("DROP TABLE IF EXISTS blocks")
 	db.Exec("DROP TABLE IF EXISTS follows")
+	db.Exec("DROP TABLE IF EXISTS refresh_tokens")
+	db.Exec("DROP TABLE IF EXISTS revoked_tokens")
 	db.Exec("DROP TABLE IF EXISTS users")
 
 	// Create all required tables
@@ -57,8 +63,13 @@ func setupArticleTestDB(t *testing.T) *sql.DB {
 			title TEXT NOT NULL,
 			description TEXT NOT NULL,
 			body TEXT NOT NULL,
+			cover_image TEXT NOT NULL DEFAULT '',
+			published BOOLEAN NOT NULL DEFAULT 1,
+			comments_enabled BOOLEAN NOT NULL DEFAULT 1,
+			publish_at TIMESTAMP,
+			view_count INTEGER NOT NULL DEFAULT 0,
 			author_id INTEGER NOT NULL,
-			favorites_count INTEGER DEFAULT 0,
+			favorites_count INTEGER NOT NULL DEFAULT 0,
 			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
 			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
 			FOREIGN KEY (author_id) REFERENCES users(id) ON DELETE CASCADE
@@ -90,19 +101,85 @@ func setupArticleTestDB(t *testing.T) *sql.DB {
 			FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
 		);
 
+		CREATE TABLE slug_redirects (
+			old_slug TEXT PRIMARY KEY,
+			article_id INTEGER NOT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+		);
+
+		CREATE TABLE article_revisions (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			article_id INTEGER NOT NULL,
+			title TEXT NOT NULL,
+			description TEXT NOT NULL,
+			body TEXT NOT NULL,
+			updated_at TIMESTAMP NOT NULL,
+			FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+		);
+
 		CREATE TABLE follows (
 			follower_id INTEGER NOT NULL,
-			followed_id INTEGER NOT NULL,
+			following_id INTEGER NOT NULL,
 			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
-			PRIMARY KEY (follower_id, followed_id),
+			PRIMARY KEY (follower_id, following_id),
 			FOREIGN KEY (follower_id) REFERENCES users(id) ON DELETE CASCADE,
-			FOREIGN KEY (followed_id) REFERENCES users(id) ON DELETE CASCADE
+			FOREIGN KEY (following_id) REFERENCES users(id) ON DELETE CASCADE
+		);
+
+		CREATE TABLE blocks (
+			blocker_id INTEGER NOT NULL,
+			blocked_id INTEGER NOT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (blocker_id, blocked_id),
+			FOREIGN KEY (blocker_id) REFERENCES users(id) ON DELETE CASCADE,
+			FOREIGN KEY (blocked_id) REFERENCES users(id) ON DELETE CASCADE
+		);
+
+		CREATE TABLE comments (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			body TEXT NOT NULL,
+			article_id INTEGER NOT NULL,
+			author_id INTEGER NOT NULL,
+			parent_id INTEGER REFERENCES comments(id) ON DELETE SET NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE,
+			FOREIGN KEY (author_id) REFERENCES users(id) ON DELETE CASCADE
 		);
 	`)
 	if err != nil {
 		t.Fatalf("failed to create tables: %v", err)
 	}
 
+	// Create refresh tokens table
+	_, err = db.Exec(`
+		CREATE TABLE refresh_tokens (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			user_id INTEGER NOT NULL,
+			token_hash TEXT NOT NULL UNIQUE,
+			expires_at TIMESTAMP NOT NULL,
+			revoked_at TIMESTAMP,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+		)
+	`)
+	if err != nil {
+		t.Fatalf("failed to create refresh_tokens table: %v", err)
+	}
+
+	// Create revoked tokens table
+	_, err = db.Exec(`
+		CREATE TABLE revoked_tokens (
+			jti TEXT PRIMARY KEY,
+			expires_at TIMESTAMP NOT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)
+	`)
+	if err != nil {
+		t.Fatalf("failed to create revoked_tokens table: %v", err)
+	}
+
 	return db
 }
 
@@ -112,10 +189,23 @@ func newArticleTestLogger() *slog.Logger {
 	}))
 }
 
+// withPathValues fills in req's path values by matching it against pattern,
+// as the router's ServeMux does before calling a handler
+func withPathValues(pattern string, req *http.Request) *http.Request {
+	routed := req
+	mux := http.NewServeMux()
+	mux.HandleFunc(pattern, func(_ http.ResponseWriter, r *http.Request) {
+		routed = r
+	})
+	mux.ServeHTTP(httptest.NewRecorder(), req)
+	return routed
+}
+
 type articleTestSetup struct {
 	handler        *ArticleHandler
 	articleService *service.ArticleService
 	authService    *service.AuthService
+	followRepo     repository.FollowRepository
 	db             *sql.DB
 }
 
@@ -125,14 +215,21 @@ func newTestArticleHandler(t *testing.T) *articleTestSetup {
 	logger := newArticleTestLogger()
 	userRepo := repository.NewSQLiteUserRepository(db, logger)
 	articleRepo := repository.NewSQLiteArticleRepository(db, logger)
-	authService := service.NewAuthService(userRepo, "test-jwt-secret", 24*time.Hour, logger)
-	articleService := service.NewArticleService(articleRepo, userRepo, logger)
+	followRepo := repository.NewSQLiteFollowRepository(db, logger)
+	refreshTokenRepo := repository.NewSQLiteRefreshTokenRepository(db, logger)
+	passwordResetRepo := repository.NewSQLitePasswordResetRepository(db, logger)
+	revokedTokenRepo := repository.NewSQLiteRevokedTokenRepository(db, logger)
+	authService := service.NewAuthService(userRepo, refreshTokenRepo, passwordResetRepo, revokedTokenRepo, "test-jwt-secret", 24*time.Hour, logger)
+	commentRepo := repository.NewSQLiteCommentRepository(db, logger)
+	favoriteRepo := repository.NewSQLiteFavoriteRepository(db, logger)
+	articleService := service.NewArticleService(articleRepo, userRepo, followRepo, commentRepo, favoriteRepo, logger)
 	articleHandler := NewArticleHandler(articleService, logger)
 
 	return &articleTestSetup{
 		handler:        articleHandler,
 		articleService: articleService,
 		authService:    authService,
+		followRepo:     followRepo,
 		db:             db,
 	}
 }
@@ -141,7 +238,7 @@ func newTestArticleHandler(t *testing.T) *articleTestSetup {
 func createTestUser(t *testing.T, setup *articleTestSetup, email, username, password string) (*domain.User, string) {
 	t.Helper()
 	ctx := context.Background()
-	user, token, err := setup.authService.Register(ctx, &domain.CreateUserInput{
+	user, tokens, err := setup.authService.Register(ctx, &domain.CreateUserInput{
 		Email:    email,
 		Username: username,
 		Password: password,
@@ -149,7 +246,7 @@ func createTestUser(t *testing.T, setup *articleTestSetup, email, username, pass
 	if err != nil {
 		t.Fatalf("failed to create test user: %v", err)
 	}
-	return user, token
+	return user, tokens.AccessToken
 }
 
 // Helper to create a test article
@@ -168,6 +265,15 @@ func createTestArticle(t *testing.T, setup *articleTestSetup, userID int64, titl
 	return article
 }
 
+// Helper to add a comment to a test article
+func createArticleTestComment(t *testing.T, setup *articleTestSetup, articleID, authorID int64) {
+	t.Helper()
+	_, err := setup.db.Exec(`INSERT INTO comments (body, article_id, author_id) VALUES ('Comment', ?, ?)`, articleID, authorID)
+	if err != nil {
+		t.Fatalf("failed to create test comment: %v", err)
+	}
+}
+
 // =============================================================================
 // TDD: POST /api/articles (Create Article) Tests
 // =============================================================================
@@ -285,7 +391,7 @@ func TestGetArticleHandler(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/api/articles/"+article.Slug, nil)
 		w := httptest.NewRecorder()
 
-		setup.handler.GetArticle(w, req)
+		setup.handler.GetArticle(w, withPathValues("/api/articles/{slug}", req))
 
 		if w.Code != http.StatusOK {
 			t.Errorf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
@@ -306,6 +412,102 @@ func TestGetArticleHandler(t *testing.T) {
 		}
 	})
 
+	t.Run("renders bodyHtml and keeps the raw body", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		user, _ := createTestUser(t, setup, "author@example.com", "author", "password123")
+		body := "Hello **world** <script>alert(1)</script>"
+		article := createTestArticle(t, setup, user.ID, "Rendered", "Test description", body, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/"+article.Slug, nil)
+		w := httptest.NewRecorder()
+
+		setup.handler.GetArticle(w, withPathValues("/api/articles/{slug}", req))
+
+		var response ArticleResponse
+		if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.Article.Body != body {
+			t.Errorf("expected raw body %q, got %q", body, response.Article.Body)
+		}
+		want := "<p>Hello <strong>world</strong> alert(1)</p>\n"
+		if response.Article.BodyHTML != want {
+			t.Errorf("expected bodyHtml %q, got %q", want, response.Article.BodyHTML)
+		}
+	})
+
+	t.Run("reports following for an author the viewer follows", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		author, _ := createTestUser(t, setup, "author@example.com", "author", "password123")
+		viewer, _ := createTestUser(t, setup, "viewer@example.com", "viewer", "password123")
+		article := createTestArticle(t, setup, author.ID, "Test Article", "Test description", "Test body", nil)
+		if err := setup.followRepo.FollowUser(context.Background(), viewer.ID, author.ID); err != nil {
+			t.Fatalf("failed to follow author: %v", err)
+		}
+
+		get := func(ctx context.Context) ArticleResponse {
+			req := httptest.NewRequest(http.MethodGet, "/api/articles/"+article.Slug, nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+			setup.handler.GetArticle(w, withPathValues("/api/articles/{slug}", req))
+
+			var response ArticleResponse
+			if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			return response
+		}
+
+		if !get(context.WithValue(context.Background(), UserIDContextKey, viewer.ID)).Article.Author.Following {
+			t.Error("expected following=true for the follower")
+		}
+		if get(context.Background()).Article.Author.Following {
+			t.Error("expected following=false for an anonymous request")
+		}
+	})
+
+	t.Run("includes the comments count", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		user, _ := createTestUser(t, setup, "author@example.com", "author", "password123")
+		article := createTestArticle(t, setup, user.ID, "Discussed", "Test description", "Test body", nil)
+		other := createTestArticle(t, setup, user.ID, "Quiet", "Test description", "Test body", nil)
+		for i := 0; i < 3; i++ {
+			createArticleTestComment(t, setup, article.ID, user.ID)
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/"+article.Slug, nil)
+		w := httptest.NewRecorder()
+		setup.handler.GetArticle(w, withPathValues("/api/articles/{slug}", req))
+
+		var response ArticleResponse
+		if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.Article.CommentsCount != 3 {
+			t.Errorf("expected commentsCount 3, got %d", response.Article.CommentsCount)
+		}
+
+		req = httptest.NewRequest(http.MethodGet, "/api/articles", nil)
+		w = httptest.NewRecorder()
+		setup.handler.ListArticles(w, req)
+
+		var list ArticlesResponse
+		if err := json.NewDecoder(w.Body).Decode(&list); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		want := map[string]int{article.Slug: 3, other.Slug: 0}
+		for _, listed := range list.Articles {
+			if listed.CommentsCount != want[listed.Slug] {
+				t.Errorf("expected commentsCount %d for %s, got %d", want[listed.Slug], listed.Slug, listed.CommentsCount)
+			}
+		}
+	})
+
 	t.Run("returns 404 for non-existent article", func(t *testing.T) {
 		setup := newTestArticleHandler(t)
 		defer setup.db.Close()
@@ -313,7 +515,7 @@ func TestGetArticleHandler(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/api/articles/non-existent-slug", nil)
 		w := httptest.NewRecorder()
 
-		setup.handler.GetArticle(w, req)
+		setup.handler.GetArticle(w, withPathValues("/api/articles/{slug}", req))
 
 		if w.Code != http.StatusNotFound {
 			t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
@@ -327,12 +529,66 @@ func TestGetArticleHandler(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/api/articles/", nil)
 		w := httptest.NewRecorder()
 
-		setup.handler.GetArticle(w, req)
+		setup.handler.GetArticle(w, withPathValues("/api/articles/{slug}", req))
 
 		if w.Code != http.StatusNotFound {
 			t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
 		}
 	})
+
+	t.Run("redirects a renamed article's old slug", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		user, _ := createTestUser(t, setup, "author@example.com", "author", "password123")
+		article := createTestArticle(t, setup, user.ID, "Original Title", "Test description", "Test body", nil)
+
+		newTitle := "Renamed Title"
+		updated, err := setup.articleService.UpdateArticle(context.Background(), article.Slug, user.ID, &domain.UpdateArticleInput{Title: &newTitle})
+		if err != nil {
+			t.Fatalf("failed to rename article: %v", err)
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/"+article.Slug+"?fields=slug", nil)
+		w := httptest.NewRecorder()
+
+		setup.handler.GetArticle(w, withPathValues("/api/articles/{slug}", req))
+
+		if w.Code != http.StatusMovedPermanently {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusMovedPermanently, w.Code, w.Body.String())
+		}
+		want := "/api/articles/" + updated.Slug + "?fields=slug"
+		if got := w.Header().Get("Location"); got != want {
+			t.Errorf("expected Location %q, got %q", want, got)
+		}
+	})
+
+	t.Run("redirects an old slug under the versioned prefix", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		user, _ := createTestUser(t, setup, "author@example.com", "author", "password123")
+		article := createTestArticle(t, setup, user.ID, "Original Title", "Test description", "Test body", nil)
+
+		newTitle := "Renamed Title"
+		updated, err := setup.articleService.UpdateArticle(context.Background(), article.Slug, user.ID, &domain.UpdateArticleInput{Title: &newTitle})
+		if err != nil {
+			t.Fatalf("failed to rename article: %v", err)
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/articles/"+article.Slug, nil)
+		w := httptest.NewRecorder()
+
+		setup.handler.GetArticle(w, withPathValues("/api/v1/articles/{slug}", req))
+
+		if w.Code != http.StatusMovedPermanently {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusMovedPermanently, w.Code, w.Body.String())
+		}
+		want := "/api/v1/articles/" + updated.Slug
+		if got := w.Header().Get("Location"); got != want {
+			t.Errorf("expected Location %q, got %q", want, got)
+		}
+	})
 }
 
 // =============================================================================
@@ -354,7 +610,7 @@ func TestUpdateArticleHandler(t *testing.T) {
 		req = req.WithContext(ctx)
 		w := httptest.NewRecorder()
 
-		setup.handler.UpdateArticle(w, req)
+		setup.handler.UpdateArticle(w, withPathValues("/api/articles/{slug}", req))
 
 		if w.Code != http.StatusOK {
 			t.Errorf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
@@ -380,7 +636,7 @@ func TestUpdateArticleHandler(t *testing.T) {
 		req.Header.Set("Content-Type", "application/json")
 		w := httptest.NewRecorder()
 
-		setup.handler.UpdateArticle(w, req)
+		setup.handler.UpdateArticle(w, withPathValues("/api/articles/{slug}", req))
 
 		if w.Code != http.StatusUnauthorized {
 			t.Errorf("expected status %d, got %d", http.StatusUnauthorized, w.Code)
@@ -402,7 +658,7 @@ func TestUpdateArticleHandler(t *testing.T) {
 		req = req.WithContext(ctx)
 		w := httptest.NewRecorder()
 
-		setup.handler.UpdateArticle(w, req)
+		setup.handler.UpdateArticle(w, withPathValues("/api/articles/{slug}", req))
 
 		if w.Code != http.StatusForbidden {
 			t.Errorf("expected status %d, got %d", http.StatusForbidden, w.Code)
@@ -422,7 +678,7 @@ func TestUpdateArticleHandler(t *testing.T) {
 		req = req.WithContext(ctx)
 		w := httptest.NewRecorder()
 
-		setup.handler.UpdateArticle(w, req)
+		setup.handler.UpdateArticle(w, withPathValues("/api/articles/{slug}", req))
 
 		if w.Code != http.StatusNotFound {
 			t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
@@ -447,7 +703,7 @@ func TestDeleteArticleHandler(t *testing.T) {
 		req = req.WithContext(ctx)
 		w := httptest.NewRecorder()
 
-		setup.handler.DeleteArticle(w, req)
+		setup.handler.DeleteArticle(w, withPathValues("/api/articles/{slug}", req))
 
 		if w.Code != http.StatusNoContent {
 			t.Errorf("expected status %d, got %d: %s", http.StatusNoContent, w.Code, w.Body.String())
@@ -461,7 +717,7 @@ func TestDeleteArticleHandler(t *testing.T) {
 		req := httptest.NewRequest(http.MethodDelete, "/api/articles/some-slug", nil)
 		w := httptest.NewRecorder()
 
-		setup.handler.DeleteArticle(w, req)
+		setup.handler.DeleteArticle(w, withPathValues("/api/articles/{slug}", req))
 
 		if w.Code != http.StatusUnauthorized {
 			t.Errorf("expected status %d, got %d", http.StatusUnauthorized, w.Code)
@@ -481,7 +737,7 @@ func TestDeleteArticleHandler(t *testing.T) {
 		req = req.WithContext(ctx)
 		w := httptest.NewRecorder()
 
-		setup.handler.DeleteArticle(w, req)
+		setup.handler.DeleteArticle(w, withPathValues("/api/articles/{slug}", req))
 
 		if w.Code != http.StatusForbidden {
 			t.Errorf("expected status %d, got %d", http.StatusForbidden, w.Code)
@@ -489,6 +745,78 @@ func TestDeleteArticleHandler(t *testing.T) {
 	})
 }
 
+func TestDeleteArticleHandler_ResponseBody(t *testing.T) {
+	t.Run("returns 200 with confirmation when enabled", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+		setup.handler.SetDeleteReturnsBody(true)
+
+		user, _ := createTestUser(t, setup, "author@example.com", "author", "password123")
+		article := createTestArticle(t, setup, user.ID, "To Delete", "Description", "Body", nil)
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/articles/"+article.Slug, nil)
+		ctx := context.WithValue(req.Context(), UserIDContextKey, user.ID)
+		req = req.WithContext(ctx)
+		w := httptest.NewRecorder()
+
+		setup.handler.DeleteArticle(w, withPathValues("/api/articles/{slug}", req))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+
+		var resp DeleteResponse
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if resp.Deleted.Type != "article" || resp.Deleted.Slug != article.Slug {
+			t.Errorf("unexpected confirmation body: %+v", resp.Deleted)
+		}
+	})
+
+	t.Run("returns 200 when client prefers representation", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		user, _ := createTestUser(t, setup, "author@example.com", "author", "password123")
+		article := createTestArticle(t, setup, user.ID, "To Delete", "Description", "Body", nil)
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/articles/"+article.Slug, nil)
+		req.Header.Set("Prefer", "return=representation")
+		ctx := context.WithValue(req.Context(), UserIDContextKey, user.ID)
+		req = req.WithContext(ctx)
+		w := httptest.NewRecorder()
+
+		setup.handler.DeleteArticle(w, withPathValues("/api/articles/{slug}", req))
+
+		if w.Code != http.StatusOK {
+			t.Errorf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("returns 204 with empty body by default", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		user, _ := createTestUser(t, setup, "author@example.com", "author", "password123")
+		article := createTestArticle(t, setup, user.ID, "To Delete", "Description", "Body", nil)
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/articles/"+article.Slug, nil)
+		ctx := context.WithValue(req.Context(), UserIDContextKey, user.ID)
+		req = req.WithContext(ctx)
+		w := httptest.NewRecorder()
+
+		setup.handler.DeleteArticle(w, withPathValues("/api/articles/{slug}", req))
+
+		if w.Code != http.StatusNoContent {
+			t.Errorf("expected status %d, got %d", http.StatusNoContent, w.Code)
+		}
+		if w.Body.Len() != 0 {
+			t.Errorf("expected empty body, got %q", w.Body.String())
+		}
+	})
+}
+
 // =============================================================================
 // TDD: GET /api/articles (List Articles) Tests
 // =============================================================================
@@ -584,6 +912,40 @@ func TestListArticlesHandler(t *testing.T) {
 		}
 	})
 
+	t.Run("reports following for authors the viewer follows", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		followed, _ := createTestUser(t, setup, "followed@example.com", "followed", "password123")
+		other, _ := createTestUser(t, setup, "other@example.com", "other", "password123")
+		viewer, _ := createTestUser(t, setup, "viewer@example.com", "viewer", "password123")
+		createTestArticle(t, setup, followed.ID, "Followed Article", "Desc", "Body", nil)
+		createTestArticle(t, setup, other.ID, "Other Article", "Desc", "Body", nil)
+		if err := setup.followRepo.FollowUser(context.Background(), viewer.ID, followed.ID); err != nil {
+			t.Fatalf("failed to follow author: %v", err)
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/api/articles", nil)
+		req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, viewer.ID))
+		w := httptest.NewRecorder()
+
+		setup.handler.ListArticles(w, req)
+
+		var response ArticlesResponse
+		if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if len(response.Articles) != 2 {
+			t.Fatalf("expected 2 articles, got %d", len(response.Articles))
+		}
+		for _, article := range response.Articles {
+			want := article.Author.Username == "followed"
+			if article.Author.Following != want {
+				t.Errorf("expected following=%v for %s, got %v", want, article.Author.Username, article.Author.Following)
+			}
+		}
+	})
+
 	t.Run("respects pagination limit", func(t *testing.T) {
 		setup := newTestArticleHandler(t)
 		defer setup.db.Close()
@@ -610,84 +972,439 @@ func TestListArticlesHandler(t *testing.T) {
 			t.Errorf("expected 2 articles with limit=2, got %d", len(articles))
 		}
 	})
-}
-
-// =============================================================================
-// TDD: GET /api/tags (Get Tags) Tests
-// =============================================================================
 
-func TestGetTagsHandler(t *testing.T) {
-	t.Run("returns empty list when no articles", func(t *testing.T) {
+	t.Run("sets Link header for a middle page", func(t *testing.T) {
 		setup := newTestArticleHandler(t)
 		defer setup.db.Close()
 
-		req := httptest.NewRequest(http.MethodGet, "/api/tags", nil)
+		user, _ := createTestUser(t, setup, "author@example.com", "author", "password123")
+		for i := 0; i < 5; i++ {
+			createTestArticle(t, setup, user.ID, "Article", "Desc", "Body", nil)
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/api/articles?author=author&limit=2&offset=2", nil)
 		w := httptest.NewRecorder()
 
-		setup.handler.GetTags(w, req)
+		setup.handler.ListArticles(w, req)
 
 		if w.Code != http.StatusOK {
-			t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
+			t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
 		}
 
-		var response map[string]interface{}
-		json.NewDecoder(w.Body).Decode(&response)
-
-		tags := response["tags"].([]interface{})
-		if len(tags) != 0 {
-			t.Errorf("expected 0 tags, got %d", len(tags))
+		want := `</api/articles?author=author&limit=2&offset=4>; rel="next", ` +
+			`</api/articles?author=author&limit=2&offset=0>; rel="prev"`
+		if got := w.Header().Get("Link"); got != want {
+			t.Errorf("expected Link %q, got %q", want, got)
 		}
 	})
 
-	t.Run("returns all unique tags", func(t *testing.T) {
+	t.Run("omits Link header on a single page", func(t *testing.T) {
 		setup := newTestArticleHandler(t)
 		defer setup.db.Close()
 
 		user, _ := createTestUser(t, setup, "author@example.com", "author", "password123")
-		createTestArticle(t, setup, user.ID, "Article 1", "Desc", "Body", []string{"go", "programming"})
-		createTestArticle(t, setup, user.ID, "Article 2", "Desc", "Body", []string{"python", "programming"})
+		createTestArticle(t, setup, user.ID, "Article", "Desc", "Body", nil)
 
-		req := httptest.NewRequest(http.MethodGet, "/api/tags", nil)
+		req := httptest.NewRequest(http.MethodGet, "/api/articles", nil)
 		w := httptest.NewRecorder()
 
-		setup.handler.GetTags(w, req)
-
-		if w.Code != http.StatusOK {
-			t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
-		}
-
-		var response map[string]interface{}
-		json.NewDecoder(w.Body).Decode(&response)
+		setup.handler.ListArticles(w, req)
 
-		tags := response["tags"].([]interface{})
-		if len(tags) != 3 { // go, programming, python
-			t.Errorf("expected 3 unique tags, got %d: %v", len(tags), tags)
+		if got := w.Header().Get("Link"); got != "" {
+			t.Errorf("expected no Link header, got %q", got)
 		}
 	})
-}
-
-// =============================================================================
-// TDD: POST /api/articles/{slug}/favorite (Favorite Article) Tests
-// =============================================================================
 
-func TestFavoriteArticleHandler(t *testing.T) {
-	t.Run("successfully favorites an article", func(t *testing.T) {
+	t.Run("rejects offset beyond the cap", func(t *testing.T) {
 		setup := newTestArticleHandler(t)
 		defer setup.db.Close()
 
-		author, _ := createTestUser(t, setup, "author@example.com", "author", "password123")
-		user, _ := createTestUser(t, setup, "user@example.com", "user", "password123")
-		article := createTestArticle(t, setup, author.ID, "Test Article", "Description", "Body", []string{"test"})
-
-		req := httptest.NewRequest(http.MethodPost, "/api/articles/"+article.Slug+"/favorite", nil)
-		ctx := context.WithValue(req.Context(), UserIDContextKey, user.ID)
-		req = req.WithContext(ctx)
+		req := httptest.NewRequest(http.MethodGet, "/api/articles?offset=100000000", nil)
 		w := httptest.NewRecorder()
 
-		setup.handler.FavoriteArticle(w, req)
+		setup.handler.ListArticles(w, req)
 
-		if w.Code != http.StatusOK {
-			t.Errorf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		if w.Code != http.StatusUnprocessableEntity {
+			t.Errorf("expected status %d, got %d", http.StatusUnprocessableEntity, w.Code)
+		}
+
+		var response ErrorResponse
+		json.NewDecoder(w.Body).Decode(&response)
+		if len(response.Errors["offset"]) == 0 {
+			t.Errorf("expected offset error, got %v", response.Errors)
+		}
+	})
+
+	t.Run("pages with nextCursor", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		user, _ := createTestUser(t, setup, "author@example.com", "author", "password123")
+		for _, title := range []string{"Article 1", "Article 2", "Article 3"} {
+			createTestArticle(t, setup, user.ID, title, "Desc", "Body", nil)
+		}
+
+		var slugs []string
+		target := "/api/articles?limit=2"
+		for page := 0; page < 3 && target != ""; page++ {
+			w := httptest.NewRecorder()
+			setup.handler.ListArticles(w, httptest.NewRequest(http.MethodGet, target, nil))
+			if w.Code != http.StatusOK {
+				t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+			}
+
+			var response ArticlesResponse
+			if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if response.ArticlesCount != 3 {
+				t.Errorf("expected articlesCount 3, got %d", response.ArticlesCount)
+			}
+			for _, article := range response.Articles {
+				slugs = append(slugs, article.Slug)
+			}
+
+			target = ""
+			if response.NextCursor != "" {
+				target = "/api/articles?limit=2&before=" + url.QueryEscape(response.NextCursor)
+			}
+		}
+
+		want := []string{"article-3", "article-2", "article-1"}
+		if len(slugs) != len(want) {
+			t.Fatalf("expected slugs %v, got %v", want, slugs)
+		}
+		for i := range want {
+			if slugs[i] != want[i] {
+				t.Errorf("expected slugs %v, got %v", want, slugs)
+				break
+			}
+		}
+	})
+
+	t.Run("rejects an invalid cursor", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/articles?before=garbage", nil)
+		w := httptest.NewRecorder()
+
+		setup.handler.ListArticles(w, req)
+
+		if w.Code != http.StatusUnprocessableEntity {
+			t.Errorf("expected status %d, got %d", http.StatusUnprocessableEntity, w.Code)
+		}
+	})
+
+	t.Run("rejects a cursor with a non-newest sort", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/articles?sort=oldest&before=2024-01-01T00:00:00Z,1", nil)
+		w := httptest.NewRecorder()
+
+		setup.handler.ListArticles(w, req)
+
+		if w.Code != http.StatusUnprocessableEntity {
+			t.Errorf("expected status %d, got %d", http.StatusUnprocessableEntity, w.Code)
+		}
+	})
+}
+
+func TestGetFeedHandler(t *testing.T) {
+	t.Run("lists articles from followed authors only", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		viewer, _ := createTestUser(t, setup, "viewer@example.com", "viewer", "password123")
+		followed, _ := createTestUser(t, setup, "followed@example.com", "followed", "password123")
+		stranger, _ := createTestUser(t, setup, "stranger@example.com", "stranger", "password123")
+		if err := setup.followRepo.FollowUser(context.Background(), viewer.ID, followed.ID); err != nil {
+			t.Fatalf("failed to follow author: %v", err)
+		}
+		article := createTestArticle(t, setup, followed.ID, "Followed Article", "Desc", "Body", nil)
+		createTestArticle(t, setup, stranger.ID, "Stranger Article", "Desc", "Body", nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/feed", nil)
+		req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, viewer.ID))
+		w := httptest.NewRecorder()
+
+		setup.handler.GetFeed(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+
+		var response map[string]interface{}
+		if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+
+		articles, ok := response["articles"].([]interface{})
+		if !ok || len(articles) != 1 {
+			t.Fatalf("expected 1 article in the feed, got %v", response["articles"])
+		}
+		respArticle := articles[0].(map[string]interface{})
+		if respArticle["slug"] != article.Slug {
+			t.Errorf("expected slug %s, got %v", article.Slug, respArticle["slug"])
+		}
+		author := respArticle["author"].(map[string]interface{})
+		if author["following"] != true {
+			t.Errorf("expected author following true, got %v", author["following"])
+		}
+	})
+
+	t.Run("requires authentication", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/feed", nil)
+		w := httptest.NewRecorder()
+
+		setup.handler.GetFeed(w, req)
+
+		if w.Code != http.StatusUnauthorized {
+			t.Errorf("expected status %d, got %d", http.StatusUnauthorized, w.Code)
+		}
+	})
+}
+
+func TestArticleFieldsSelection(t *testing.T) {
+	t.Run("returns only requested fields plus slug", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		user, _ := createTestUser(t, setup, "author@example.com", "author", "password123")
+		createTestArticle(t, setup, user.ID, "Article 1", "Desc 1", "Body 1", nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/articles?fields=title,author", nil)
+		w := httptest.NewRecorder()
+
+		setup.handler.ListArticles(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+
+		var response struct {
+			Articles      []map[string]json.RawMessage `json:"articles"`
+			ArticlesCount int                          `json:"articlesCount"`
+		}
+		if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if len(response.Articles) != 1 || response.ArticlesCount != 1 {
+			t.Fatalf("expected 1 article, got %d (count %d)", len(response.Articles), response.ArticlesCount)
+		}
+
+		article := response.Articles[0]
+		if len(article) != 3 {
+			t.Errorf("expected exactly slug, title and author, got %v", article)
+		}
+		for _, field := range []string{"slug", "title", "author"} {
+			if _, ok := article[field]; !ok {
+				t.Errorf("expected field %q in response", field)
+			}
+		}
+	})
+
+	t.Run("selects fields on a single article", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		user, _ := createTestUser(t, setup, "author@example.com", "author", "password123")
+		article := createTestArticle(t, setup, user.ID, "Article 1", "Desc 1", "Body 1", nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/"+article.Slug+"?fields=favoritesCount", nil)
+		w := httptest.NewRecorder()
+
+		setup.handler.GetArticle(w, withPathValues("/api/articles/{slug}", req))
+
+		var response struct {
+			Article map[string]json.RawMessage `json:"article"`
+		}
+		if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if len(response.Article) != 2 {
+			t.Errorf("expected slug and favoritesCount only, got %v", response.Article)
+		}
+		if string(response.Article["slug"]) != `"`+article.Slug+`"` {
+			t.Errorf("expected slug %q, got %s", article.Slug, response.Article["slug"])
+		}
+	})
+
+	t.Run("rejects unknown fields", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/articles?fields=title,password", nil)
+		w := httptest.NewRecorder()
+
+		setup.handler.ListArticles(w, req)
+
+		if w.Code != http.StatusUnprocessableEntity {
+			t.Errorf("expected status %d, got %d", http.StatusUnprocessableEntity, w.Code)
+		}
+
+		var response ErrorResponse
+		json.NewDecoder(w.Body).Decode(&response)
+		if len(response.Errors["fields"]) == 0 {
+			t.Errorf("expected fields error, got %v", response.Errors)
+		}
+	})
+}
+
+// =============================================================================
+// TDD: GET /api/tags (Get Tags) Tests
+// =============================================================================
+
+func TestGetTagsHandler(t *testing.T) {
+	t.Run("returns empty list when no articles", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/tags", nil)
+		w := httptest.NewRecorder()
+
+		setup.handler.GetTags(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
+		}
+
+		var response map[string]interface{}
+		json.NewDecoder(w.Body).Decode(&response)
+
+		tags := response["tags"].([]interface{})
+		if len(tags) != 0 {
+			t.Errorf("expected 0 tags, got %d", len(tags))
+		}
+	})
+
+	t.Run("returns all unique tags", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		user, _ := createTestUser(t, setup, "author@example.com", "author", "password123")
+		createTestArticle(t, setup, user.ID, "Article 1", "Desc", "Body", []string{"go", "programming"})
+		createTestArticle(t, setup, user.ID, "Article 2", "Desc", "Body", []string{"python", "programming"})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/tags", nil)
+		w := httptest.NewRecorder()
+
+		setup.handler.GetTags(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
+		}
+
+		var response map[string]interface{}
+		json.NewDecoder(w.Body).Decode(&response)
+
+		tags := response["tags"].([]interface{})
+		if len(tags) != 3 { // go, programming, python
+			t.Errorf("expected 3 unique tags, got %d: %v", len(tags), tags)
+		}
+	})
+}
+
+func TestSearchTagsHandler(t *testing.T) {
+	setup := newTestArticleHandler(t)
+	defer setup.db.Close()
+
+	user, _ := createTestUser(t, setup, "author@example.com", "author", "password123")
+	createTestArticle(t, setup, user.ID, "Article 1", "Desc", "Body", []string{"golang", "gopher", "python"})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tags/search?prefix=Go", nil)
+	w := httptest.NewRecorder()
+
+	setup.handler.SearchTags(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	var response TagsResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if strings.Join(response.Tags, ",") != "golang,gopher" {
+		t.Errorf("expected [golang gopher], got %v", response.Tags)
+	}
+}
+
+func TestGetPopularTagsHandler(t *testing.T) {
+	setup := newTestArticleHandler(t)
+	defer setup.db.Close()
+
+	user, _ := createTestUser(t, setup, "author@example.com", "author", "password123")
+	createTestArticle(t, setup, user.ID, "Article 1", "Desc", "Body", []string{"go", "programming"})
+	createTestArticle(t, setup, user.ID, "Article 2", "Desc", "Body", []string{"python", "programming"})
+	createTestArticle(t, setup, user.ID, "Article 3", "Desc", "Body", []string{"go", "programming"})
+
+	t.Run("returns tags by article count", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/tags/popular", nil)
+		w := httptest.NewRecorder()
+
+		setup.handler.GetPopularTags(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+
+		var response PopularTagsResponse
+		if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+
+		want := []domain.TagCount{{Name: "programming", Count: 3}, {Name: "go", Count: 2}, {Name: "python", Count: 1}}
+		if len(response.Tags) != len(want) {
+			t.Fatalf("expected %v, got %v", want, response.Tags)
+		}
+		for i := range want {
+			if response.Tags[i] != want[i] {
+				t.Errorf("tag %d: expected %v, got %v", i, want[i], response.Tags[i])
+			}
+		}
+	})
+
+	t.Run("applies limit", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/tags/popular?limit=2", nil)
+		w := httptest.NewRecorder()
+
+		setup.handler.GetPopularTags(w, req)
+
+		var response PopularTagsResponse
+		json.NewDecoder(w.Body).Decode(&response)
+		if len(response.Tags) != 2 {
+			t.Errorf("expected 2 tags, got %d", len(response.Tags))
+		}
+	})
+}
+
+// =============================================================================
+// TDD: POST /api/articles/{slug}/favorite (Favorite Article) Tests
+// =============================================================================
+
+func TestFavoriteArticleHandler(t *testing.T) {
+	t.Run("successfully favorites an article", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		author, _ := createTestUser(t, setup, "author@example.com", "author", "password123")
+		user, _ := createTestUser(t, setup, "user@example.com", "user", "password123")
+		article := createTestArticle(t, setup, author.ID, "Test Article", "Description", "Body", []string{"test"})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/articles/"+article.Slug+"/favorite", nil)
+		ctx := context.WithValue(req.Context(), UserIDContextKey, user.ID)
+		req = req.WithContext(ctx)
+		w := httptest.NewRecorder()
+
+		setup.handler.FavoriteArticle(w, withPathValues("/api/articles/{slug}/favorite", req))
+
+		if w.Code != http.StatusOK {
+			t.Errorf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
 		}
 
 		var response map[string]interface{}
@@ -709,7 +1426,7 @@ func TestFavoriteArticleHandler(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/api/articles/test-slug/favorite", nil)
 		w := httptest.NewRecorder()
 
-		setup.handler.FavoriteArticle(w, req)
+		setup.handler.FavoriteArticle(w, withPathValues("/api/articles/{slug}/favorite", req))
 
 		if w.Code != http.StatusUnauthorized {
 			t.Errorf("expected status %d, got %d", http.StatusUnauthorized, w.Code)
@@ -727,7 +1444,7 @@ func TestFavoriteArticleHandler(t *testing.T) {
 		req = req.WithContext(ctx)
 		w := httptest.NewRecorder()
 
-		setup.handler.FavoriteArticle(w, req)
+		setup.handler.FavoriteArticle(w, withPathValues("/api/articles/{slug}/favorite", req))
 
 		if w.Code != http.StatusNotFound {
 			t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
@@ -747,14 +1464,14 @@ func TestFavoriteArticleHandler(t *testing.T) {
 		ctx := context.WithValue(req.Context(), UserIDContextKey, user.ID)
 		req = req.WithContext(ctx)
 		w := httptest.NewRecorder()
-		setup.handler.FavoriteArticle(w, req)
+		setup.handler.FavoriteArticle(w, withPathValues("/api/articles/{slug}/favorite", req))
 
 		// Second favorite (should still succeed)
 		req = httptest.NewRequest(http.MethodPost, "/api/articles/"+article.Slug+"/favorite", nil)
 		ctx = context.WithValue(req.Context(), UserIDContextKey, user.ID)
 		req = req.WithContext(ctx)
 		w = httptest.NewRecorder()
-		setup.handler.FavoriteArticle(w, req)
+		setup.handler.FavoriteArticle(w, withPathValues("/api/articles/{slug}/favorite", req))
 
 		if w.Code != http.StatusOK {
 			t.Errorf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
@@ -792,7 +1509,7 @@ func TestUnfavoriteArticleHandler(t *testing.T) {
 		ctx := context.WithValue(req.Context(), UserIDContextKey, user.ID)
 		req = req.WithContext(ctx)
 		w := httptest.NewRecorder()
-		setup.handler.FavoriteArticle(w, req)
+		setup.handler.FavoriteArticle(w, withPathValues("/api/articles/{slug}/favorite", req))
 
 		// Now unfavorite
 		req = httptest.NewRequest(http.MethodDelete, "/api/articles/"+article.Slug+"/favorite", nil)
@@ -800,7 +1517,7 @@ func TestUnfavoriteArticleHandler(t *testing.T) {
 		req = req.WithContext(ctx)
 		w = httptest.NewRecorder()
 
-		setup.handler.UnfavoriteArticle(w, req)
+		setup.handler.UnfavoriteArticle(w, withPathValues("/api/articles/{slug}/favorite", req))
 
 		if w.Code != http.StatusOK {
 			t.Errorf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
@@ -825,7 +1542,7 @@ func TestUnfavoriteArticleHandler(t *testing.T) {
 		req := httptest.NewRequest(http.MethodDelete, "/api/articles/test-slug/favorite", nil)
 		w := httptest.NewRecorder()
 
-		setup.handler.UnfavoriteArticle(w, req)
+		setup.handler.UnfavoriteArticle(w, withPathValues("/api/articles/{slug}/favorite", req))
 
 		if w.Code != http.StatusUnauthorized {
 			t.Errorf("expected status %d, got %d", http.StatusUnauthorized, w.Code)
@@ -843,7 +1560,7 @@ func TestUnfavoriteArticleHandler(t *testing.T) {
 		req = req.WithContext(ctx)
 		w := httptest.NewRecorder()
 
-		setup.handler.UnfavoriteArticle(w, req)
+		setup.handler.UnfavoriteArticle(w, withPathValues("/api/articles/{slug}/favorite", req))
 
 		if w.Code != http.StatusNotFound {
 			t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
@@ -864,7 +1581,7 @@ func TestUnfavoriteArticleHandler(t *testing.T) {
 		req = req.WithContext(ctx)
 		w := httptest.NewRecorder()
 
-		setup.handler.UnfavoriteArticle(w, req)
+		setup.handler.UnfavoriteArticle(w, withPathValues("/api/articles/{slug}/favorite", req))
 
 		if w.Code != http.StatusOK {
 			t.Errorf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
@@ -882,3 +1599,510 @@ func TestUnfavoriteArticleHandler(t *testing.T) {
 		}
 	})
 }
+
+// =============================================================================
+// GET /api/articles/{slug}/stats Tests
+// =============================================================================
+
+func TestGetArticleStatsHandler(t *testing.T) {
+	t.Run("returns engagement counts", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		author, _ := createTestUser(t, setup, "author@example.com", "author", "password123")
+		reader, _ := createTestUser(t, setup, "reader@example.com", "reader", "password123")
+		article := createTestArticle(t, setup, author.ID, "Stats Article", "Description", "Body", nil)
+
+		if _, err := setup.articleService.FavoriteArticle(context.Background(), article.Slug, reader.ID); err != nil {
+			t.Fatalf("failed to favorite article: %v", err)
+		}
+		for _, body := range []string{"First", "Second"} {
+			if _, err := setup.db.Exec(`INSERT INTO comments (body, article_id, author_id) VALUES (?, ?, ?)`,
+				body, article.ID, reader.ID); err != nil {
+				t.Fatalf("failed to create comment: %v", err)
+			}
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/"+article.Slug+"/stats", nil)
+		req.SetPathValue("slug", article.Slug)
+		w := httptest.NewRecorder()
+
+		setup.handler.GetArticleStats(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+
+		var resp ArticleStatsResponse
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if resp.Stats.FavoritesCount != 1 {
+			t.Errorf("expected favoritesCount 1, got %d", resp.Stats.FavoritesCount)
+		}
+		if resp.Stats.CommentsCount != 2 {
+			t.Errorf("expected commentsCount 2, got %d", resp.Stats.CommentsCount)
+		}
+	})
+
+	t.Run("returns 404 for missing article", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/missing/stats", nil)
+		req.SetPathValue("slug", "missing")
+		w := httptest.NewRecorder()
+
+		setup.handler.GetArticleStats(w, req)
+
+		if w.Code != http.StatusNotFound {
+			t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+		}
+	})
+
+	t.Run("restricts stats to the author when configured", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+		setup.articleService.SetConfig(service.ArticleServiceConfig{StatsAuthorOnly: true})
+
+		author, _ := createTestUser(t, setup, "author@example.com", "author", "password123")
+		reader, _ := createTestUser(t, setup, "reader@example.com", "reader", "password123")
+		article := createTestArticle(t, setup, author.ID, "Private Stats", "Description", "Body", nil)
+
+		tests := []struct {
+			name   string
+			userID *int64
+			want   int
+		}{
+			{"anonymous", nil, http.StatusUnauthorized},
+			{"other user", &reader.ID, http.StatusForbidden},
+			{"author", &author.ID, http.StatusOK},
+		}
+
+		for _, tt := range tests {
+			req := httptest.NewRequest(http.MethodGet, "/api/articles/"+article.Slug+"/stats", nil)
+			req.SetPathValue("slug", article.Slug)
+			if tt.userID != nil {
+				req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, *tt.userID))
+			}
+			w := httptest.NewRecorder()
+
+			setup.handler.GetArticleStats(w, req)
+
+			if w.Code != tt.want {
+				t.Errorf("%s: expected status %d, got %d", tt.name, tt.want, w.Code)
+			}
+		}
+	})
+}
+
+// =============================================================================
+// GET /api/articles/{slug}/revisions Tests
+// =============================================================================
+
+func TestGetArticleRevisionsHandler(t *testing.T) {
+	newRequest := func(slug string, userID int64) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/"+slug+"/revisions", nil)
+		req.SetPathValue("slug", slug)
+		return req.WithContext(context.WithValue(req.Context(), UserIDContextKey, userID))
+	}
+
+	t.Run("returns previous versions newest first", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		user, _ := createTestUser(t, setup, "author@example.com", "author", "password123")
+		article := createTestArticle(t, setup, user.ID, "Revised", "Description", "Version 1", nil)
+
+		for _, body := range []string{"Version 2", "Version 3"} {
+			newBody := body
+			if _, err := setup.articleService.UpdateArticle(context.Background(), article.Slug, user.ID,
+				&domain.UpdateArticleInput{Body: &newBody}); err != nil {
+				t.Fatalf("failed to update article: %v", err)
+			}
+		}
+
+		w := httptest.NewRecorder()
+		setup.handler.GetArticleRevisions(w, newRequest(article.Slug, user.ID))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+
+		var resp ArticleRevisionsResponse
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if len(resp.Revisions) != 2 {
+			t.Fatalf("expected 2 revisions, got %d", len(resp.Revisions))
+		}
+		if resp.Revisions[0].Body != "Version 2" || resp.Revisions[1].Body != "Version 1" {
+			t.Errorf("expected Version 2 then Version 1, got %q then %q", resp.Revisions[0].Body, resp.Revisions[1].Body)
+		}
+	})
+
+	t.Run("returns 403 for non-author", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		author, _ := createTestUser(t, setup, "author@example.com", "author", "password123")
+		other, _ := createTestUser(t, setup, "other@example.com", "other", "password123")
+		article := createTestArticle(t, setup, author.ID, "Private History", "Description", "Body", nil)
+
+		w := httptest.NewRecorder()
+		setup.handler.GetArticleRevisions(w, newRequest(article.Slug, other.ID))
+
+		if w.Code != http.StatusForbidden {
+			t.Errorf("expected status %d, got %d", http.StatusForbidden, w.Code)
+		}
+	})
+
+	t.Run("returns 401 without authentication", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/any/revisions", nil)
+		req.SetPathValue("slug", "any")
+		w := httptest.NewRecorder()
+
+		setup.handler.GetArticleRevisions(w, req)
+
+		if w.Code != http.StatusUnauthorized {
+			t.Errorf("expected status %d, got %d", http.StatusUnauthorized, w.Code)
+		}
+	})
+}
+
+// =============================================================================
+// GET /api/articles/{slug}/related Tests
+// =============================================================================
+
+func TestGetRelatedArticlesHandler(t *testing.T) {
+	t.Run("returns articles sharing tags", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		user, _ := createTestUser(t, setup, "author@example.com", "author", "password123")
+		article := createTestArticle(t, setup, user.ID, "Source", "Description", "Body", []string{"go", "sql"})
+		createTestArticle(t, setup, user.ID, "Related", "Description", "Body", []string{"go"})
+		createTestArticle(t, setup, user.ID, "Unrelated", "Description", "Body", []string{"cooking"})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/"+article.Slug+"/related", nil)
+		req.SetPathValue("slug", article.Slug)
+		w := httptest.NewRecorder()
+
+		setup.handler.GetRelatedArticles(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+
+		var resp ArticlesResponse
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if len(resp.Articles) != 1 || resp.Articles[0].Slug != "related" {
+			t.Errorf("expected only the related article, got %+v", resp.Articles)
+		}
+		if resp.Articles[0].Author.Username != "author" {
+			t.Errorf("expected author to be loaded, got %+v", resp.Articles[0].Author)
+		}
+	})
+
+	t.Run("returns an empty list for an untagged article", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		user, _ := createTestUser(t, setup, "author@example.com", "author", "password123")
+		article := createTestArticle(t, setup, user.ID, "Untagged", "Description", "Body", nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/"+article.Slug+"/related", nil)
+		req.SetPathValue("slug", article.Slug)
+		w := httptest.NewRecorder()
+
+		setup.handler.GetRelatedArticles(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+
+		var resp ArticlesResponse
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if resp.Articles == nil || len(resp.Articles) != 0 {
+			t.Errorf("expected an empty articles list, got %+v", resp.Articles)
+		}
+	})
+
+	t.Run("returns 404 for missing article", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/missing/related", nil)
+		req.SetPathValue("slug", "missing")
+		w := httptest.NewRecorder()
+
+		setup.handler.GetRelatedArticles(w, req)
+
+		if w.Code != http.StatusNotFound {
+			t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+		}
+	})
+}
+
+// =============================================================================
+// GET /api/profiles/{username}/feed.rss Tests
+// =============================================================================
+
+func TestGetAuthorFeedHandler(t *testing.T) {
+	t.Run("returns the author's published articles as RSS", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+		setup.handler.SetPublicURL("https://conduit.example.com/")
+
+		author, _ := createTestUser(t, setup, "author@example.com", "author", "password123")
+		other, _ := createTestUser(t, setup, "other@example.com", "other", "password123")
+		createTestArticle(t, setup, author.ID, "Feed Article", "Feed summary", "Body", nil)
+		createTestArticle(t, setup, other.ID, "Someone Else", "Not in the feed", "Body", nil)
+		draft := false
+		if _, err := setup.articleService.CreateArticle(context.Background(), author.ID, &domain.CreateArticleInput{
+			Title: "Draft", Description: "Hidden", Body: "Body", Published: &draft,
+		}); err != nil {
+			t.Fatalf("failed to create draft: %v", err)
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/api/profiles/author/feed.rss", nil)
+		req.SetPathValue("username", "author")
+		w := httptest.NewRecorder()
+
+		setup.handler.GetAuthorFeed(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+		if ct := w.Header().Get("Content-Type"); !strings.HasPrefix(ct, "application/rss+xml") {
+			t.Errorf("expected RSS content type, got %q", ct)
+		}
+
+		var doc struct {
+			Items []struct {
+				Title       string `xml:"title"`
+				Link        string `xml:"link"`
+				Description string `xml:"description"`
+				GUID        string `xml:"guid"`
+			} `xml:"channel>item"`
+		}
+		if err := xml.Unmarshal(w.Body.Bytes(), &doc); err != nil {
+			t.Fatalf("failed to parse RSS: %v", err)
+		}
+		if len(doc.Items) != 1 {
+			t.Fatalf("expected 1 item, got %d", len(doc.Items))
+		}
+		item := doc.Items[0]
+		if item.Title != "Feed Article" || item.Description != "Feed summary" || item.GUID != "feed-article" {
+			t.Errorf("unexpected item: %+v", item)
+		}
+		if item.Link != "https://conduit.example.com/article/feed-article" {
+			t.Errorf("expected article link, got %q", item.Link)
+		}
+	})
+
+	t.Run("returns 404 for unknown username", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/profiles/nobody/feed.rss", nil)
+		req.SetPathValue("username", "nobody")
+		w := httptest.NewRecorder()
+
+		setup.handler.GetAuthorFeed(w, req)
+
+		if w.Code != http.StatusNotFound {
+			t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+		}
+	})
+}
+
+// =============================================================================
+// GET /api/profiles/{username}/favorites Tests
+// =============================================================================
+
+func TestListFavoritedArticlesHandler(t *testing.T) {
+	t.Run("returns the user's favorited articles with viewer flags", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		ctx := context.Background()
+		author, _ := createTestUser(t, setup, "author@example.com", "author", "password123")
+		fan, _ := createTestUser(t, setup, "fan@example.com", "fan", "password123")
+		viewer, _ := createTestUser(t, setup, "viewer@example.com", "viewer", "password123")
+
+		liked := createTestArticle(t, setup, author.ID, "Liked Article", "Desc", "Body", nil)
+		createTestArticle(t, setup, author.ID, "Ignored Article", "Desc", "Body", nil)
+		if _, err := setup.articleService.FavoriteArticle(ctx, liked.Slug, fan.ID); err != nil {
+			t.Fatalf("failed to favorite: %v", err)
+		}
+		if _, err := setup.articleService.FavoriteArticle(ctx, liked.Slug, viewer.ID); err != nil {
+			t.Fatalf("failed to favorite: %v", err)
+		}
+		if err := setup.followRepo.FollowUser(ctx, viewer.ID, author.ID); err != nil {
+			t.Fatalf("failed to follow: %v", err)
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/api/profiles/fan/favorites", nil)
+		req.SetPathValue("username", "fan")
+		req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, viewer.ID))
+		w := httptest.NewRecorder()
+
+		setup.handler.ListFavoritedArticles(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+
+		var response ArticlesResponse
+		if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.ArticlesCount != 1 || len(response.Articles) != 1 {
+			t.Fatalf("expected 1 article, got %d (count %d)", len(response.Articles), response.ArticlesCount)
+		}
+		article := response.Articles[0]
+		if article.Slug != liked.Slug {
+			t.Errorf("expected slug %q, got %q", liked.Slug, article.Slug)
+		}
+		if !article.Favorited {
+			t.Error("expected favorited true for a viewer who favorited the article")
+		}
+		if !article.Author.Following {
+			t.Error("expected following true for an author the viewer follows")
+		}
+	})
+
+	t.Run("returns an empty list when nothing is favorited", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		createTestUser(t, setup, "fan@example.com", "fan", "password123")
+
+		req := httptest.NewRequest(http.MethodGet, "/api/profiles/fan/favorites", nil)
+		req.SetPathValue("username", "fan")
+		w := httptest.NewRecorder()
+
+		setup.handler.ListFavoritedArticles(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+		var response map[string]interface{}
+		json.NewDecoder(w.Body).Decode(&response)
+		articles, ok := response["articles"].([]interface{})
+		if !ok || len(articles) != 0 {
+			t.Errorf("expected empty articles array, got %v", response["articles"])
+		}
+		if response["articlesCount"] != float64(0) {
+			t.Errorf("expected articlesCount 0, got %v", response["articlesCount"])
+		}
+	})
+
+	t.Run("returns 404 for unknown username", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/profiles/nobody/favorites", nil)
+		req.SetPathValue("username", "nobody")
+		w := httptest.NewRecorder()
+
+		setup.handler.ListFavoritedArticles(w, req)
+
+		if w.Code != http.StatusNotFound {
+			t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+		}
+	})
+}
+
+// =============================================================================
+// GET /api/articles/{slug}/favorited-by Tests
+// =============================================================================
+
+func TestListFavoritingUsersHandler(t *testing.T) {
+	t.Run("returns every user who favorited the article", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		ctx := context.Background()
+		author, _ := createTestUser(t, setup, "author@example.com", "author", "password123")
+		article := createTestArticle(t, setup, author.ID, "Popular Article", "Desc", "Body", nil)
+
+		var fanIDs []int64
+		for _, name := range []string{"fan1", "fan2", "fan3"} {
+			fan, _ := createTestUser(t, setup, name+"@example.com", name, "password123")
+			if _, err := setup.articleService.FavoriteArticle(ctx, article.Slug, fan.ID); err != nil {
+				t.Fatalf("failed to favorite: %v", err)
+			}
+			fanIDs = append(fanIDs, fan.ID)
+		}
+		// fan3 follows fan1, so only that profile is marked as followed
+		viewerID := fanIDs[2]
+		if err := setup.followRepo.FollowUser(ctx, viewerID, fanIDs[0]); err != nil {
+			t.Fatalf("failed to follow: %v", err)
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/"+article.Slug+"/favorited-by", nil)
+		req.SetPathValue("slug", article.Slug)
+		req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, viewerID))
+		w := httptest.NewRecorder()
+
+		setup.handler.ListFavoritingUsers(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+
+		var response map[string]interface{}
+		if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response["profilesCount"] != float64(3) {
+			t.Errorf("expected profilesCount 3, got %v", response["profilesCount"])
+		}
+		profiles := response["profiles"].([]interface{})
+		if len(profiles) != 3 {
+			t.Fatalf("expected 3 profiles, got %d", len(profiles))
+		}
+
+		seen := make(map[string]bool)
+		for _, p := range profiles {
+			profile := p.(map[string]interface{})
+			username := profile["username"].(string)
+			seen[username] = true
+			if _, ok := profile["email"]; ok {
+				t.Errorf("expected only public profile fields, got email for %s", username)
+			}
+			if want := username == "fan1"; profile["following"] != want {
+				t.Errorf("%s: expected following %v, got %v", username, want, profile["following"])
+			}
+		}
+		for _, name := range []string{"fan1", "fan2", "fan3"} {
+			if !seen[name] {
+				t.Errorf("expected %s in favorited-by list", name)
+			}
+		}
+	})
+
+	t.Run("returns 404 for unknown article", func(t *testing.T) {
+		setup := newTestArticleHandler(t)
+		defer setup.db.Close()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/missing/favorited-by", nil)
+		req.SetPathValue("slug", "missing")
+		w := httptest.NewRecorder()
+
+		setup.handler.ListFavoritingUsers(w, req)
+
+		if w.Code != http.StatusNotFound {
+			t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+		}
+	})
+}
@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// BatchResult is the shared response shape for batch endpoints. Each input
+// gets its own entry so a batch can partially succeed.
+type BatchResult[T any] struct {
+	Results []BatchItemResult[T] `json:"results"`
+}
+
+// BatchItemResult reports the outcome for one input of a batch. Status is the
+// HTTP status the item would have received as a single request.
+type BatchItemResult[T any] struct {
+	Input  any                 `json:"input"`
+	Status int                 `json:"status"`
+	Data   *T                  `json:"data,omitempty"`
+	Error  map[string][]string `json:"error,omitempty"`
+}
+
+// NewBatchResult creates an empty batch result
+func NewBatchResult[T any]() *BatchResult[T] {
+	return &BatchResult[T]{
+		Results: []BatchItemResult[T]{},
+	}
+}
+
+// AddSuccess records a successful item
+func (b *BatchResult[T]) AddSuccess(input any, status int, data T) {
+	b.Results = append(b.Results, BatchItemResult[T]{
+		Input:  input,
+		Status: status,
+		Data:   &data,
+	})
+}
+
+// AddError records a failed item using the RealWorld error format
+func (b *BatchResult[T]) AddError(input any, status int, field, message string) {
+	b.Results = append(b.Results, BatchItemResult[T]{
+		Input:  input,
+		Status: status,
+		Error:  map[string][]string{field: {message}},
+	})
+}
+
+// writeBatchResult writes a batch response. The batch itself always succeeds
+// with 200; callers inspect each item's status.
+func writeBatchResult[T any](w http.ResponseWriter, result *BatchResult[T]) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(result)
+}
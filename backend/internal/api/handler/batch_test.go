@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWriteBatchResult(t *testing.T) {
+	t.Run("serializes mixed success and failure items", func(t *testing.T) {
+		result := NewBatchResult[ProfileResponseBody]()
+		result.AddSuccess("alice", http.StatusOK, ProfileResponseBody{Username: "alice", Following: true})
+		result.AddError("ghost", http.StatusNotFound, "profile", "profile not found")
+
+		w := httptest.NewRecorder()
+		writeBatchResult(w, result)
+
+		if w.Code != http.StatusOK {
+			t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
+		}
+
+		var resp struct {
+			Results []map[string]json.RawMessage `json:"results"`
+		}
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if len(resp.Results) != 2 {
+			t.Fatalf("expected 2 results, got %d", len(resp.Results))
+		}
+
+		ok := resp.Results[0]
+		if string(ok["input"]) != `"alice"` || string(ok["status"]) != "200" {
+			t.Errorf("unexpected success item: %s %s", ok["input"], ok["status"])
+		}
+		if _, hasError := ok["error"]; hasError {
+			t.Error("expected success item to omit error")
+		}
+		var profile ProfileResponseBody
+		if err := json.Unmarshal(ok["data"], &profile); err != nil || profile.Username != "alice" {
+			t.Errorf("unexpected success data: %s", ok["data"])
+		}
+
+		failed := resp.Results[1]
+		if string(failed["input"]) != `"ghost"` || string(failed["status"]) != "404" {
+			t.Errorf("unexpected failure item: %s %s", failed["input"], failed["status"])
+		}
+		if _, hasData := failed["data"]; hasData {
+			t.Error("expected failure item to omit data")
+		}
+		var errs map[string][]string
+		if err := json.Unmarshal(failed["error"], &errs); err != nil || errs["profile"][0] != "profile not found" {
+			t.Errorf("unexpected failure error: %s", failed["error"])
+		}
+	})
+
+	t.Run("serializes an empty batch as an empty list", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		writeBatchResult(w, NewBatchResult[ProfileResponseBody]())
+
+		if body := w.Body.String(); body != "{\"results\":[]}\n" {
+			t.Errorf("unexpected body %q", body)
+		}
+	})
+}
@@ -0,0 +1,17 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+)
+
+// decodeErrorResponse maps a request body decode error to the status and
+// message reported under "body": 413 when the body exceeded the size limit
+// set by middleware.MaxBodyBytes, 422 for anything else.
+func decodeErrorResponse(err error) (int, string) {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return http.StatusRequestEntityTooLarge, "request body too large"
+	}
+	return http.StatusUnprocessableEntity, "invalid request body"
+}
@@ -2,19 +2,25 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
+	"io"
 	"log/slog"
 	"net/http"
 	"strconv"
-	"strings"
 
 	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
+	"github.com/alexlee0213/realworld-conduit/backend/internal/markdown"
 	"github.com/alexlee0213/realworld-conduit/backend/internal/service"
+	"github.com/alexlee0213/realworld-conduit/backend/internal/timefmt"
 )
 
 // CommentHandler handles comment-related HTTP requests
 type CommentHandler struct {
 	commentService *service.CommentService
 	logger         *slog.Logger
+
+	// deleteReturnsBody answers deletes with 200 and a JSON body instead of 204
+	deleteReturnsBody bool
 }
 
 // NewCommentHandler creates a new CommentHandler instance
@@ -25,47 +31,167 @@ func NewCommentHandler(commentService *service.CommentService, logger *slog.Logg
 	}
 }
 
+// SetDeleteReturnsBody makes delete endpoints return 200 with a JSON
+// confirmation instead of 204 No Content
+func (h *CommentHandler) SetDeleteReturnsBody(enabled bool) {
+	h.deleteReturnsBody = enabled
+}
+
 // CreateCommentRequest represents the create comment request body
 type CreateCommentRequest struct {
+	Comment struct {
+		Body     string `json:"body"`
+		ParentID *int64 `json:"parentId,omitempty"`
+	} `json:"comment"`
+}
+
+// UpdateCommentRequest represents the update comment request body
+type UpdateCommentRequest struct {
 	Comment struct {
 		Body string `json:"body"`
 	} `json:"comment"`
 }
 
+// ReportCommentRequest represents the report comment request body
+// The body is optional; a report without a reason is accepted
+type ReportCommentRequest struct {
+	Report struct {
+		Reason string `json:"reason"`
+	} `json:"report"`
+}
+
+// ReportResponse represents a single comment report response
+type ReportResponse struct {
+	Report ReportResponseBody `json:"report"`
+}
+
+// ReportsResponse represents a paginated list of comment reports
+type ReportsResponse struct {
+	Reports      []ReportResponseBody `json:"reports"`
+	ReportsCount int                  `json:"reportsCount"`
+}
+
+// ReportResponseBody represents the comment report data in responses
+type ReportResponseBody struct {
+	ID          int64  `json:"id"`
+	CommentID   int64  `json:"commentId"`
+	Reason      string `json:"reason"`
+	CreatedAt   string `json:"createdAt"`
+	CommentBody string `json:"commentBody,omitempty"`
+	ArticleSlug string `json:"articleSlug,omitempty"`
+	Reporter    string `json:"reporter,omitempty"`
+}
+
 // CommentResponse represents a single comment response
 type CommentResponse struct {
 	Comment CommentResponseBody `json:"comment"`
 }
 
-// CommentsResponse represents a list of comments response
+// CommentsResponse represents a paginated list of an article's comments
 type CommentsResponse struct {
-	Comments []CommentResponseBody `json:"comments"`
+	Comments      []CommentResponseBody `json:"comments"`
+	CommentsCount int                   `json:"commentsCount"`
+}
+
+// AuthorCommentsResponse represents a paginated list of a user's comments
+type AuthorCommentsResponse struct {
+	Comments      []CommentResponseBody `json:"comments"`
+	CommentsCount int                   `json:"commentsCount"`
 }
 
 // CommentResponseBody represents the comment data in responses
 type CommentResponseBody struct {
 	ID        int64               `json:"id"`
 	Body      string              `json:"body"`
+	BodyHTML  string              `json:"bodyHtml"`
+	ParentID  *int64              `json:"parentId"`
 	CreatedAt string              `json:"createdAt"`
 	UpdatedAt string              `json:"updatedAt"`
 	Author    ProfileResponseBody `json:"author"`
+	Article   *CommentArticleBody `json:"article,omitempty"`
+}
+
+// CommentArticleBody identifies the article a comment belongs to
+type CommentArticleBody struct {
+	Slug  string `json:"slug"`
+	Title string `json:"title"`
 }
 
 // GetComments handles GET /api/articles/{slug}/comments
+// Supports ?sort=oldest|newest; without it the configured default order is used
+// Pages with ?limit= (default 20, max 100) and ?offset=
 func (h *CommentHandler) GetComments(w http.ResponseWriter, r *http.Request) {
-	slug := h.extractSlugFromPath(r.URL.Path)
+	slug := r.PathValue("slug")
 	if slug == "" {
 		h.writeError(w, http.StatusNotFound, "article", "article not found")
 		return
 	}
 
-	comments, err := h.commentService.GetCommentsByArticleSlug(r.Context(), slug)
+	params := &domain.CommentListParams{
+		Limit:  h.parseIntParam(r.URL.Query().Get("limit"), 20),
+		Offset: h.parseIntParam(r.URL.Query().Get("offset"), 0),
+	}
+	if value := r.URL.Query().Get("sort"); value != "" {
+		parsed, ok := domain.ParseCommentSort(value)
+		if !ok {
+			h.writeError(w, http.StatusUnprocessableEntity, "sort", "must be one of: oldest, newest")
+			return
+		}
+		params.Sort = parsed
+	}
+
+	var currentUserID *int64
+	if userID, ok := r.Context().Value(UserIDContextKey).(int64); ok {
+		currentUserID = &userID
+	}
+
+	comments, total, err := h.commentService.GetCommentsByArticleSlug(r.Context(), slug, params, currentUserID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	setPaginationLinks(w, r, params.Limit, params.Offset, total)
+	h.writeCommentsResponse(w, http.StatusOK, comments, total)
+}
+
+// GetCommentsByAuthor handles GET /api/profiles/{username}/comments
+func (h *CommentHandler) GetCommentsByAuthor(w http.ResponseWriter, r *http.Request) {
+	username := r.PathValue("username")
+	if username == "" {
+		h.writeError(w, http.StatusNotFound, "profile", "profile not found")
+		return
+	}
+
+	limit := h.parseIntParam(r.URL.Query().Get("limit"), 20)
+	offset := h.parseIntParam(r.URL.Query().Get("offset"), 0)
+
+	comments, total, err := h.commentService.GetCommentsByAuthorUsername(r.Context(), username, limit, offset)
 	if err != nil {
 		h.handleServiceError(w, err)
 		return
 	}
 
-	h.writeCommentsResponse(w, http.StatusOK, comments)
+	setPaginationLinks(w, r, limit, offset, total)
+
+	commentBodies := make([]CommentResponseBody, 0, len(comments))
+	for _, comment := range comments {
+		body := h.toCommentResponseBody(comment)
+		body.Article = &CommentArticleBody{
+			Slug:  comment.ArticleSlug,
+			Title: comment.ArticleTitle,
+		}
+		commentBodies = append(commentBodies, body)
+	}
+
+	resp := AuthorCommentsResponse{
+		Comments:      commentBodies,
+		CommentsCount: total,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(resp)
 }
 
 // CreateComment handles POST /api/articles/{slug}/comments
@@ -76,7 +202,7 @@ func (h *CommentHandler) CreateComment(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	slug := h.extractSlugFromPath(r.URL.Path)
+	slug := r.PathValue("slug")
 	if slug == "" {
 		h.writeError(w, http.StatusNotFound, "article", "article not found")
 		return
@@ -85,12 +211,14 @@ func (h *CommentHandler) CreateComment(w http.ResponseWriter, r *http.Request) {
 	var req CreateCommentRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.logger.Debug("failed to decode create comment request", "error", err)
-		h.writeError(w, http.StatusUnprocessableEntity, "body", "invalid request body")
+		status, message := decodeErrorResponse(err)
+		h.writeError(w, status, "body", message)
 		return
 	}
 
 	input := &domain.CreateCommentInput{
-		Body: req.Comment.Body,
+		Body:     req.Comment.Body,
+		ParentID: req.Comment.ParentID,
 	}
 
 	comment, err := h.commentService.CreateComment(r.Context(), slug, userID, input)
@@ -102,6 +230,41 @@ func (h *CommentHandler) CreateComment(w http.ResponseWriter, r *http.Request) {
 	h.writeCommentResponse(w, http.StatusCreated, comment)
 }
 
+// UpdateComment handles PUT /api/articles/{slug}/comments/{id}
+func (h *CommentHandler) UpdateComment(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(UserIDContextKey).(int64)
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "token", "authorization required")
+		return
+	}
+
+	slug, commentID := h.extractSlugAndCommentID(r)
+	if slug == "" || commentID == 0 {
+		h.writeError(w, http.StatusNotFound, "comment", "comment not found")
+		return
+	}
+
+	var req UpdateCommentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Debug("failed to decode update comment request", "error", err)
+		status, message := decodeErrorResponse(err)
+		h.writeError(w, status, "body", message)
+		return
+	}
+
+	input := &domain.UpdateCommentInput{
+		Body: req.Comment.Body,
+	}
+
+	comment, err := h.commentService.UpdateComment(r.Context(), slug, commentID, userID, input)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeCommentResponse(w, http.StatusOK, comment)
+}
+
 // DeleteComment handles DELETE /api/articles/{slug}/comments/{id}
 func (h *CommentHandler) DeleteComment(w http.ResponseWriter, r *http.Request) {
 	userID, ok := r.Context().Value(UserIDContextKey).(int64)
@@ -110,7 +273,7 @@ func (h *CommentHandler) DeleteComment(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	slug, commentID := h.extractSlugAndCommentID(r.URL.Path)
+	slug, commentID := h.extractSlugAndCommentID(r)
 	if slug == "" || commentID == 0 {
 		h.writeError(w, http.StatusNotFound, "comment", "comment not found")
 		return
@@ -122,45 +285,115 @@ func (h *CommentHandler) DeleteComment(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	w.WriteHeader(http.StatusNoContent)
+	writeDeleted(w, r, h.deleteReturnsBody, DeleteResponseBody{Type: "comment", ID: commentID})
 }
 
-// extractSlugFromPath extracts the article slug from paths like /api/articles/{slug}/comments
-func (h *CommentHandler) extractSlugFromPath(path string) string {
-	// Path format: /api/articles/{slug}/comments
-	const prefix = "/api/articles/"
-	const suffix = "/comments"
+// ReportComment handles POST /api/articles/{slug}/comments/{id}/report
+// Reporting a comment again returns the existing report with 200
+func (h *CommentHandler) ReportComment(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(UserIDContextKey).(int64)
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "token", "authorization required")
+		return
+	}
+
+	slug, commentID := h.extractSlugAndCommentID(r)
+	if slug == "" || commentID == 0 {
+		h.writeError(w, http.StatusNotFound, "comment", "comment not found")
+		return
+	}
 
-	path = strings.TrimPrefix(path, prefix)
+	var req ReportCommentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.logger.Debug("failed to decode report comment request", "error", err)
+		status, message := decodeErrorResponse(err)
+		h.writeError(w, status, "body", message)
+		return
+	}
 
-	// Find the first slash after the slug
-	if idx := strings.Index(path, "/"); idx != -1 {
-		return path[:idx]
+	input := &domain.ReportCommentInput{
+		Reason: req.Report.Reason,
 	}
-	return ""
+
+	report, err := h.commentService.ReportComment(r.Context(), slug, commentID, userID, input)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(ReportResponse{Report: toReportResponseBody(report)})
 }
 
-// extractSlugAndCommentID extracts slug and comment ID from paths like /api/articles/{slug}/comments/{id}
-func (h *CommentHandler) extractSlugAndCommentID(path string) (string, int64) {
-	// Path format: /api/articles/{slug}/comments/{id}
-	const prefix = "/api/articles/"
+// ListReports handles GET /api/admin/reports
+// Restricted to maintainers; pages with ?limit= (default 20, max 100) and ?offset=
+func (h *CommentHandler) ListReports(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(UserIDContextKey).(int64)
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "token", "authorization required")
+		return
+	}
 
-	path = strings.TrimPrefix(path, prefix)
-	parts := strings.Split(path, "/")
+	limit := h.parseIntParam(r.URL.Query().Get("limit"), 20)
+	offset := h.parseIntParam(r.URL.Query().Get("offset"), 0)
 
-	if len(parts) < 3 || parts[1] != "comments" {
-		return "", 0
+	reports, total, err := h.commentService.ListReports(r.Context(), userID, limit, offset)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	setPaginationLinks(w, r, limit, offset, total)
+
+	reportBodies := make([]ReportResponseBody, 0, len(reports))
+	for _, report := range reports {
+		reportBodies = append(reportBodies, toReportResponseBody(report))
+	}
+
+	resp := ReportsResponse{
+		Reports:      reportBodies,
+		ReportsCount: total,
 	}
 
-	slug := parts[0]
-	commentIDStr := parts[2]
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// toReportResponseBody converts a domain comment report to response body
+func toReportResponseBody(report *domain.CommentReport) ReportResponseBody {
+	return ReportResponseBody{
+		ID:          report.ID,
+		CommentID:   report.CommentID,
+		Reason:      report.Reason,
+		CreatedAt:   timefmt.FormatRFC3339Millis(report.CreatedAt),
+		CommentBody: report.CommentBody,
+		ArticleSlug: report.ArticleSlug,
+		Reporter:    report.ReporterUsername,
+	}
+}
 
-	commentID, err := strconv.ParseInt(commentIDStr, 10, 64)
+// extractSlugAndCommentID reads the {slug} and {id} path values. A
+// non-numeric id yields an empty slug and zero ID.
+func (h *CommentHandler) extractSlugAndCommentID(r *http.Request) (string, int64) {
+	commentID, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
 	if err != nil {
 		return "", 0
 	}
+	return r.PathValue("slug"), commentID
+}
 
-	return slug, commentID
+// parseIntParam parses an integer query parameter with a default value
+func (h *CommentHandler) parseIntParam(value string, defaultValue int) int {
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return defaultValue
+	}
+	return parsed
 }
 
 // writeCommentResponse writes a single comment response
@@ -174,15 +407,16 @@ func (h *CommentHandler) writeCommentResponse(w http.ResponseWriter, status int,
 	json.NewEncoder(w).Encode(resp)
 }
 
-// writeCommentsResponse writes a list of comments response
-func (h *CommentHandler) writeCommentsResponse(w http.ResponseWriter, status int, comments []*domain.Comment) {
+// writeCommentsResponse writes a page of comments with the total count
+func (h *CommentHandler) writeCommentsResponse(w http.ResponseWriter, status int, comments []*domain.Comment, total int) {
 	commentBodies := make([]CommentResponseBody, 0, len(comments))
 	for _, comment := range comments {
 		commentBodies = append(commentBodies, h.toCommentResponseBody(comment))
 	}
 
 	resp := CommentsResponse{
-		Comments: commentBodies,
+		Comments:      commentBodies,
+		CommentsCount: total,
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -195,8 +429,10 @@ func (h *CommentHandler) toCommentResponseBody(comment *domain.Comment) CommentR
 	body := CommentResponseBody{
 		ID:        comment.ID,
 		Body:      comment.Body,
-		CreatedAt: comment.CreatedAt.UTC().Format("2006-01-02T15:04:05.000Z"),
-		UpdatedAt: comment.UpdatedAt.UTC().Format("2006-01-02T15:04:05.000Z"),
+		BodyHTML:  markdown.Render(comment.Body),
+		ParentID:  comment.ParentID,
+		CreatedAt: timefmt.FormatRFC3339Millis(comment.CreatedAt),
+		UpdatedAt: timefmt.FormatRFC3339Millis(comment.UpdatedAt),
 	}
 
 	// Add author profile if available
@@ -205,7 +441,7 @@ func (h *CommentHandler) toCommentResponseBody(comment *domain.Comment) CommentR
 			Username:  comment.Author.Username,
 			Bio:       comment.Author.Bio,
 			Image:     comment.Author.Image,
-			Following: false, // TODO: Implement following status
+			Following: comment.AuthorFollowing,
 		}
 	}
 
@@ -245,6 +481,16 @@ func (h *CommentHandler) handleServiceError(w http.ResponseWriter, err error) {
 			h.writeError(w, http.StatusNotFound, "article", "article not found")
 		} else if err == domain.ErrCommentNotFound {
 			h.writeError(w, http.StatusNotFound, "comment", "comment not found")
+		} else if err == domain.ErrUserNotFound {
+			h.writeError(w, http.StatusNotFound, "profile", "profile not found")
+		} else if err == domain.ErrInvalidParentComment {
+			h.writeError(w, http.StatusUnprocessableEntity, "parentId", "must be a comment on the same article")
+		} else if err == domain.ErrCommentTooSoon {
+			h.writeError(w, http.StatusTooManyRequests, "comment", "please wait before commenting again")
+		} else if err == domain.ErrCommentsDisabled {
+			h.writeError(w, http.StatusForbidden, "comments_disabled", "comments are disabled for this article")
+		} else if err == domain.ErrAccountTooNew {
+			h.writeError(w, http.StatusForbidden, "account_too_new", "your account is too new to post")
 		} else if err == domain.ErrForbidden {
 			h.writeError(w, http.StatusForbidden, "comment", "you are not authorized to perform this action")
 		} else if err == domain.ErrUnauthorized {
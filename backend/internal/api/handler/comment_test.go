@@ -5,10 +5,12 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -62,6 +64,12 @@ func setupCommentTestDB(t *testing.T) (*sql.DB, func()) {
 			title TEXT NOT NULL,
 			description TEXT NOT NULL DEFAULT '',
 			body TEXT NOT NULL DEFAULT '',
+			cover_image TEXT NOT NULL DEFAULT '',
+			published BOOLEAN NOT NULL DEFAULT 1,
+			comments_enabled BOOLEAN NOT NULL DEFAULT 1,
+			publish_at TIMESTAMP,
+			view_count INTEGER NOT NULL DEFAULT 0,
+			favorites_count INTEGER NOT NULL DEFAULT 0,
 			author_id INTEGER NOT NULL,
 			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
 			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
@@ -120,6 +128,7 @@ func setupCommentTestDB(t *testing.T) (*sql.DB, func()) {
 			body TEXT NOT NULL,
 			article_id INTEGER NOT NULL,
 			author_id INTEGER NOT NULL,
+			parent_id INTEGER REFERENCES comments(id) ON DELETE SET NULL,
 			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
 			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
 			FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE,
@@ -130,6 +139,23 @@ func setupCommentTestDB(t *testing.T) (*sql.DB, func()) {
 		t.Fatalf("failed to create comments table: %v", err)
 	}
 
+	// Create comment reports table
+	_, err = db.Exec(`
+		CREATE TABLE comment_reports (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			comment_id INTEGER NOT NULL,
+			reporter_id INTEGER NOT NULL,
+			reason TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			UNIQUE (comment_id, reporter_id),
+			FOREIGN KEY (comment_id) REFERENCES comments(id) ON DELETE CASCADE,
+			FOREIGN KEY (reporter_id) REFERENCES users(id) ON DELETE CASCADE
+		)
+	`)
+	if err != nil {
+		t.Fatalf("failed to create comment_reports table: %v", err)
+	}
+
 	return db, func() {
 		db.Close()
 	}
@@ -176,7 +202,8 @@ func setupCommentHandler(t *testing.T, db *sql.DB) *CommentHandler {
 	userRepo := repository.NewSQLiteUserRepository(db, logger)
 	articleRepo := repository.NewSQLiteArticleRepository(db, logger)
 	commentRepo := repository.NewSQLiteCommentRepository(db, logger)
-	commentService := service.NewCommentService(commentRepo, articleRepo, userRepo, logger)
+	followRepo := repository.NewSQLiteFollowRepository(db, logger)
+	commentService := service.NewCommentService(commentRepo, articleRepo, userRepo, followRepo, logger)
 	return NewCommentHandler(commentService, logger)
 }
 
@@ -195,7 +222,7 @@ func TestCommentHandler_GetComments(t *testing.T) {
 		req := httptest.NewRequest("GET", "/api/articles/test-article/comments", nil)
 		w := httptest.NewRecorder()
 
-		handler.GetComments(w, req)
+		handler.GetComments(w, withPathValues("/api/articles/{slug}/comments", req))
 
 		if w.Code != http.StatusOK {
 			t.Errorf("GetComments() status = %v, want %v", w.Code, http.StatusOK)
@@ -209,13 +236,71 @@ func TestCommentHandler_GetComments(t *testing.T) {
 		if len(resp.Comments) != 2 {
 			t.Errorf("GetComments() count = %v, want 2", len(resp.Comments))
 		}
+		if resp.CommentsCount != 2 {
+			t.Errorf("GetComments() commentsCount = %v, want 2", resp.CommentsCount)
+		}
+	})
+
+	t.Run("pages with limit and offset", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/articles/test-article/comments?limit=1&offset=1", nil)
+		w := httptest.NewRecorder()
+
+		handler.GetComments(w, withPathValues("/api/articles/{slug}/comments", req))
+
+		var resp CommentsResponse
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if len(resp.Comments) != 1 || resp.Comments[0].Body != "Second comment" {
+			t.Errorf("GetComments() comments = %+v, want only the second comment", resp.Comments)
+		}
+		if resp.CommentsCount != 2 {
+			t.Errorf("GetComments() commentsCount = %v, want 2", resp.CommentsCount)
+		}
+	})
+
+	t.Run("orders comments by sort param", func(t *testing.T) {
+		tests := []struct {
+			query string
+			first string
+		}{
+			{query: "", first: "First comment"},
+			{query: "?sort=oldest", first: "First comment"},
+			{query: "?sort=newest", first: "Second comment"},
+		}
+
+		for _, tt := range tests {
+			req := httptest.NewRequest("GET", "/api/articles/test-article/comments"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			handler.GetComments(w, withPathValues("/api/articles/{slug}/comments", req))
+
+			var resp CommentsResponse
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if len(resp.Comments) == 0 || resp.Comments[0].Body != tt.first {
+				t.Errorf("GetComments(%q) first comment = %+v, want %q", tt.query, resp.Comments, tt.first)
+			}
+		}
+	})
+
+	t.Run("rejects unknown sort", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/articles/test-article/comments?sort=random", nil)
+		w := httptest.NewRecorder()
+
+		handler.GetComments(w, withPathValues("/api/articles/{slug}/comments", req))
+
+		if w.Code != http.StatusUnprocessableEntity {
+			t.Errorf("GetComments() status = %v, want %v", w.Code, http.StatusUnprocessableEntity)
+		}
 	})
 
 	t.Run("get comments for non-existing article", func(t *testing.T) {
 		req := httptest.NewRequest("GET", "/api/articles/non-existing/comments", nil)
 		w := httptest.NewRecorder()
 
-		handler.GetComments(w, req)
+		handler.GetComments(w, withPathValues("/api/articles/{slug}/comments", req))
 
 		if w.Code != http.StatusNotFound {
 			t.Errorf("GetComments() status = %v, want %v", w.Code, http.StatusNotFound)
@@ -239,7 +324,7 @@ func TestCommentHandler_CreateComment(t *testing.T) {
 		req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, authorID))
 		w := httptest.NewRecorder()
 
-		handler.CreateComment(w, req)
+		handler.CreateComment(w, withPathValues("/api/articles/{slug}/comments", req))
 
 		if w.Code != http.StatusCreated {
 			t.Errorf("CreateComment() status = %v, want %v, body: %s", w.Code, http.StatusCreated, w.Body.String())
@@ -255,13 +340,40 @@ func TestCommentHandler_CreateComment(t *testing.T) {
 		}
 	})
 
+	t.Run("renders bodyHtml safely and keeps the raw body", func(t *testing.T) {
+		raw := "Nice **post** <img src=x onerror=alert(1)><script>alert(2)</script>"
+		body, _ := json.Marshal(map[string]any{"comment": map[string]string{"body": raw}})
+		req := httptest.NewRequest("POST", "/api/articles/test-article/comments", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, authorID))
+		w := httptest.NewRecorder()
+
+		handler.CreateComment(w, withPathValues("/api/articles/{slug}/comments", req))
+
+		var resp CommentResponse
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if resp.Comment.Body != raw {
+			t.Errorf("CreateComment() body = %q, want %q", resp.Comment.Body, raw)
+		}
+		for _, unsafe := range []string{"<img", "<script"} {
+			if strings.Contains(resp.Comment.BodyHTML, unsafe) {
+				t.Errorf("CreateComment() bodyHtml = %q, must not contain %q", resp.Comment.BodyHTML, unsafe)
+			}
+		}
+		if !strings.Contains(resp.Comment.BodyHTML, "<strong>post</strong>") {
+			t.Errorf("CreateComment() bodyHtml = %q, want rendered markdown", resp.Comment.BodyHTML)
+		}
+	})
+
 	t.Run("create comment without auth", func(t *testing.T) {
 		body := `{"comment": {"body": "This is a test comment"}}`
 		req := httptest.NewRequest("POST", "/api/articles/test-article/comments", bytes.NewBufferString(body))
 		req.Header.Set("Content-Type", "application/json")
 		w := httptest.NewRecorder()
 
-		handler.CreateComment(w, req)
+		handler.CreateComment(w, withPathValues("/api/articles/{slug}/comments", req))
 
 		if w.Code != http.StatusUnauthorized {
 			t.Errorf("CreateComment() status = %v, want %v", w.Code, http.StatusUnauthorized)
@@ -275,7 +387,7 @@ func TestCommentHandler_CreateComment(t *testing.T) {
 		req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, authorID))
 		w := httptest.NewRecorder()
 
-		handler.CreateComment(w, req)
+		handler.CreateComment(w, withPathValues("/api/articles/{slug}/comments", req))
 
 		if w.Code != http.StatusUnprocessableEntity {
 			t.Errorf("CreateComment() status = %v, want %v", w.Code, http.StatusUnprocessableEntity)
@@ -289,12 +401,50 @@ func TestCommentHandler_CreateComment(t *testing.T) {
 		req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, authorID))
 		w := httptest.NewRecorder()
 
-		handler.CreateComment(w, req)
+		handler.CreateComment(w, withPathValues("/api/articles/{slug}/comments", req))
 
 		if w.Code != http.StatusNotFound {
 			t.Errorf("CreateComment() status = %v, want %v", w.Code, http.StatusNotFound)
 		}
 	})
+
+	t.Run("reply to a comment", func(t *testing.T) {
+		parentID := createCommentTestComment(t, db, "Parent comment", 1, authorID)
+
+		body := fmt.Sprintf(`{"comment": {"body": "A reply", "parentId": %d}}`, parentID)
+		req := httptest.NewRequest("POST", "/api/articles/test-article/comments", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, authorID))
+		w := httptest.NewRecorder()
+
+		handler.CreateComment(w, withPathValues("/api/articles/{slug}/comments", req))
+
+		if w.Code != http.StatusCreated {
+			t.Fatalf("CreateComment() status = %v, want %v, body: %s", w.Code, http.StatusCreated, w.Body.String())
+		}
+
+		var resp CommentResponse
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if resp.Comment.ParentID == nil || *resp.Comment.ParentID != parentID {
+			t.Errorf("CreateComment() parentId = %v, want %v", resp.Comment.ParentID, parentID)
+		}
+	})
+
+	t.Run("reply to a missing comment", func(t *testing.T) {
+		body := `{"comment": {"body": "A reply", "parentId": 9999}}`
+		req := httptest.NewRequest("POST", "/api/articles/test-article/comments", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, authorID))
+		w := httptest.NewRecorder()
+
+		handler.CreateComment(w, withPathValues("/api/articles/{slug}/comments", req))
+
+		if w.Code != http.StatusUnprocessableEntity {
+			t.Errorf("CreateComment() status = %v, want %v", w.Code, http.StatusUnprocessableEntity)
+		}
+	})
 }
 
 func TestCommentHandler_DeleteComment(t *testing.T) {
@@ -313,7 +463,7 @@ func TestCommentHandler_DeleteComment(t *testing.T) {
 		req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, authorID))
 		w := httptest.NewRecorder()
 
-		handler.DeleteComment(w, req)
+		handler.DeleteComment(w, withPathValues("/api/articles/{slug}/comments/{id}", req))
 
 		if w.Code != http.StatusNoContent {
 			t.Errorf("DeleteComment() status = %v, want %v", w.Code, http.StatusNoContent)
@@ -328,7 +478,7 @@ func TestCommentHandler_DeleteComment(t *testing.T) {
 		req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, otherUserID))
 		w := httptest.NewRecorder()
 
-		handler.DeleteComment(w, req)
+		handler.DeleteComment(w, withPathValues("/api/articles/{slug}/comments/{id}", req))
 
 		if w.Code != http.StatusForbidden {
 			t.Errorf("DeleteComment() status = %v, want %v", w.Code, http.StatusForbidden)
@@ -340,7 +490,7 @@ func TestCommentHandler_DeleteComment(t *testing.T) {
 		req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, authorID))
 		w := httptest.NewRecorder()
 
-		handler.DeleteComment(w, req)
+		handler.DeleteComment(w, withPathValues("/api/articles/{slug}/comments/{id}", req))
 
 		if w.Code != http.StatusNotFound {
 			t.Errorf("DeleteComment() status = %v, want %v", w.Code, http.StatusNotFound)
@@ -351,7 +501,7 @@ func TestCommentHandler_DeleteComment(t *testing.T) {
 		req := httptest.NewRequest("DELETE", "/api/articles/test-article/comments/1", nil)
 		w := httptest.NewRecorder()
 
-		handler.DeleteComment(w, req)
+		handler.DeleteComment(w, withPathValues("/api/articles/{slug}/comments/{id}", req))
 
 		if w.Code != http.StatusUnauthorized {
 			t.Errorf("DeleteComment() status = %v, want %v", w.Code, http.StatusUnauthorized)
@@ -361,3 +511,321 @@ func TestCommentHandler_DeleteComment(t *testing.T) {
 	// Suppress unused variable warning
 	_ = commentID
 }
+
+func TestCommentHandler_UpdateComment(t *testing.T) {
+	db, cleanup := setupCommentTestDB(t)
+	defer cleanup()
+
+	handler := setupCommentHandler(t, db)
+
+	authorID := createCommentTestUser(t, db, "testuser", "test@example.com")
+	otherUserID := createCommentTestUser(t, db, "otheruser", "other@example.com")
+	articleID := createCommentTestArticle(t, db, "test-article", "Test Article", authorID)
+	commentID := createCommentTestComment(t, db, "Test comment", articleID, authorID)
+	target := fmt.Sprintf("/api/articles/test-article/comments/%d", commentID)
+
+	t.Run("update comment successfully", func(t *testing.T) {
+		body := `{"comment":{"body":"Edited comment"}}`
+		req := httptest.NewRequest("PUT", target, bytes.NewBufferString(body))
+		req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, authorID))
+		w := httptest.NewRecorder()
+
+		handler.UpdateComment(w, withPathValues("/api/articles/{slug}/comments/{id}", req))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("UpdateComment() status = %v, want %v: %s", w.Code, http.StatusOK, w.Body.String())
+		}
+
+		var resp CommentResponse
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if resp.Comment.ID != commentID {
+			t.Errorf("UpdateComment() id = %v, want %v", resp.Comment.ID, commentID)
+		}
+		if resp.Comment.Body != "Edited comment" {
+			t.Errorf("UpdateComment() body = %q, want %q", resp.Comment.Body, "Edited comment")
+		}
+		if resp.Comment.Author.Username != "testuser" {
+			t.Errorf("UpdateComment() author = %q, want %q", resp.Comment.Author.Username, "testuser")
+		}
+	})
+
+	t.Run("update comment by non-author fails", func(t *testing.T) {
+		body := `{"comment":{"body":"Hijacked"}}`
+		req := httptest.NewRequest("PUT", target, bytes.NewBufferString(body))
+		req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, otherUserID))
+		w := httptest.NewRecorder()
+
+		handler.UpdateComment(w, withPathValues("/api/articles/{slug}/comments/{id}", req))
+
+		if w.Code != http.StatusForbidden {
+			t.Errorf("UpdateComment() status = %v, want %v", w.Code, http.StatusForbidden)
+		}
+	})
+
+	t.Run("update comment with empty body fails", func(t *testing.T) {
+		body := `{"comment":{"body":"   "}}`
+		req := httptest.NewRequest("PUT", target, bytes.NewBufferString(body))
+		req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, authorID))
+		w := httptest.NewRecorder()
+
+		handler.UpdateComment(w, withPathValues("/api/articles/{slug}/comments/{id}", req))
+
+		if w.Code != http.StatusUnprocessableEntity {
+			t.Errorf("UpdateComment() status = %v, want %v", w.Code, http.StatusUnprocessableEntity)
+		}
+	})
+
+	t.Run("update non-existing comment", func(t *testing.T) {
+		body := `{"comment":{"body":"Edited comment"}}`
+		req := httptest.NewRequest("PUT", "/api/articles/test-article/comments/9999", bytes.NewBufferString(body))
+		req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, authorID))
+		w := httptest.NewRecorder()
+
+		handler.UpdateComment(w, withPathValues("/api/articles/{slug}/comments/{id}", req))
+
+		if w.Code != http.StatusNotFound {
+			t.Errorf("UpdateComment() status = %v, want %v", w.Code, http.StatusNotFound)
+		}
+	})
+}
+
+func TestCommentHandler_ReportComment(t *testing.T) {
+	db, cleanup := setupCommentTestDB(t)
+	defer cleanup()
+
+	handler := setupCommentHandler(t, db)
+
+	authorID := createCommentTestUser(t, db, "testuser", "test@example.com")
+	reporterID := createCommentTestUser(t, db, "reporter", "reporter@example.com")
+	articleID := createCommentTestArticle(t, db, "test-article", "Test Article", authorID)
+	commentID := createCommentTestComment(t, db, "Offensive comment", articleID, authorID)
+	target := fmt.Sprintf("/api/articles/test-article/comments/%d/report", commentID)
+
+	report := func(t *testing.T, target, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", target, bytes.NewBufferString(body))
+		req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, reporterID))
+		w := httptest.NewRecorder()
+		handler.ReportComment(w, withPathValues("/api/articles/{slug}/comments/{id}/report", req))
+		return w
+	}
+
+	t.Run("duplicate report is idempotent", func(t *testing.T) {
+		var ids []int64
+		for _, body := range []string{`{"report":{"reason":"spam"}}`, ""} {
+			w := report(t, target, body)
+			if w.Code != http.StatusOK {
+				t.Fatalf("ReportComment() status = %v, want %v: %s", w.Code, http.StatusOK, w.Body.String())
+			}
+
+			var resp ReportResponse
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if resp.Report.CommentID != commentID || resp.Report.Reason != "spam" {
+				t.Errorf("ReportComment() report = %+v", resp.Report)
+			}
+			ids = append(ids, resp.Report.ID)
+		}
+		if ids[0] != ids[1] {
+			t.Errorf("ReportComment() ids = %v, want the same report twice", ids)
+		}
+
+		var count int
+		db.QueryRow(`SELECT COUNT(*) FROM comment_reports`).Scan(&count)
+		if count != 1 {
+			t.Errorf("comment_reports rows = %d, want 1", count)
+		}
+	})
+
+	t.Run("report missing comment", func(t *testing.T) {
+		w := report(t, "/api/articles/test-article/comments/9999/report", "")
+		if w.Code != http.StatusNotFound {
+			t.Errorf("ReportComment() status = %v, want %v", w.Code, http.StatusNotFound)
+		}
+	})
+
+	t.Run("report without auth", func(t *testing.T) {
+		req := httptest.NewRequest("POST", target, nil)
+		w := httptest.NewRecorder()
+		handler.ReportComment(w, withPathValues("/api/articles/{slug}/comments/{id}/report", req))
+		if w.Code != http.StatusUnauthorized {
+			t.Errorf("ReportComment() status = %v, want %v", w.Code, http.StatusUnauthorized)
+		}
+	})
+
+	t.Run("list reports requires a maintainer", func(t *testing.T) {
+		config := service.DefaultCommentServiceConfig()
+		config.MaintainerIDs = []int64{authorID}
+		handler.commentService.SetConfig(config)
+
+		list := func(userID int64) *httptest.ResponseRecorder {
+			req := httptest.NewRequest("GET", "/api/admin/reports", nil)
+			req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, userID))
+			w := httptest.NewRecorder()
+			handler.ListReports(w, req)
+			return w
+		}
+
+		if w := list(reporterID); w.Code != http.StatusForbidden {
+			t.Errorf("ListReports() non-maintainer status = %v, want %v", w.Code, http.StatusForbidden)
+		}
+
+		w := list(authorID)
+		if w.Code != http.StatusOK {
+			t.Fatalf("ListReports() status = %v, want %v: %s", w.Code, http.StatusOK, w.Body.String())
+		}
+		var resp ReportsResponse
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if resp.ReportsCount != 1 || len(resp.Reports) != 1 || resp.Reports[0].Reporter != "reporter" {
+			t.Errorf("ListReports() = %+v", resp)
+		}
+	})
+}
+
+func TestCommentHandler_GetCommentsByAuthor(t *testing.T) {
+	db, cleanup := setupCommentTestDB(t)
+	defer cleanup()
+
+	handler := setupCommentHandler(t, db)
+
+	authorID := createCommentTestUser(t, db, "testuser", "test@example.com")
+	commenterID := createCommentTestUser(t, db, "commenter", "commenter@example.com")
+	firstID := createCommentTestArticle(t, db, "first-article", "First Article", authorID)
+	secondID := createCommentTestArticle(t, db, "second-article", "Second Article", authorID)
+	createCommentTestComment(t, db, "Nice first", firstID, commenterID)
+	createCommentTestComment(t, db, "Author reply", firstID, authorID)
+	createCommentTestComment(t, db, "Nice second", secondID, commenterID)
+
+	t.Run("lists the user's comments with article references", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/profiles/commenter/comments", nil)
+		req.SetPathValue("username", "commenter")
+		w := httptest.NewRecorder()
+
+		handler.GetCommentsByAuthor(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("GetCommentsByAuthor() status = %v, want %v", w.Code, http.StatusOK)
+		}
+
+		var resp AuthorCommentsResponse
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+
+		if resp.CommentsCount != 2 || len(resp.Comments) != 2 {
+			t.Fatalf("GetCommentsByAuthor() = %d comments (count %d), want 2", len(resp.Comments), resp.CommentsCount)
+		}
+		slugs := map[string]bool{}
+		for _, c := range resp.Comments {
+			if c.Author.Username != "commenter" {
+				t.Errorf("expected author 'commenter', got %q", c.Author.Username)
+			}
+			if c.Article == nil {
+				t.Fatal("expected article reference in comment")
+			}
+			slugs[c.Article.Slug] = true
+		}
+		if !slugs["first-article"] || !slugs["second-article"] {
+			t.Errorf("expected comments on both articles, got %v", slugs)
+		}
+	})
+
+	t.Run("paginates results", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/profiles/commenter/comments?limit=1&offset=1", nil)
+		req.SetPathValue("username", "commenter")
+		w := httptest.NewRecorder()
+
+		handler.GetCommentsByAuthor(w, req)
+
+		var resp AuthorCommentsResponse
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if resp.CommentsCount != 2 {
+			t.Errorf("GetCommentsByAuthor() count = %v, want 2", resp.CommentsCount)
+		}
+		if len(resp.Comments) != 1 {
+			t.Errorf("GetCommentsByAuthor() page size = %v, want 1", len(resp.Comments))
+		}
+	})
+
+	t.Run("returns 404 for unknown user", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/profiles/ghost/comments", nil)
+		req.SetPathValue("username", "ghost")
+		w := httptest.NewRecorder()
+
+		handler.GetCommentsByAuthor(w, req)
+
+		if w.Code != http.StatusNotFound {
+			t.Errorf("GetCommentsByAuthor() status = %v, want %v", w.Code, http.StatusNotFound)
+		}
+	})
+}
+
+func TestCommentHandler_DeleteComment_ResponseBody(t *testing.T) {
+	db, cleanup := setupCommentTestDB(t)
+	defer cleanup()
+
+	handler := setupCommentHandler(t, db)
+
+	authorID := createCommentTestUser(t, db, "testuser", "test@example.com")
+	articleID := createCommentTestArticle(t, db, "test-article", "Test Article", authorID)
+
+	t.Run("returns 204 by default", func(t *testing.T) {
+		commentID := createCommentTestComment(t, db, "Default mode", articleID, authorID)
+
+		req := httptest.NewRequest("DELETE", fmt.Sprintf("/api/articles/test-article/comments/%d", commentID), nil)
+		req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, authorID))
+		w := httptest.NewRecorder()
+
+		handler.DeleteComment(w, withPathValues("/api/articles/{slug}/comments/{id}", req))
+
+		if w.Code != http.StatusNoContent {
+			t.Errorf("DeleteComment() status = %v, want %v", w.Code, http.StatusNoContent)
+		}
+	})
+
+	t.Run("returns 200 when client prefers representation", func(t *testing.T) {
+		commentID := createCommentTestComment(t, db, "Prefer header", articleID, authorID)
+
+		req := httptest.NewRequest("DELETE", fmt.Sprintf("/api/articles/test-article/comments/%d", commentID), nil)
+		req.Header.Set("Prefer", "respond-async, return=representation")
+		req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, authorID))
+		w := httptest.NewRecorder()
+
+		handler.DeleteComment(w, withPathValues("/api/articles/{slug}/comments/{id}", req))
+
+		if w.Code != http.StatusOK {
+			t.Errorf("DeleteComment() status = %v, want %v", w.Code, http.StatusOK)
+		}
+	})
+
+	t.Run("returns 200 with confirmation when enabled", func(t *testing.T) {
+		handler.SetDeleteReturnsBody(true)
+		defer handler.SetDeleteReturnsBody(false)
+
+		commentID := createCommentTestComment(t, db, "Config mode", articleID, authorID)
+
+		req := httptest.NewRequest("DELETE", fmt.Sprintf("/api/articles/test-article/comments/%d", commentID), nil)
+		req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, authorID))
+		w := httptest.NewRecorder()
+
+		handler.DeleteComment(w, withPathValues("/api/articles/{slug}/comments/{id}", req))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("DeleteComment() status = %v, want %v", w.Code, http.StatusOK)
+		}
+
+		var resp DeleteResponse
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if resp.Deleted.Type != "comment" || resp.Deleted.ID != commentID {
+			t.Errorf("unexpected confirmation body: %+v", resp.Deleted)
+		}
+	})
+}
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// DeleteResponse is the confirmation body returned by delete endpoints when
+// a representation is requested instead of an empty 204
+type DeleteResponse struct {
+	Deleted DeleteResponseBody `json:"deleted"`
+}
+
+// DeleteResponseBody identifies the resource that was removed
+type DeleteResponseBody struct {
+	Type string `json:"type"`
+	Slug string `json:"slug,omitempty"`
+	ID   int64  `json:"id,omitempty"`
+}
+
+// prefersRepresentation reports whether the client sent
+// "Prefer: return=representation" (RFC 7240)
+func prefersRepresentation(r *http.Request) bool {
+	for _, header := range r.Header.Values("Prefer") {
+		for _, pref := range strings.Split(header, ",") {
+			if strings.EqualFold(strings.TrimSpace(pref), "return=representation") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// writeDeleted finishes a successful delete: 204 by default, or 200 with a
+// JSON confirmation when enabled server-wide or requested by the client
+func writeDeleted(w http.ResponseWriter, r *http.Request, alwaysBody bool, body DeleteResponseBody) {
+	if !alwaysBody && !prefersRepresentation(r) {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(DeleteResponse{Deleted: body})
+}
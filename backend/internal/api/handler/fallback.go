@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// routeMethods are the methods probed when building an Allow header
+var routeMethods = []string{
+	http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut,
+	http.MethodPatch, http.MethodDelete,
+}
+
+// Fallback returns the handler for requests no route matched, meant to be
+// registered on routes under the "/" pattern. A path that routes serves
+// under other methods gets 405 with an Allow header; anything else gets 404.
+// Both use the standard error envelope.
+func Fallback(routes *http.ServeMux) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if allowed := allowedMethods(routes, r); len(allowed) > 0 {
+			w.Header().Set("Allow", strings.Join(allowed, ", "))
+			writeFallbackError(w, http.StatusMethodNotAllowed, "method", "method not allowed")
+			return
+		}
+		writeFallbackError(w, http.StatusNotFound, "route", "not found")
+	}
+}
+
+// allowedMethods lists the methods routes serves r's path with, not counting
+// the "/" fallback itself
+func allowedMethods(routes *http.ServeMux, r *http.Request) []string {
+	var allowed []string
+	for _, method := range routeMethods {
+		probe := r.Clone(r.Context())
+		probe.Method = method
+		if _, pattern := routes.Handler(probe); pattern != "" && pattern != "/" {
+			allowed = append(allowed, method)
+		}
+	}
+	return allowed
+}
+
+func writeFallbackError(w http.ResponseWriter, status int, field, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(ErrorResponse{Errors: map[string][]string{field: {message}}})
+}
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFallback(t *testing.T) {
+	ok := func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) }
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/articles/{slug}", ok)
+	mux.HandleFunc("PUT /api/articles/{slug}", ok)
+	mux.HandleFunc("DELETE /api/articles/{slug}", ok)
+	mux.Handle("/", Fallback(mux))
+
+	serve := func(method, path string) (*httptest.ResponseRecorder, ErrorResponse) {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest(method, path, nil))
+		var resp ErrorResponse
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatalf("%s %s: expected a JSON body: %v", method, path, err)
+		}
+		return w, resp
+	}
+
+	t.Run("unknown path is a JSON 404", func(t *testing.T) {
+		w, resp := serve(http.MethodGet, "/api/nope")
+
+		if w.Code != http.StatusNotFound {
+			t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+		}
+		if got := w.Header().Get("Content-Type"); got != "application/json" {
+			t.Errorf("expected Content-Type application/json, got %q", got)
+		}
+		if got := resp.Errors["route"]; len(got) != 1 || got[0] != "not found" {
+			t.Errorf("expected route error, got %v", resp.Errors)
+		}
+	})
+
+	t.Run("wrong method is a JSON 405 with Allow", func(t *testing.T) {
+		w, resp := serve(http.MethodPost, "/api/articles/some-slug")
+
+		if w.Code != http.StatusMethodNotAllowed {
+			t.Errorf("expected status %d, got %d", http.StatusMethodNotAllowed, w.Code)
+		}
+		if got := w.Header().Get("Allow"); got != "GET, HEAD, PUT, DELETE" {
+			t.Errorf("expected Allow %q, got %q", "GET, HEAD, PUT, DELETE", got)
+		}
+		if got := resp.Errors["method"]; len(got) != 1 || got[0] != "method not allowed" {
+			t.Errorf("expected method error, got %v", resp.Errors)
+		}
+	})
+}
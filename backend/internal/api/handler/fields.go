@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"strings"
+)
+
+// articleFieldNames lists the article fields clients may select with ?fields=
+var articleFieldNames = []string{
+	"slug", "title", "description", "body", "bodyHtml", "readingTime", "coverImage", "tagList",
+	"createdAt", "updatedAt", "favorited", "favoritesCount", "viewCount", "commentsCount",
+	"commentsEnabled", "published", "publishAt", "author",
+}
+
+// parseArticleFields parses a comma-separated ?fields= value. It returns nil
+// when no selection was requested, and the unknown names if any are invalid.
+// slug is always included so clients can identify each article.
+func parseArticleFields(value string) (fields []string, unknown []string) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+
+	known := make(map[string]bool, len(articleFieldNames))
+	for _, name := range articleFieldNames {
+		known[name] = true
+	}
+
+	selected := map[string]bool{"slug": true}
+	fields = []string{"slug"}
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" || selected[name] {
+			continue
+		}
+		if !known[name] {
+			unknown = append(unknown, name)
+			continue
+		}
+		selected[name] = true
+		fields = append(fields, name)
+	}
+
+	return fields, unknown
+}
+
+// selectArticleFields serializes only the requested fields of an article
+func selectArticleFields(body ArticleResponseBody, fields []string) map[string]any {
+	selected := make(map[string]any, len(fields))
+	for _, name := range fields {
+		switch name {
+		case "slug":
+			selected[name] = body.Slug
+		case "title":
+			selected[name] = body.Title
+		case "description":
+			selected[name] = body.Description
+		case "body":
+			selected[name] = body.Body
+		case "bodyHtml":
+			selected[name] = body.BodyHTML
+		case "readingTime":
+			selected[name] = body.ReadingTime
+		case "coverImage":
+			selected[name] = body.CoverImage
+		case "tagList":
+			selected[name] = body.TagList
+		case "createdAt":
+			selected[name] = body.CreatedAt
+		case "updatedAt":
+			selected[name] = body.UpdatedAt
+		case "favorited":
+			selected[name] = body.Favorited
+		case "favoritesCount":
+			selected[name] = body.FavoritesCount
+		case "viewCount":
+			selected[name] = body.ViewCount
+		case "commentsCount":
+			selected[name] = body.CommentsCount
+		case "commentsEnabled":
+			selected[name] = body.CommentsEnabled
+		case "published":
+			selected[name] = body.Published
+		case "publishAt":
+			selected[name] = body.PublishAt
+		case "author":
+			selected[name] = body.Author
+		}
+	}
+	return selected
+}
@@ -1,22 +1,73 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
+	"time"
+
+	"github.com/alexlee0213/realworld-conduit/backend/internal/buildinfo"
 )
 
-type HealthHandler struct{}
+// readinessTimeout bounds the database ping so a hung database fails the
+// probe instead of stalling it
+const readinessTimeout = 2 * time.Second
+
+// DatabasePinger checks that the database is reachable
+type DatabasePinger interface {
+	Ping(ctx context.Context) error
+}
+
+type HealthHandler struct {
+	db DatabasePinger
+}
 
-func NewHealthHandler() *HealthHandler {
-	return &HealthHandler{}
+func NewHealthHandler(db DatabasePinger) *HealthHandler {
+	return &HealthHandler{db: db}
 }
 
 type HealthResponse struct {
 	Status string `json:"status"`
 }
 
+// ReadinessResponse reports the overall status and the result of each check
+type ReadinessResponse struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks"`
+}
+
+// Health handles GET /health, a cheap liveness check that touches no
+// dependencies
 func (h *HealthHandler) Health(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(HealthResponse{Status: "ok"})
 }
+
+// Version handles GET /api/version, reporting which build is deployed
+func (h *HealthHandler) Version(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(buildinfo.Get())
+}
+
+// Ready handles GET /health/ready. It pings the database and answers 503
+// when the ping fails, so load balancers stop routing to the instance.
+func (h *HealthHandler) Ready(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), readinessTimeout)
+	defer cancel()
+
+	status := http.StatusOK
+	resp := ReadinessResponse{Status: "ok", Checks: map[string]string{"database": "ok"}}
+	// The ping error is logged by the repository; it isn't echoed here
+	// since it can name hosts and users
+	if err := h.db.Ping(ctx); err != nil {
+		status = http.StatusServiceUnavailable
+		resp = ReadinessResponse{Status: "unavailable", Checks: map[string]string{"database": "unreachable"}}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(resp)
+}
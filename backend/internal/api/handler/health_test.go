@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alexlee0213/realworld-conduit/backend/internal/buildinfo"
+)
+
+func TestHealthHandler_Version(t *testing.T) {
+	h := NewHealthHandler(nil)
+
+	t.Run("reports the defaults for an unstamped build", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		h.Version(w, httptest.NewRequest(http.MethodGet, "/api/version", nil))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+		}
+		if got := w.Header().Get("Content-Type"); got != "application/json" {
+			t.Errorf("expected Content-Type application/json, got %q", got)
+		}
+
+		var resp map[string]string
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		want := map[string]string{"version": "dev", "commit": "unknown", "buildTime": "unknown"}
+		for key, value := range want {
+			if got, ok := resp[key]; !ok || got != value {
+				t.Errorf("expected %s = %q, got %q (present: %v)", key, value, got, ok)
+			}
+		}
+	})
+
+	t.Run("reports stamped values", func(t *testing.T) {
+		defer func(version, commit, buildTime string) {
+			buildinfo.Version, buildinfo.Commit, buildinfo.BuildTime = version, commit, buildTime
+		}(buildinfo.Version, buildinfo.Commit, buildinfo.BuildTime)
+		buildinfo.Version, buildinfo.Commit, buildinfo.BuildTime = "v1.2.0", "abc1234", "2026-01-02T03:04:05Z"
+
+		w := httptest.NewRecorder()
+		h.Version(w, httptest.NewRequest(http.MethodGet, "/api/version", nil))
+
+		var resp buildinfo.Info
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if resp != (buildinfo.Info{Version: "v1.2.0", Commit: "abc1234", BuildTime: "2026-01-02T03:04:05Z"}) {
+			t.Errorf("unexpected build info %+v", resp)
+		}
+	})
+}
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// setPaginationLinks sets an RFC 5988 Link header pointing at the next and
+// previous limit/offset pages. next is omitted on the last page and prev on
+// the first; the header is left unset when neither applies.
+func setPaginationLinks(w http.ResponseWriter, r *http.Request, limit, offset, total int) {
+	if limit <= 0 {
+		return
+	}
+
+	var links []string
+	if offset+limit < total {
+		links = append(links, paginationLink(r, limit, offset+limit, "next"))
+	}
+	if offset > 0 {
+		links = append(links, paginationLink(r, limit, max(offset-limit, 0), "prev"))
+	}
+	if len(links) > 0 {
+		w.Header().Set("Link", strings.Join(links, ", "))
+	}
+}
+
+// paginationLink formats one Link entry for the request URL with limit and
+// offset replaced, keeping every other query parameter
+func paginationLink(r *http.Request, limit, offset int, rel string) string {
+	query := r.URL.Query()
+	query.Set("limit", strconv.Itoa(limit))
+	query.Set("offset", strconv.Itoa(offset))
+	return "<" + r.URL.Path + "?" + query.Encode() + `>; rel="` + rel + `"`
+}
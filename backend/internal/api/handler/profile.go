@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"log/slog"
 	"net/http"
+	"strconv"
 
 	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
 	"github.com/alexlee0213/realworld-conduit/backend/internal/service"
@@ -25,11 +26,25 @@ func NewProfileHandler(profileService *service.ProfileService, logger *slog.Logg
 
 // ProfileResponse represents the profile response body
 type ProfileResponse struct {
-	Profile ProfileResponseBody `json:"profile"`
+	Profile ProfileDetailResponseBody `json:"profile"`
 }
 
 // Note: ProfileResponseBody is defined in article.go and reused here
 
+// ProfileDetailResponseBody is the profile returned by the profile endpoints,
+// which adds follow counts to the embedded author profile fields
+type ProfileDetailResponseBody struct {
+	ProfileResponseBody
+	FollowersCount int `json:"followersCount"`
+	FollowingCount int `json:"followingCount"`
+}
+
+// ProfilesResponse represents a list of profiles
+type ProfilesResponse struct {
+	Profiles      []ProfileResponseBody `json:"profiles"`
+	ProfilesCount int                   `json:"profilesCount"`
+}
+
 // GetProfile handles GET /api/profiles/:username
 func (h *ProfileHandler) GetProfile(w http.ResponseWriter, r *http.Request) {
 	username := r.PathValue("username")
@@ -101,14 +116,154 @@ func (h *ProfileHandler) UnfollowUser(w http.ResponseWriter, r *http.Request) {
 	h.writeProfileResponse(w, http.StatusOK, profile)
 }
 
-// writeProfileResponse writes a profile response
-func (h *ProfileHandler) writeProfileResponse(w http.ResponseWriter, status int, profile *domain.Profile) {
-	resp := ProfileResponse{
-		Profile: ProfileResponseBody{
+// BlockUser handles POST /api/profiles/:username/block
+func (h *ProfileHandler) BlockUser(w http.ResponseWriter, r *http.Request) {
+	username := r.PathValue("username")
+	if username == "" {
+		h.writeError(w, http.StatusBadRequest, "username", "username is required")
+		return
+	}
+
+	// Get current user ID (required)
+	userID, ok := GetUserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "token", "authorization required")
+		return
+	}
+
+	profile, err := h.profileService.BlockUser(r.Context(), userID, username)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeProfileResponse(w, http.StatusOK, profile)
+}
+
+// UnblockUser handles DELETE /api/profiles/:username/block
+func (h *ProfileHandler) UnblockUser(w http.ResponseWriter, r *http.Request) {
+	username := r.PathValue("username")
+	if username == "" {
+		h.writeError(w, http.StatusBadRequest, "username", "username is required")
+		return
+	}
+
+	// Get current user ID (required)
+	userID, ok := GetUserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "token", "authorization required")
+		return
+	}
+
+	profile, err := h.profileService.UnblockUser(r.Context(), userID, username)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeProfileResponse(w, http.StatusOK, profile)
+}
+
+// ListFollowers handles GET /api/profiles/:username/followers
+// Query params: limit (default 20), offset (default 0)
+func (h *ProfileHandler) ListFollowers(w http.ResponseWriter, r *http.Request) {
+	username := r.PathValue("username")
+	if username == "" {
+		h.writeError(w, http.StatusBadRequest, "username", "username is required")
+		return
+	}
+
+	// Get current user ID (optional)
+	var currentUserID *int64
+	if userID, ok := GetUserIDFromContext(r.Context()); ok {
+		currentUserID = &userID
+	}
+
+	limit := h.parseIntParam(r.URL.Query().Get("limit"), 20)
+	offset := h.parseIntParam(r.URL.Query().Get("offset"), 0)
+
+	profiles, total, err := h.profileService.ListFollowers(r.Context(), username, currentUserID, limit, offset)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	setPaginationLinks(w, r, limit, offset, total)
+	writeProfilesResponse(w, profiles, total)
+}
+
+// ListFollowing handles GET /api/profiles/:username/following
+// Query params: limit (default 20), offset (default 0)
+func (h *ProfileHandler) ListFollowing(w http.ResponseWriter, r *http.Request) {
+	username := r.PathValue("username")
+	if username == "" {
+		h.writeError(w, http.StatusBadRequest, "username", "username is required")
+		return
+	}
+
+	// Get current user ID (optional)
+	var currentUserID *int64
+	if userID, ok := GetUserIDFromContext(r.Context()); ok {
+		currentUserID = &userID
+	}
+
+	limit := h.parseIntParam(r.URL.Query().Get("limit"), 20)
+	offset := h.parseIntParam(r.URL.Query().Get("offset"), 0)
+
+	profiles, total, err := h.profileService.ListFollowing(r.Context(), username, currentUserID, limit, offset)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	setPaginationLinks(w, r, limit, offset, total)
+	writeProfilesResponse(w, profiles, total)
+}
+
+// writeProfilesResponse writes a list of profiles with the total count
+func writeProfilesResponse(w http.ResponseWriter, profiles []*domain.Profile, total int) {
+	resp := ProfilesResponse{
+		Profiles:      make([]ProfileResponseBody, 0, len(profiles)),
+		ProfilesCount: total,
+	}
+	for _, profile := range profiles {
+		resp.Profiles = append(resp.Profiles, ProfileResponseBody{
 			Username:  profile.Username,
 			Bio:       profile.Bio,
 			Image:     profile.Image,
 			Following: profile.Following,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// parseIntParam parses an integer query parameter with a default value
+func (h *ProfileHandler) parseIntParam(value string, defaultValue int) int {
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return defaultValue
+	}
+	return parsed
+}
+
+// writeProfileResponse writes a profile response
+func (h *ProfileHandler) writeProfileResponse(w http.ResponseWriter, status int, profile *domain.Profile) {
+	resp := ProfileResponse{
+		Profile: ProfileDetailResponseBody{
+			ProfileResponseBody: ProfileResponseBody{
+				Username:  profile.Username,
+				Bio:       profile.Bio,
+				Image:     profile.Image,
+				Following: profile.Following,
+			},
+			FollowersCount: profile.FollowersCount,
+			FollowingCount: profile.FollowingCount,
 		},
 	}
 
@@ -147,6 +302,8 @@ func (h *ProfileHandler) handleServiceError(w http.ResponseWriter, err error) {
 	default:
 		if err == domain.ErrUserNotFound {
 			h.writeError(w, http.StatusNotFound, "profile", "profile not found")
+		} else if err == domain.ErrBlockedByUser {
+			h.writeError(w, http.StatusForbidden, "profile", "you have been blocked by this user")
 		} else if err == domain.ErrValidation {
 			h.writeError(w, http.StatusUnprocessableEntity, "profile", "cannot follow yourself")
 		} else {
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -51,6 +52,34 @@ func setupProfileTestDB(t *testing.T) *sql.DB {
 		t.Fatalf("failed to create users table: %v", err)
 	}
 
+	// Create refresh tokens table
+	_, err = db.Exec(`
+		CREATE TABLE refresh_tokens (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			user_id INTEGER NOT NULL,
+			token_hash TEXT NOT NULL UNIQUE,
+			expires_at TIMESTAMP NOT NULL,
+			revoked_at TIMESTAMP,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+		)
+	`)
+	if err != nil {
+		t.Fatalf("failed to create refresh_tokens table: %v", err)
+	}
+
+	// Create revoked tokens table
+	_, err = db.Exec(`
+		CREATE TABLE revoked_tokens (
+			jti TEXT PRIMARY KEY,
+			expires_at TIMESTAMP NOT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)
+	`)
+	if err != nil {
+		t.Fatalf("failed to create revoked_tokens table: %v", err)
+	}
+
 	// Create follows table
 	_, err = db.Exec(`
 		CREATE TABLE follows (
@@ -69,6 +98,21 @@ func setupProfileTestDB(t *testing.T) *sql.DB {
 		t.Fatalf("failed to create follows table: %v", err)
 	}
 
+	// Create blocks table
+	_, err = db.Exec(`
+		CREATE TABLE blocks (
+			blocker_id INTEGER NOT NULL,
+			blocked_id INTEGER NOT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (blocker_id, blocked_id),
+			FOREIGN KEY (blocker_id) REFERENCES users(id) ON DELETE CASCADE,
+			FOREIGN KEY (blocked_id) REFERENCES users(id) ON DELETE CASCADE
+		)
+	`)
+	if err != nil {
+		t.Fatalf("failed to create blocks table: %v", err)
+	}
+
 	return db
 }
 
@@ -78,8 +122,12 @@ func newTestProfileHandler(t *testing.T) *profileTestSetup {
 	logger := newTestLogger()
 	userRepo := repository.NewSQLiteUserRepository(db, logger)
 	followRepo := repository.NewSQLiteFollowRepository(db, logger)
-	authService := service.NewAuthService(userRepo, "test-jwt-secret", 24*time.Hour, logger)
-	profileService := service.NewProfileService(userRepo, followRepo, logger)
+	blockRepo := repository.NewSQLiteBlockRepository(db, logger)
+	refreshTokenRepo := repository.NewSQLiteRefreshTokenRepository(db, logger)
+	passwordResetRepo := repository.NewSQLitePasswordResetRepository(db, logger)
+	revokedTokenRepo := repository.NewSQLiteRevokedTokenRepository(db, logger)
+	authService := service.NewAuthService(userRepo, refreshTokenRepo, passwordResetRepo, revokedTokenRepo, "test-jwt-secret", 24*time.Hour, logger)
+	profileService := service.NewProfileService(userRepo, followRepo, blockRepo, logger)
 	profileHandler := NewProfileHandler(profileService, logger)
 
 	return &profileTestSetup{
@@ -621,6 +669,9 @@ func TestFollowUnfollowFlow(t *testing.T) {
 		if profile["bio"] != "I am the target user" {
 			t.Errorf("step 1: expected bio, got %v", profile["bio"])
 		}
+		if profile["followersCount"] != float64(0) || profile["followingCount"] != float64(0) {
+			t.Errorf("step 1: expected zero follow counts, got %v and %v", profile["followersCount"], profile["followingCount"])
+		}
 
 		// Step 2: Get profile with auth - following should be false (not followed yet)
 		req = httptest.NewRequest(http.MethodGet, "/api/profiles/completetarget", nil)
@@ -655,6 +706,9 @@ func TestFollowUnfollowFlow(t *testing.T) {
 		if profile["following"] != true {
 			t.Errorf("step 3: expected following true, got %v", profile["following"])
 		}
+		if profile["followersCount"] != float64(1) {
+			t.Errorf("step 3: expected followersCount 1, got %v", profile["followersCount"])
+		}
 
 		// Step 4: Get profile again - following should be true
 		req = httptest.NewRequest(http.MethodGet, "/api/profiles/completetarget", nil)
@@ -708,3 +762,296 @@ func TestFollowUnfollowFlow(t *testing.T) {
 		}
 	})
 }
+
+// =============================================================================
+// POST/DELETE /api/profiles/:username/block Tests
+// =============================================================================
+
+func TestBlockUserHandler(t *testing.T) {
+	t.Run("blocks and unblocks a user", func(t *testing.T) {
+		setup := newTestProfileHandler(t)
+		defer setup.db.Close()
+
+		ctx := context.Background()
+		blocker, _, err := setup.authService.Register(ctx, &domain.CreateUserInput{
+			Email:    "blocker@example.com",
+			Username: "blocker",
+			Password: "password123",
+		})
+		if err != nil {
+			t.Fatalf("failed to register blocker: %v", err)
+		}
+		blocked, _, err := setup.authService.Register(ctx, &domain.CreateUserInput{
+			Email:    "blocked@example.com",
+			Username: "blocked",
+			Password: "password123",
+		})
+		if err != nil {
+			t.Fatalf("failed to register blocked user: %v", err)
+		}
+
+		req := httptest.NewRequest(http.MethodPost, "/api/profiles/blocked/block", nil)
+		req.SetPathValue("username", "blocked")
+		req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, blocker.ID))
+		w := httptest.NewRecorder()
+		setup.handler.BlockUser(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+
+		// The blocked user can no longer follow the blocker
+		req = httptest.NewRequest(http.MethodPost, "/api/profiles/blocker/follow", nil)
+		req.SetPathValue("username", "blocker")
+		req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, blocked.ID))
+		w = httptest.NewRecorder()
+		setup.handler.FollowUser(w, req)
+
+		if w.Code != http.StatusForbidden {
+			t.Errorf("expected status %d, got %d: %s", http.StatusForbidden, w.Code, w.Body.String())
+		}
+
+		req = httptest.NewRequest(http.MethodDelete, "/api/profiles/blocked/block", nil)
+		req.SetPathValue("username", "blocked")
+		req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, blocker.ID))
+		w = httptest.NewRecorder()
+		setup.handler.UnblockUser(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Errorf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("rejects blocking yourself", func(t *testing.T) {
+		setup := newTestProfileHandler(t)
+		defer setup.db.Close()
+
+		user, _, err := setup.authService.Register(context.Background(), &domain.CreateUserInput{
+			Email:    "self@example.com",
+			Username: "selfblocker",
+			Password: "password123",
+		})
+		if err != nil {
+			t.Fatalf("failed to register user: %v", err)
+		}
+
+		req := httptest.NewRequest(http.MethodPost, "/api/profiles/selfblocker/block", nil)
+		req.SetPathValue("username", "selfblocker")
+		req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, user.ID))
+		w := httptest.NewRecorder()
+		setup.handler.BlockUser(w, req)
+
+		if w.Code != http.StatusUnprocessableEntity {
+			t.Errorf("expected status %d, got %d: %s", http.StatusUnprocessableEntity, w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("requires authentication", func(t *testing.T) {
+		setup := newTestProfileHandler(t)
+		defer setup.db.Close()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/profiles/anyone/block", nil)
+		req.SetPathValue("username", "anyone")
+		w := httptest.NewRecorder()
+		setup.handler.BlockUser(w, req)
+
+		if w.Code != http.StatusUnauthorized {
+			t.Errorf("expected status %d, got %d", http.StatusUnauthorized, w.Code)
+		}
+	})
+}
+
+// =============================================================================
+// GET /api/profiles/:username/followers Tests
+// =============================================================================
+
+func TestListFollowersHandler(t *testing.T) {
+	listFollowers := func(setup *profileTestSetup, username, query string, viewerID int64) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/profiles/"+username+"/followers"+query, nil)
+		req.SetPathValue("username", username)
+		if viewerID != 0 {
+			req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, viewerID))
+		}
+		w := httptest.NewRecorder()
+		setup.handler.ListFollowers(w, req)
+		return w
+	}
+
+	register := func(t *testing.T, setup *profileTestSetup, username string) *domain.User {
+		t.Helper()
+		user, _, err := setup.authService.Register(context.Background(), &domain.CreateUserInput{
+			Email:    username + "@example.com",
+			Username: username,
+			Password: "password123",
+		})
+		if err != nil {
+			t.Fatalf("failed to register %s: %v", username, err)
+		}
+		return user
+	}
+
+	t.Run("lists followers with pagination and following flags", func(t *testing.T) {
+		setup := newTestProfileHandler(t)
+		defer setup.db.Close()
+
+		ctx := context.Background()
+		register(t, setup, "target")
+		viewer := register(t, setup, "viewer")
+		for _, name := range []string{"alice", "bob", "carol"} {
+			follower := register(t, setup, name)
+			if _, err := setup.profileService.FollowUser(ctx, follower.ID, "target"); err != nil {
+				t.Fatalf("failed to follow: %v", err)
+			}
+		}
+		if _, err := setup.profileService.FollowUser(ctx, viewer.ID, "bob"); err != nil {
+			t.Fatalf("failed to follow: %v", err)
+		}
+
+		w := listFollowers(setup, "target", "?limit=2", viewer.ID)
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+		if link := w.Header().Get("Link"); !strings.Contains(link, `rel="next"`) {
+			t.Errorf("expected a next link, got %q", link)
+		}
+
+		var response ProfilesResponse
+		if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.ProfilesCount != 3 || len(response.Profiles) != 2 {
+			t.Fatalf("expected 2 of 3 profiles, got %+v", response)
+		}
+
+		w = listFollowers(setup, "target", "?limit=20", viewer.ID)
+		response = ProfilesResponse{}
+		if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		for _, profile := range response.Profiles {
+			if want := profile.Username == "bob"; profile.Following != want {
+				t.Errorf("expected %s following=%v, got %v", profile.Username, want, profile.Following)
+			}
+		}
+	})
+
+	t.Run("returns an empty list for a user with no followers", func(t *testing.T) {
+		setup := newTestProfileHandler(t)
+		defer setup.db.Close()
+
+		register(t, setup, "lonely")
+
+		w := listFollowers(setup, "lonely", "", 0)
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+		if body := strings.TrimSpace(w.Body.String()); body != `{"profiles":[],"profilesCount":0}` {
+			t.Errorf("unexpected body %s", body)
+		}
+	})
+
+	t.Run("returns 404 for non-existent user", func(t *testing.T) {
+		setup := newTestProfileHandler(t)
+		defer setup.db.Close()
+
+		w := listFollowers(setup, "nobody", "", 0)
+		if w.Code != http.StatusNotFound {
+			t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+		}
+	})
+}
+
+// =============================================================================
+// GET /api/profiles/:username/following Tests
+// =============================================================================
+
+func TestListFollowingHandler(t *testing.T) {
+	listFollowing := func(setup *profileTestSetup, username string, viewerID int64) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/profiles/"+username+"/following", nil)
+		req.SetPathValue("username", username)
+		if viewerID != 0 {
+			req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, viewerID))
+		}
+		w := httptest.NewRecorder()
+		setup.handler.ListFollowing(w, req)
+		return w
+	}
+
+	register := func(t *testing.T, setup *profileTestSetup, username string) *domain.User {
+		t.Helper()
+		user, _, err := setup.authService.Register(context.Background(), &domain.CreateUserInput{
+			Email:    username + "@example.com",
+			Username: username,
+			Password: "password123",
+		})
+		if err != nil {
+			t.Fatalf("failed to register %s: %v", username, err)
+		}
+		return user
+	}
+
+	t.Run("lists every user followed with the viewer's flags", func(t *testing.T) {
+		setup := newTestProfileHandler(t)
+		defer setup.db.Close()
+
+		ctx := context.Background()
+		source := register(t, setup, "source")
+		viewer := register(t, setup, "viewer")
+		for _, name := range []string{"alice", "bob", "carol"} {
+			register(t, setup, name)
+			if _, err := setup.profileService.FollowUser(ctx, source.ID, name); err != nil {
+				t.Fatalf("failed to follow: %v", err)
+			}
+		}
+		if _, err := setup.profileService.FollowUser(ctx, viewer.ID, "alice"); err != nil {
+			t.Fatalf("failed to follow: %v", err)
+		}
+
+		w := listFollowing(setup, "source", viewer.ID)
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+
+		var response map[string]interface{}
+		if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		profiles, ok := response["profiles"].([]interface{})
+		if !ok || len(profiles) != 3 {
+			t.Fatalf("expected 3 profiles, got %v", response["profiles"])
+		}
+		if response["profilesCount"] != float64(3) {
+			t.Errorf("expected profilesCount 3, got %v", response["profilesCount"])
+		}
+		for _, p := range profiles {
+			profile := p.(map[string]interface{})
+			if want := profile["username"] == "alice"; profile["following"] != want {
+				t.Errorf("expected %v following=%v, got %v", profile["username"], want, profile["following"])
+			}
+		}
+	})
+
+	t.Run("returns an empty list for a user following no one", func(t *testing.T) {
+		setup := newTestProfileHandler(t)
+		defer setup.db.Close()
+
+		register(t, setup, "loner")
+
+		w := listFollowing(setup, "loner", 0)
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+		if body := strings.TrimSpace(w.Body.String()); body != `{"profiles":[],"profilesCount":0}` {
+			t.Errorf("unexpected body %s", body)
+		}
+	})
+
+	t.Run("returns 404 for non-existent user", func(t *testing.T) {
+		setup := newTestProfileHandler(t)
+		defer setup.db.Close()
+
+		if w := listFollowing(setup, "nobody", 0); w.Code != http.StatusNotFound {
+			t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+		}
+	})
+}
@@ -3,8 +3,12 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"io"
 	"log/slog"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
 	"github.com/alexlee0213/realworld-conduit/backend/internal/service"
@@ -15,6 +19,20 @@ type contextKey string
 
 const UserIDContextKey contextKey = "userID"
 
+// AccessTokenContextKey is the context key for the raw access token of an
+// authenticated request
+const AccessTokenContextKey contextKey = "accessToken"
+
+// RequestIDContextKey is the context key for the request's correlation ID
+const RequestIDContextKey contextKey = "requestID"
+
+// RequestIDFromContext returns the correlation ID set by the RequestID
+// middleware, or "" when there is none
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(RequestIDContextKey).(string)
+	return id
+}
+
 // UserHandler handles user-related HTTP requests
 type UserHandler struct {
 	authService *service.AuthService
@@ -46,6 +64,36 @@ type LoginRequest struct {
 	} `json:"user"`
 }
 
+// RefreshTokenRequest represents the refresh and logout request body
+type RefreshTokenRequest struct {
+	User struct {
+		RefreshToken string `json:"refreshToken"`
+	} `json:"user"`
+}
+
+// PasswordResetRequest represents the password reset request body
+type PasswordResetRequest struct {
+	User struct {
+		Email string `json:"email"`
+	} `json:"user"`
+}
+
+// PasswordResetConfirmRequest represents the password reset confirmation body
+type PasswordResetConfirmRequest struct {
+	User struct {
+		Token    string `json:"token"`
+		Password string `json:"password"`
+	} `json:"user"`
+}
+
+// ChangePasswordRequest represents the change password request body
+type ChangePasswordRequest struct {
+	User struct {
+		CurrentPassword string `json:"currentPassword"`
+		NewPassword     string `json:"newPassword"`
+	} `json:"user"`
+}
+
 // UpdateUserRequest represents the update user request body
 type UpdateUserRequest struct {
 	User struct {
@@ -64,11 +112,12 @@ type UserResponse struct {
 
 // UserResponseBody represents the user data in responses
 type UserResponseBody struct {
-	Email    string `json:"email"`
-	Token    string `json:"token"`
-	Username string `json:"username"`
-	Bio      string `json:"bio"`
-	Image    string `json:"image"`
+	Email        string `json:"email"`
+	Token        string `json:"token"`
+	RefreshToken string `json:"refreshToken,omitempty"`
+	Username     string `json:"username"`
+	Bio          string `json:"bio"`
+	Image        string `json:"image"`
 }
 
 // ErrorResponse represents an error response body
@@ -81,7 +130,8 @@ func (h *UserHandler) Register(w http.ResponseWriter, r *http.Request) {
 	var req RegisterRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.logger.Debug("failed to decode register request", "error", err)
-		h.writeError(w, http.StatusUnprocessableEntity, "body", "invalid request body")
+		status, message := decodeErrorResponse(err)
+		h.writeError(w, status, "body", message)
 		return
 	}
 
@@ -91,13 +141,13 @@ func (h *UserHandler) Register(w http.ResponseWriter, r *http.Request) {
 		Password: req.User.Password,
 	}
 
-	user, token, err := h.authService.Register(r.Context(), input)
+	user, tokens, err := h.authService.Register(r.Context(), input)
 	if err != nil {
 		h.handleServiceError(w, err)
 		return
 	}
 
-	h.writeUserResponse(w, http.StatusCreated, user, token)
+	h.writeUserResponse(w, http.StatusCreated, user, tokens.AccessToken, tokens.RefreshToken)
 }
 
 // Login handles POST /api/users/login
@@ -105,17 +155,121 @@ func (h *UserHandler) Login(w http.ResponseWriter, r *http.Request) {
 	var req LoginRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.logger.Debug("failed to decode login request", "error", err)
-		h.writeError(w, http.StatusUnprocessableEntity, "body", "invalid request body")
+		status, message := decodeErrorResponse(err)
+		h.writeError(w, status, "body", message)
+		return
+	}
+
+	user, tokens, err := h.authService.Login(r.Context(), req.User.Email, req.User.Password)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeUserResponse(w, http.StatusOK, user, tokens.AccessToken, tokens.RefreshToken)
+}
+
+// Refresh handles POST /api/users/refresh
+// Exchanges a refresh token for a new access and refresh token pair; the
+// presented refresh token stops working
+func (h *UserHandler) Refresh(w http.ResponseWriter, r *http.Request) {
+	var req RefreshTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Debug("failed to decode refresh request", "error", err)
+		status, message := decodeErrorResponse(err)
+		h.writeError(w, status, "body", message)
+		return
+	}
+	if req.User.RefreshToken == "" {
+		h.writeError(w, http.StatusUnprocessableEntity, "refreshToken", "can't be blank")
 		return
 	}
 
-	user, token, err := h.authService.Login(r.Context(), req.User.Email, req.User.Password)
+	user, tokens, err := h.authService.RefreshTokens(r.Context(), req.User.RefreshToken)
 	if err != nil {
 		h.handleServiceError(w, err)
 		return
 	}
 
-	h.writeUserResponse(w, http.StatusOK, user, token)
+	h.writeUserResponse(w, http.StatusOK, user, tokens.AccessToken, tokens.RefreshToken)
+}
+
+// Logout handles POST /api/users/logout
+// Revokes the refresh token in the body, if any, and blocklists the access
+// token the request was authenticated with. The body may be empty when an
+// access token is sent.
+func (h *UserHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	accessToken, _ := r.Context().Value(AccessTokenContextKey).(string)
+
+	var req RefreshTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.logger.Debug("failed to decode logout request", "error", err)
+		status, message := decodeErrorResponse(err)
+		h.writeError(w, status, "body", message)
+		return
+	}
+	if req.User.RefreshToken == "" && accessToken == "" {
+		h.writeError(w, http.StatusUnprocessableEntity, "refreshToken", "can't be blank")
+		return
+	}
+
+	if err := h.authService.Logout(r.Context(), req.User.RefreshToken, accessToken); err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RequestPasswordReset handles POST /api/users/password-reset/request
+// Always answers 200 for a well-formed request, whether or not the email
+// belongs to an account, so the endpoint can't be used to enumerate users
+func (h *UserHandler) RequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	var req PasswordResetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Debug("failed to decode password reset request", "error", err)
+		status, message := decodeErrorResponse(err)
+		h.writeError(w, status, "body", message)
+		return
+	}
+	if req.User.Email == "" {
+		h.writeError(w, http.StatusUnprocessableEntity, "email", "can't be blank")
+		return
+	}
+
+	if err := h.authService.RequestPasswordReset(r.Context(), req.User.Email); err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(struct{}{})
+}
+
+// ConfirmPasswordReset handles POST /api/users/password-reset/confirm
+// Sets a new password using a token from RequestPasswordReset
+func (h *UserHandler) ConfirmPasswordReset(w http.ResponseWriter, r *http.Request) {
+	var req PasswordResetConfirmRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Debug("failed to decode password reset confirmation", "error", err)
+		status, message := decodeErrorResponse(err)
+		h.writeError(w, status, "body", message)
+		return
+	}
+	if req.User.Token == "" {
+		h.writeError(w, http.StatusUnprocessableEntity, "token", "can't be blank")
+		return
+	}
+
+	if err := h.authService.ConfirmPasswordReset(r.Context(), req.User.Token, req.User.Password); err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(struct{}{})
 }
 
 // GetCurrentUser handles GET /api/user
@@ -135,12 +289,12 @@ func (h *UserHandler) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
 	// Generate a fresh token for the response
 	token, err := h.authService.GenerateToken(user.ID)
 	if err != nil {
-		h.logger.Error("failed to generate token", "error", err)
+		h.logger.Error("failed to generate token", "error", err, "request_id", RequestIDFromContext(r.Context()))
 		h.writeError(w, http.StatusInternalServerError, "server", "internal server error")
 		return
 	}
 
-	h.writeUserResponse(w, http.StatusOK, user, token)
+	h.writeUserResponse(w, http.StatusOK, user, token, "")
 }
 
 // UpdateUser handles PUT /api/user
@@ -154,7 +308,8 @@ func (h *UserHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 	var req UpdateUserRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.logger.Debug("failed to decode update user request", "error", err)
-		h.writeError(w, http.StatusUnprocessableEntity, "body", "invalid request body")
+		status, message := decodeErrorResponse(err)
+		h.writeError(w, status, "body", message)
 		return
 	}
 
@@ -175,12 +330,39 @@ func (h *UserHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 	// Generate a fresh token for the response
 	token, err := h.authService.GenerateToken(user.ID)
 	if err != nil {
-		h.logger.Error("failed to generate token", "error", err)
+		h.logger.Error("failed to generate token", "error", err, "request_id", RequestIDFromContext(r.Context()))
 		h.writeError(w, http.StatusInternalServerError, "server", "internal server error")
 		return
 	}
 
-	h.writeUserResponse(w, http.StatusOK, user, token)
+	h.writeUserResponse(w, http.StatusOK, user, token, "")
+}
+
+// ChangePassword handles POST /api/user/password
+// Requires the current password; responds with the user and a new token pair
+// because every existing refresh token is revoked
+func (h *UserHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(UserIDContextKey).(int64)
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "token", "authorization required")
+		return
+	}
+
+	var req ChangePasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Debug("failed to decode change password request", "error", err)
+		status, message := decodeErrorResponse(err)
+		h.writeError(w, status, "body", message)
+		return
+	}
+
+	user, tokens, err := h.authService.ChangePassword(r.Context(), userID, req.User.CurrentPassword, req.User.NewPassword)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeUserResponse(w, http.StatusOK, user, tokens.AccessToken, tokens.RefreshToken)
 }
 
 // GetUserIDFromContext retrieves the user ID from context
@@ -190,14 +372,16 @@ func GetUserIDFromContext(ctx context.Context) (int64, bool) {
 }
 
 // writeUserResponse writes a user response
-func (h *UserHandler) writeUserResponse(w http.ResponseWriter, status int, user *domain.User, token string) {
+// refreshToken is omitted from the body when empty
+func (h *UserHandler) writeUserResponse(w http.ResponseWriter, status int, user *domain.User, token, refreshToken string) {
 	resp := UserResponse{
 		User: UserResponseBody{
-			Email:    user.Email,
-			Token:    token,
-			Username: user.Username,
-			Bio:      user.Bio,
-			Image:    user.Image,
+			Email:        user.Email,
+			Token:        token,
+			RefreshToken: refreshToken,
+			Username:     user.Username,
+			Bio:          user.Bio,
+			Image:        user.Image,
 		},
 	}
 
@@ -234,6 +418,11 @@ func (h *UserHandler) handleServiceError(w http.ResponseWriter, err error) {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusUnprocessableEntity)
 		json.NewEncoder(w).Encode(resp)
+	case *domain.TooManyAttemptsError:
+		// Round up so clients never retry before the lockout ends
+		seconds := int((e.RetryAfter + time.Second - 1) / time.Second)
+		w.Header().Set("Retry-After", strconv.Itoa(seconds))
+		h.writeError(w, http.StatusTooManyRequests, "email or password", "too many failed attempts, try again later")
 	default:
 		if err == domain.ErrUserNotFound {
 			h.writeError(w, http.StatusNotFound, "user", "user not found")
@@ -243,6 +432,10 @@ func (h *UserHandler) handleServiceError(w http.ResponseWriter, err error) {
 			h.writeError(w, http.StatusUnprocessableEntity, "username", "has already been taken")
 		} else if err == domain.ErrInvalidCredentials {
 			h.writeError(w, http.StatusUnprocessableEntity, "email or password", "is invalid")
+		} else if err == domain.ErrInvalidRefreshToken {
+			h.writeError(w, http.StatusUnauthorized, "refreshToken", "is invalid or expired")
+		} else if err == domain.ErrInvalidResetToken {
+			h.writeError(w, http.StatusUnprocessableEntity, "token", "is invalid or expired")
 		} else {
 			h.logger.Error("unexpected error", "error", err)
 			h.writeError(w, http.StatusInternalServerError, "server", "internal server error")
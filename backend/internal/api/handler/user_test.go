@@ -15,6 +15,7 @@ import (
 	_ "github.com/mattn/go-sqlite3"
 
 	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
+	"github.com/alexlee0213/realworld-conduit/backend/internal/events"
 	"github.com/alexlee0213/realworld-conduit/backend/internal/repository"
 	"github.com/alexlee0213/realworld-conduit/backend/internal/service"
 )
@@ -46,6 +47,50 @@ func setupTestDB(t *testing.T) *sql.DB {
 		t.Fatalf("failed to create users table: %v", err)
 	}
 
+	// Create refresh tokens table
+	_, err = db.Exec(`
+		CREATE TABLE refresh_tokens (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			user_id INTEGER NOT NULL,
+			token_hash TEXT NOT NULL UNIQUE,
+			expires_at TIMESTAMP NOT NULL,
+			revoked_at TIMESTAMP,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+		)
+	`)
+	if err != nil {
+		t.Fatalf("failed to create refresh_tokens table: %v", err)
+	}
+
+	// Create password resets table
+	_, err = db.Exec(`
+		CREATE TABLE password_resets (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			user_id INTEGER NOT NULL,
+			token_hash TEXT NOT NULL UNIQUE,
+			expires_at TIMESTAMP NOT NULL,
+			used_at TIMESTAMP,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+		)
+	`)
+	if err != nil {
+		t.Fatalf("failed to create password_resets table: %v", err)
+	}
+
+	// Create revoked tokens table
+	_, err = db.Exec(`
+		CREATE TABLE revoked_tokens (
+			jti TEXT PRIMARY KEY,
+			expires_at TIMESTAMP NOT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)
+	`)
+	if err != nil {
+		t.Fatalf("failed to create revoked_tokens table: %v", err)
+	}
+
 	return db
 }
 
@@ -66,7 +111,10 @@ func newTestUserHandler(t *testing.T) *testSetup {
 	db := setupTestDB(t)
 	logger := newTestLogger()
 	userRepo := repository.NewSQLiteUserRepository(db, logger)
-	authService := service.NewAuthService(userRepo, "test-jwt-secret", 24*time.Hour, logger)
+	refreshTokenRepo := repository.NewSQLiteRefreshTokenRepository(db, logger)
+	passwordResetRepo := repository.NewSQLitePasswordResetRepository(db, logger)
+	revokedTokenRepo := repository.NewSQLiteRevokedTokenRepository(db, logger)
+	authService := service.NewAuthService(userRepo, refreshTokenRepo, passwordResetRepo, revokedTokenRepo, "test-jwt-secret", 24*time.Hour, logger)
 	userHandler := NewUserHandler(authService, logger)
 
 	return &testSetup{
@@ -171,6 +219,30 @@ func TestRegisterHandler(t *testing.T) {
 			t.Errorf("expected status %d, got %d", http.StatusUnprocessableEntity, w.Code)
 		}
 	})
+
+	t.Run("returns 422 for a weak password", func(t *testing.T) {
+		setup := newTestUserHandler(t)
+		defer setup.db.Close()
+
+		body := `{"user":{"username":"weakuser","email":"weak@example.com","password":"short"}}`
+		req := httptest.NewRequest(http.MethodPost, "/api/users", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		setup.handler.Register(w, req)
+
+		if w.Code != http.StatusUnprocessableEntity {
+			t.Fatalf("expected status %d, got %d", http.StatusUnprocessableEntity, w.Code)
+		}
+
+		var response ErrorResponse
+		if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if len(response.Errors["password"]) != 1 {
+			t.Errorf("expected one password error, got %v", response.Errors)
+		}
+	})
 }
 
 // =============================================================================
@@ -218,6 +290,9 @@ func TestLoginHandler(t *testing.T) {
 		if user["token"] == nil || user["token"] == "" {
 			t.Error("expected token in response")
 		}
+		if user["refreshToken"] == nil || user["refreshToken"] == "" {
+			t.Error("expected refreshToken in response")
+		}
 	})
 
 	t.Run("returns error for wrong password", func(t *testing.T) {
@@ -248,6 +323,27 @@ func TestLoginHandler(t *testing.T) {
 		}
 	})
 
+	t.Run("returns 429 with Retry-After once locked out", func(t *testing.T) {
+		setup := newTestUserHandler(t)
+		defer setup.db.Close()
+
+		body := `{"user":{"email":"brute@example.com","password":"guess"}}`
+		var w *httptest.ResponseRecorder
+		for i := 0; i <= service.DefaultMaxLoginFailures; i++ {
+			req := httptest.NewRequest(http.MethodPost, "/api/users/login", bytes.NewBufferString(body))
+			req.Header.Set("Content-Type", "application/json")
+			w = httptest.NewRecorder()
+			setup.handler.Login(w, req)
+		}
+
+		if w.Code != http.StatusTooManyRequests {
+			t.Fatalf("expected status %d, got %d", http.StatusTooManyRequests, w.Code)
+		}
+		if w.Header().Get("Retry-After") == "" {
+			t.Error("expected Retry-After header")
+		}
+	})
+
 	t.Run("returns error for non-existent email", func(t *testing.T) {
 		setup := newTestUserHandler(t)
 		defer setup.db.Close()
@@ -263,6 +359,241 @@ func TestLoginHandler(t *testing.T) {
 			t.Errorf("expected status %d, got %d", http.StatusUnprocessableEntity, w.Code)
 		}
 	})
+
+	t.Run("unknown email and wrong password get identical responses", func(t *testing.T) {
+		setup := newTestUserHandler(t)
+		defer setup.db.Close()
+
+		if _, _, err := setup.authService.Register(context.Background(), &domain.CreateUserInput{
+			Email:    "known@example.com",
+			Username: "knownuser",
+			Password: "password123",
+		}); err != nil {
+			t.Fatalf("failed to register user: %v", err)
+		}
+
+		login := func(body string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodPost, "/api/users/login", bytes.NewBufferString(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			setup.handler.Login(w, req)
+			return w
+		}
+
+		wrongPassword := login(`{"user":{"email":"known@example.com","password":"wrongpassword"}}`)
+		unknownEmail := login(`{"user":{"email":"unknown@example.com","password":"wrongpassword"}}`)
+
+		if wrongPassword.Code != unknownEmail.Code {
+			t.Errorf("status differs: %d vs %d", wrongPassword.Code, unknownEmail.Code)
+		}
+		if wrongPassword.Body.String() != unknownEmail.Body.String() {
+			t.Errorf("body differs: %s vs %s", wrongPassword.Body.String(), unknownEmail.Body.String())
+		}
+	})
+}
+
+// =============================================================================
+// POST /api/users/refresh and /api/users/logout Tests
+// =============================================================================
+
+func TestRefreshAndLogoutHandlers(t *testing.T) {
+	post := func(handle http.HandlerFunc, target, refreshToken string) *httptest.ResponseRecorder {
+		body := `{"user":{"refreshToken":"` + refreshToken + `"}}`
+		req := httptest.NewRequest(http.MethodPost, target, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		handle(w, req)
+		return w
+	}
+
+	register := func(t *testing.T, setup *testSetup) string {
+		t.Helper()
+		_, tokens, err := setup.authService.Register(context.Background(), &domain.CreateUserInput{
+			Email:    "refresh@example.com",
+			Username: "refreshuser",
+			Password: "password123",
+		})
+		if err != nil {
+			t.Fatalf("failed to register user: %v", err)
+		}
+		return tokens.RefreshToken
+	}
+
+	t.Run("refresh returns a new token pair", func(t *testing.T) {
+		setup := newTestUserHandler(t)
+		defer setup.db.Close()
+
+		refreshToken := register(t, setup)
+
+		w := post(setup.handler.Refresh, "/api/users/refresh", refreshToken)
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+
+		var response UserResponse
+		if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.User.Username != "refreshuser" || response.User.Token == "" {
+			t.Errorf("unexpected user in response: %+v", response.User)
+		}
+		if response.User.RefreshToken == "" || response.User.RefreshToken == refreshToken {
+			t.Errorf("expected a new refresh token, got %q", response.User.RefreshToken)
+		}
+
+		// The old refresh token was rotated out
+		if w := post(setup.handler.Refresh, "/api/users/refresh", refreshToken); w.Code != http.StatusUnauthorized {
+			t.Errorf("expected status %d on reuse, got %d", http.StatusUnauthorized, w.Code)
+		}
+	})
+
+	t.Run("refresh rejects an unknown token", func(t *testing.T) {
+		setup := newTestUserHandler(t)
+		defer setup.db.Close()
+
+		w := post(setup.handler.Refresh, "/api/users/refresh", "unknown")
+		if w.Code != http.StatusUnauthorized {
+			t.Errorf("expected status %d, got %d", http.StatusUnauthorized, w.Code)
+		}
+	})
+
+	t.Run("refresh requires a token", func(t *testing.T) {
+		setup := newTestUserHandler(t)
+		defer setup.db.Close()
+
+		w := post(setup.handler.Refresh, "/api/users/refresh", "")
+		if w.Code != http.StatusUnprocessableEntity {
+			t.Errorf("expected status %d, got %d", http.StatusUnprocessableEntity, w.Code)
+		}
+	})
+
+	t.Run("logout invalidates the refresh token", func(t *testing.T) {
+		setup := newTestUserHandler(t)
+		defer setup.db.Close()
+
+		refreshToken := register(t, setup)
+
+		if w := post(setup.handler.Logout, "/api/users/logout", refreshToken); w.Code != http.StatusNoContent {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusNoContent, w.Code, w.Body.String())
+		}
+		if w := post(setup.handler.Refresh, "/api/users/refresh", refreshToken); w.Code != http.StatusUnauthorized {
+			t.Errorf("expected status %d after logout, got %d", http.StatusUnauthorized, w.Code)
+		}
+	})
+
+	t.Run("logout revokes the access token without a body", func(t *testing.T) {
+		setup := newTestUserHandler(t)
+		defer setup.db.Close()
+
+		ctx := context.Background()
+		_, tokens, err := setup.authService.Register(ctx, &domain.CreateUserInput{
+			Email:    "logout@example.com",
+			Username: "logoutuser",
+			Password: "password123",
+		})
+		if err != nil {
+			t.Fatalf("failed to register user: %v", err)
+		}
+
+		req := httptest.NewRequest(http.MethodPost, "/api/users/logout", nil)
+		req = req.WithContext(context.WithValue(req.Context(), AccessTokenContextKey, tokens.AccessToken))
+		w := httptest.NewRecorder()
+		setup.handler.Logout(w, req)
+
+		if w.Code != http.StatusNoContent {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusNoContent, w.Code, w.Body.String())
+		}
+		if _, err := setup.authService.ValidateToken(ctx, tokens.AccessToken); err != domain.ErrTokenRevoked {
+			t.Errorf("expected ErrTokenRevoked after logout, got %v", err)
+		}
+	})
+
+	t.Run("logout requires a token", func(t *testing.T) {
+		setup := newTestUserHandler(t)
+		defer setup.db.Close()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/users/logout", nil)
+		w := httptest.NewRecorder()
+		setup.handler.Logout(w, req)
+
+		if w.Code != http.StatusUnprocessableEntity {
+			t.Errorf("expected status %d, got %d", http.StatusUnprocessableEntity, w.Code)
+		}
+	})
+}
+
+// =============================================================================
+// POST /api/users/password-reset Tests
+// =============================================================================
+
+func TestPasswordResetHandlers(t *testing.T) {
+	post := func(handle http.HandlerFunc, target, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, target, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		handle(w, req)
+		return w
+	}
+
+	setup := newTestUserHandler(t)
+	defer setup.db.Close()
+
+	var resetToken string
+	bus := events.NewBus(newTestLogger())
+	bus.Subscribe(func(ctx context.Context, event events.Event) {
+		if e, ok := event.(events.PasswordResetRequested); ok {
+			resetToken = e.Token
+		}
+	})
+	setup.authService.SetEventBus(bus)
+
+	_, _, err := setup.authService.Register(context.Background(), &domain.CreateUserInput{
+		Email:    "reset@example.com",
+		Username: "resetuser",
+		Password: "oldpassword",
+	})
+	if err != nil {
+		t.Fatalf("failed to register user: %v", err)
+	}
+
+	t.Run("request answers 200 for unknown email", func(t *testing.T) {
+		w := post(setup.handler.RequestPasswordReset, "/api/users/password-reset/request", `{"user":{"email":"nobody@example.com"}}`)
+		if w.Code != http.StatusOK {
+			t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
+		}
+		if resetToken != "" {
+			t.Error("expected no token for an unknown email")
+		}
+	})
+
+	t.Run("confirm rejects an unknown token", func(t *testing.T) {
+		w := post(setup.handler.ConfirmPasswordReset, "/api/users/password-reset/confirm", `{"user":{"token":"bogus","password":"newpassword"}}`)
+		if w.Code != http.StatusUnprocessableEntity {
+			t.Errorf("expected status %d, got %d", http.StatusUnprocessableEntity, w.Code)
+		}
+	})
+
+	t.Run("request and confirm reset the password once", func(t *testing.T) {
+		w := post(setup.handler.RequestPasswordReset, "/api/users/password-reset/request", `{"user":{"email":"reset@example.com"}}`)
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+		}
+		if resetToken == "" {
+			t.Fatal("expected a reset token to be published")
+		}
+
+		confirm := `{"user":{"token":"` + resetToken + `","password":"newpassword"}}`
+		if w := post(setup.handler.ConfirmPasswordReset, "/api/users/password-reset/confirm", confirm); w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+		if _, _, err := setup.authService.Login(context.Background(), "reset@example.com", "newpassword"); err != nil {
+			t.Errorf("expected login with new password, got %v", err)
+		}
+
+		if w := post(setup.handler.ConfirmPasswordReset, "/api/users/password-reset/confirm", confirm); w.Code != http.StatusUnprocessableEntity {
+			t.Errorf("expected status %d when reusing the token, got %d", http.StatusUnprocessableEntity, w.Code)
+		}
+	})
 }
 
 // =============================================================================
@@ -439,3 +770,73 @@ func TestUpdateUserHandler(t *testing.T) {
 		}
 	})
 }
+
+func TestChangePasswordHandler(t *testing.T) {
+	changePassword := func(setup *testSetup, userID int64, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/user/password", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		req = req.WithContext(context.WithValue(req.Context(), UserIDContextKey, userID))
+		w := httptest.NewRecorder()
+		setup.handler.ChangePassword(w, req)
+		return w
+	}
+
+	t.Run("changes the password with the correct current password", func(t *testing.T) {
+		setup := newTestUserHandler(t)
+		defer setup.db.Close()
+
+		ctx := context.Background()
+		user, _, err := setup.authService.Register(ctx, &domain.CreateUserInput{
+			Email:    "change@example.com",
+			Username: "changeuser",
+			Password: "oldpassword",
+		})
+		if err != nil {
+			t.Fatalf("failed to register user: %v", err)
+		}
+
+		w := changePassword(setup, user.ID, `{"user":{"currentPassword":"oldpassword","newPassword":"newpassword"}}`)
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+
+		var response UserResponse
+		if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.User.Token == "" || response.User.RefreshToken == "" {
+			t.Error("expected a new token pair in the response")
+		}
+
+		if _, _, err := setup.authService.Login(ctx, "change@example.com", "newpassword"); err != nil {
+			t.Errorf("login with new password should work: %v", err)
+		}
+	})
+
+	t.Run("returns 422 for an incorrect current password", func(t *testing.T) {
+		setup := newTestUserHandler(t)
+		defer setup.db.Close()
+
+		user, _, err := setup.authService.Register(context.Background(), &domain.CreateUserInput{
+			Email:    "change@example.com",
+			Username: "changeuser",
+			Password: "oldpassword",
+		})
+		if err != nil {
+			t.Fatalf("failed to register user: %v", err)
+		}
+
+		w := changePassword(setup, user.ID, `{"user":{"currentPassword":"wrongpassword","newPassword":"newpassword"}}`)
+		if w.Code != http.StatusUnprocessableEntity {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusUnprocessableEntity, w.Code, w.Body.String())
+		}
+
+		var response ErrorResponse
+		if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if msgs := response.Errors["password"]; len(msgs) != 1 || msgs[0] != "is invalid" {
+			t.Errorf("expected password: is invalid, got %v", response.Errors)
+		}
+	})
+}
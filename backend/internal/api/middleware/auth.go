@@ -9,25 +9,57 @@ import (
 	"github.com/alexlee0213/realworld-conduit/backend/internal/service"
 )
 
+// AccessTokenQueryParam is the query parameter carrying the token on routes
+// that allow it (see WithQueryToken)
+const AccessTokenQueryParam = "access_token"
+
+// AuthOption customizes Auth and OptionalAuth
+type AuthOption func(*authOptions)
+
+type authOptions struct {
+	queryToken bool
+}
+
+// WithQueryToken also accepts the token from the access_token query
+// parameter when no Authorization header is sent. It is meant for routes
+// consumed by clients that can't set headers, such as EventSource for
+// Server-Sent Events. Tokens in URLs can end up in proxy and browser logs,
+// so enable it only on the routes that need it.
+func WithQueryToken() AuthOption {
+	return func(o *authOptions) {
+		o.queryToken = true
+	}
+}
+
+func newAuthOptions(opts []AuthOption) authOptions {
+	var o authOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
 // Auth creates a middleware that requires authentication
-// It validates the JWT token and adds the user ID to the request context
-func Auth(authService *service.AuthService) func(http.Handler) http.Handler {
+// It validates the JWT token and adds the user ID and token to the request context
+func Auth(authService *service.AuthService, opts ...AuthOption) func(http.Handler) http.Handler {
+	options := newAuthOptions(opts)
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			token, ok := extractToken(r)
+			token, ok := options.extractToken(r)
 			if !ok {
 				writeUnauthorizedError(w)
 				return
 			}
 
-			userID, err := authService.ValidateToken(token)
+			userID, err := authService.ValidateToken(r.Context(), token)
 			if err != nil {
 				writeUnauthorizedError(w)
 				return
 			}
 
-			// Add user ID to context
+			// Add user ID and token to context
 			ctx := context.WithValue(r.Context(), handler.UserIDContextKey, userID)
+			ctx = context.WithValue(ctx, handler.AccessTokenContextKey, token)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
@@ -36,30 +68,44 @@ func Auth(authService *service.AuthService) func(http.Handler) http.Handler {
 // OptionalAuth creates a middleware that optionally authenticates
 // If a valid token is provided, the user ID is added to context
 // If no token or invalid token, the request continues without user ID
-func OptionalAuth(authService *service.AuthService) func(http.Handler) http.Handler {
+func OptionalAuth(authService *service.AuthService, opts ...AuthOption) func(http.Handler) http.Handler {
+	options := newAuthOptions(opts)
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			token, ok := extractToken(r)
+			token, ok := options.extractToken(r)
 			if !ok {
 				// No token, continue without authentication
 				next.ServeHTTP(w, r)
 				return
 			}
 
-			userID, err := authService.ValidateToken(token)
+			userID, err := authService.ValidateToken(r.Context(), token)
 			if err != nil {
 				// Invalid token, continue without authentication
 				next.ServeHTTP(w, r)
 				return
 			}
 
-			// Add user ID to context
+			// Add user ID and token to context
 			ctx := context.WithValue(r.Context(), handler.UserIDContextKey, userID)
+			ctx = context.WithValue(ctx, handler.AccessTokenContextKey, token)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
 
+// extractToken extracts the JWT token from the Authorization header, falling
+// back to the access_token query parameter only if enabled and the header is
+// absent
+func (o authOptions) extractToken(r *http.Request) (string, bool) {
+	if r.Header.Get("Authorization") == "" && o.queryToken {
+		if token := r.URL.Query().Get(AccessTokenQueryParam); token != "" {
+			return token, true
+		}
+	}
+	return extractToken(r)
+}
+
 // extractToken extracts the JWT token from the Authorization header
 // Expected format: "Token <jwt-token>"
 func extractToken(r *http.Request) (string, bool) {
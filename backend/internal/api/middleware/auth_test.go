@@ -41,6 +41,18 @@ func setupTestDB(t *testing.T) *sql.DB {
 		t.Fatalf("failed to create users table: %v", err)
 	}
 
+	// Create revoked tokens table
+	_, err = db.Exec(`
+		CREATE TABLE revoked_tokens (
+			jti TEXT PRIMARY KEY,
+			expires_at TIMESTAMP NOT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)
+	`)
+	if err != nil {
+		t.Fatalf("failed to create revoked_tokens table: %v", err)
+	}
+
 	return db
 }
 
@@ -55,7 +67,10 @@ func newTestAuthService(t *testing.T) (*service.AuthService, *sql.DB) {
 	db := setupTestDB(t)
 	logger := newTestLogger()
 	userRepo := repository.NewSQLiteUserRepository(db, logger)
-	authService := service.NewAuthService(userRepo, "test-jwt-secret", 24*time.Hour, logger)
+	refreshTokenRepo := repository.NewSQLiteRefreshTokenRepository(db, logger)
+	passwordResetRepo := repository.NewSQLitePasswordResetRepository(db, logger)
+	revokedTokenRepo := repository.NewSQLiteRevokedTokenRepository(db, logger)
+	authService := service.NewAuthService(userRepo, refreshTokenRepo, passwordResetRepo, revokedTokenRepo, "test-jwt-secret", 24*time.Hour, logger)
 	return authService, db
 }
 
@@ -168,6 +183,39 @@ func TestAuthMiddleware(t *testing.T) {
 	})
 }
 
+func TestAuthMiddleware_RevokedToken(t *testing.T) {
+	authService, db := newTestAuthService(t)
+	defer db.Close()
+
+	token, err := authService.GenerateToken(123)
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+
+	protected := Auth(authService)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	logout := OptionalAuth(authService)(http.HandlerFunc(handler.NewUserHandler(authService, newTestLogger()).Logout))
+
+	send := func(h http.Handler, method, target string) int {
+		req := httptest.NewRequest(method, target, nil)
+		req.Header.Set("Authorization", "Token "+token)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	if code := send(protected, http.MethodGet, "/api/user"); code != http.StatusOK {
+		t.Fatalf("expected status %d before logout, got %d", http.StatusOK, code)
+	}
+	if code := send(logout, http.MethodPost, "/api/users/logout"); code != http.StatusNoContent {
+		t.Fatalf("expected status %d from logout, got %d", http.StatusNoContent, code)
+	}
+	if code := send(protected, http.MethodGet, "/api/user"); code != http.StatusUnauthorized {
+		t.Errorf("expected status %d after logout, got %d", http.StatusUnauthorized, code)
+	}
+}
+
 func TestOptionalAuthMiddleware(t *testing.T) {
 	t.Run("allows request without token", func(t *testing.T) {
 		authService, db := newTestAuthService(t)
@@ -253,3 +301,84 @@ func TestOptionalAuthMiddleware(t *testing.T) {
 		}
 	})
 }
+
+func TestAuthMiddleware_QueryToken(t *testing.T) {
+	authService, db := newTestAuthService(t)
+	defer db.Close()
+
+	token, err := authService.GenerateToken(789)
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+	otherToken, err := authService.GenerateToken(790)
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+
+	// serve reports the status and the authenticated user ID (0 if none)
+	serve := func(mw func(http.Handler) http.Handler, req *http.Request) (int, int64) {
+		var userID int64
+		testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userID, _ = r.Context().Value(handler.UserIDContextKey).(int64)
+			w.WriteHeader(http.StatusOK)
+		})
+		w := httptest.NewRecorder()
+		mw(testHandler).ServeHTTP(w, req)
+		return w.Code, userID
+	}
+
+	t.Run("accepts the query parameter when enabled", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/events?access_token="+token, nil)
+		code, userID := serve(Auth(authService, WithQueryToken()), req)
+
+		if code != http.StatusOK {
+			t.Errorf("expected status %d, got %d", http.StatusOK, code)
+		}
+		if userID != 789 {
+			t.Errorf("expected user ID 789, got %d", userID)
+		}
+	})
+
+	t.Run("ignores the query parameter by default", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/events?access_token="+token, nil)
+		code, _ := serve(Auth(authService), req)
+
+		if code != http.StatusUnauthorized {
+			t.Errorf("expected status %d, got %d", http.StatusUnauthorized, code)
+		}
+	})
+
+	t.Run("prefers the Authorization header", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/events?access_token="+otherToken, nil)
+		req.Header.Set("Authorization", "Token "+token)
+		code, userID := serve(Auth(authService, WithQueryToken()), req)
+
+		if code != http.StatusOK {
+			t.Errorf("expected status %d, got %d", http.StatusOK, code)
+		}
+		if userID != 789 {
+			t.Errorf("expected the header's user ID 789, got %d", userID)
+		}
+	})
+
+	t.Run("rejects an invalid query token", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/events?access_token=invalid.token", nil)
+		code, _ := serve(Auth(authService, WithQueryToken()), req)
+
+		if code != http.StatusUnauthorized {
+			t.Errorf("expected status %d, got %d", http.StatusUnauthorized, code)
+		}
+	})
+
+	t.Run("optional auth reads the query parameter when enabled", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/events?access_token="+token, nil)
+		if _, userID := serve(OptionalAuth(authService, WithQueryToken()), req); userID != 789 {
+			t.Errorf("expected user ID 789, got %d", userID)
+		}
+
+		req = httptest.NewRequest(http.MethodGet, "/api/events?access_token="+token, nil)
+		if _, userID := serve(OptionalAuth(authService), req); userID != 0 {
+			t.Errorf("expected no user without the option, got %d", userID)
+		}
+	})
+}
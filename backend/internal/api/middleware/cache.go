@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/alexlee0213/realworld-conduit/backend/internal/api/handler"
+)
+
+// privateCacheControl keeps personalized responses out of shared caches
+const privateCacheControl = "private, no-store"
+
+// CacheControl creates a middleware that sets Cache-Control on public GET
+// endpoints. Anonymous successful responses get the public policy; requests
+// carrying credentials get "private, no-store" since the body may include
+// per-user fields like favorited or following. Every response also varies on
+// Authorization so a shared cache never hands the anonymous copy to a
+// logged-in request for the same URL. An empty policy disables it.
+//
+// It must run inside OptionalAuth so the authenticated user is visible.
+func CacheControl(publicPolicy string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if publicPolicy == "" {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Add("Vary", "Authorization")
+
+			if isAuthenticatedRequest(r) {
+				w.Header().Set("Cache-Control", privateCacheControl)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			next.ServeHTTP(&cacheControlWriter{ResponseWriter: w, policy: publicPolicy}, r)
+		})
+	}
+}
+
+// isAuthenticatedRequest reports whether the request carries a user or any
+// credentials, even ones OptionalAuth rejected
+func isAuthenticatedRequest(r *http.Request) bool {
+	if _, ok := r.Context().Value(handler.UserIDContextKey).(int64); ok {
+		return true
+	}
+	return r.Header.Get("Authorization") != ""
+}
+
+// cacheControlWriter applies the public policy only to successful responses
+// so errors are never cached
+type cacheControlWriter struct {
+	http.ResponseWriter
+	policy      string
+	wroteHeader bool
+}
+
+func (cw *cacheControlWriter) WriteHeader(code int) {
+	if !cw.wroteHeader {
+		cw.wroteHeader = true
+		if code >= 200 && code < 300 {
+			cw.Header().Set("Cache-Control", cw.policy)
+		} else {
+			cw.Header().Set("Cache-Control", "no-store")
+		}
+	}
+	cw.ResponseWriter.WriteHeader(code)
+}
+
+func (cw *cacheControlWriter) Write(b []byte) (int, error) {
+	if !cw.wroteHeader {
+		cw.WriteHeader(http.StatusOK)
+	}
+	return cw.ResponseWriter.Write(b)
+}
@@ -0,0 +1,100 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/alexlee0213/realworld-conduit/backend/internal/api/handler"
+)
+
+const publicPolicy = "public, max-age=30, stale-while-revalidate=60"
+
+func newCacheTestHandler(policy string, status int) http.Handler {
+	return CacheControl(policy)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	}))
+}
+
+func TestCacheControl(t *testing.T) {
+	t.Run("anonymous requests get the public policy", func(t *testing.T) {
+		h := newCacheTestHandler(publicPolicy, http.StatusOK)
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/articles", nil))
+
+		if got := w.Header().Get("Cache-Control"); got != publicPolicy {
+			t.Errorf("expected %q, got %q", publicPolicy, got)
+		}
+	})
+
+	t.Run("authenticated requests get private, no-store", func(t *testing.T) {
+		h := newCacheTestHandler(publicPolicy, http.StatusOK)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/articles", nil)
+		req = req.WithContext(context.WithValue(req.Context(), handler.UserIDContextKey, int64(1)))
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		if got := w.Header().Get("Cache-Control"); got != "private, no-store" {
+			t.Errorf("expected private, no-store, got %q", got)
+		}
+	})
+
+	t.Run("requests with rejected credentials are not shared", func(t *testing.T) {
+		h := newCacheTestHandler(publicPolicy, http.StatusOK)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/tags", nil)
+		req.Header.Set("Authorization", "Token invalid")
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		if got := w.Header().Get("Cache-Control"); got != "private, no-store" {
+			t.Errorf("expected private, no-store, got %q", got)
+		}
+	})
+
+	t.Run("error responses are not cached", func(t *testing.T) {
+		h := newCacheTestHandler(publicPolicy, http.StatusNotFound)
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/articles/missing", nil))
+
+		if got := w.Header().Get("Cache-Control"); got != "no-store" {
+			t.Errorf("expected no-store, got %q", got)
+		}
+	})
+
+	t.Run("responses vary on Authorization", func(t *testing.T) {
+		for name, h := range map[string]http.Handler{
+			"success": newCacheTestHandler(publicPolicy, http.StatusOK),
+			"error":   newCacheTestHandler(publicPolicy, http.StatusNotFound),
+		} {
+			for _, auth := range []string{"", "Token abc"} {
+				req := httptest.NewRequest(http.MethodGet, "/api/articles", nil)
+				if auth != "" {
+					req.Header.Set("Authorization", auth)
+				}
+				w := httptest.NewRecorder()
+				h.ServeHTTP(w, req)
+
+				if got := w.Header().Values("Vary"); !slices.Contains(got, "Authorization") {
+					t.Errorf("%s, authorization %q: expected Vary: Authorization, got %q", name, auth, got)
+				}
+			}
+		}
+	})
+
+	t.Run("empty policy disables the header", func(t *testing.T) {
+		h := newCacheTestHandler("", http.StatusOK)
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/articles", nil))
+
+		if got := w.Header().Get("Cache-Control"); got != "" {
+			t.Errorf("expected no Cache-Control header, got %q", got)
+		}
+	})
+}
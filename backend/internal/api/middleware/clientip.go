@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// TrustedProxies is a set of networks whose X-Forwarded-For headers are honored
+type TrustedProxies []*net.IPNet
+
+// ParseTrustedProxies parses a list of IP addresses or CIDR ranges.
+// Invalid entries are skipped.
+func ParseTrustedProxies(entries []string) TrustedProxies {
+	var proxies TrustedProxies
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if !strings.Contains(entry, "/") {
+			if ip := net.ParseIP(entry); ip != nil {
+				bits := 32
+				if ip.To4() == nil {
+					bits = 128
+				}
+				entry = ip.String() + "/" + strconv.Itoa(bits)
+			}
+		}
+		if _, network, err := net.ParseCIDR(entry); err == nil {
+			proxies = append(proxies, network)
+		}
+	}
+	return proxies
+}
+
+// contains reports whether ip belongs to one of the trusted networks
+func (p TrustedProxies) contains(ip net.IP) bool {
+	for _, network := range p {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP returns the originating client IP for a request. X-Forwarded-For
+// is only consulted when the direct peer is a trusted proxy, and is walked
+// right to left so a client cannot spoof its address by prepending entries.
+func ClientIP(r *http.Request, trusted TrustedProxies) string {
+	remote := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(remote); err == nil {
+		remote = host
+	}
+
+	remoteIP := net.ParseIP(remote)
+	if remoteIP == nil || !trusted.contains(remoteIP) {
+		return remote
+	}
+
+	hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := net.ParseIP(strings.TrimSpace(hops[i]))
+		if hop == nil {
+			// Malformed entry: stop trusting the chain here
+			break
+		}
+		if !trusted.contains(hop) {
+			return hop.String()
+		}
+	}
+
+	return remote
+}
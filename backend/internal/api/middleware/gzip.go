@@ -0,0 +1,177 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// gzipMinSize is the smallest body worth compressing; below it the gzip
+// framing costs more than it saves
+const gzipMinSize = 1024
+
+// Gzip creates a middleware that compresses responses for clients sending
+// Accept-Encoding: gzip. The body is buffered until it reaches gzipMinSize,
+// so tiny responses, bodiless statuses like 204, and responses the handler
+// already encoded are passed through unchanged.
+func Gzip() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// The representation depends on Accept-Encoding either way
+			w.Header().Add("Vary", "Accept-Encoding")
+
+			if r.Method == http.MethodHead || !acceptsGzip(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			gw := &gzipResponseWriter{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(gw, r)
+			// Not deferred: after a panic nothing buffered should reach the
+			// client, leaving Recover free to send its 500
+			gw.Close()
+		})
+	}
+}
+
+// acceptsGzip reports whether Accept-Encoding lists gzip (or *) without q=0
+func acceptsGzip(r *http.Request) bool {
+	for _, header := range r.Header.Values("Accept-Encoding") {
+		for _, part := range strings.Split(header, ",") {
+			coding, params, _ := strings.Cut(part, ";")
+			coding = strings.ToLower(strings.TrimSpace(coding))
+			if (coding == "gzip" || coding == "*") && !zeroQuality(params) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// zeroQuality reports whether coding parameters carry q=0, which refuses
+// the coding
+func zeroQuality(params string) bool {
+	for _, param := range strings.Split(params, ";") {
+		name, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+		if strings.EqualFold(name, "q") {
+			q, err := strconv.ParseFloat(value, 64)
+			return err == nil && q == 0
+		}
+	}
+	return false
+}
+
+// gzipResponseWriter holds back the status and the start of the body until
+// it knows whether the response is worth compressing
+type gzipResponseWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	buf         []byte
+	// started is set once the header has gone out; gz is non-nil if the
+	// body is being compressed
+	started bool
+	gz      *gzip.Writer
+}
+
+func (gw *gzipResponseWriter) WriteHeader(code int) {
+	if gw.wroteHeader || gw.started {
+		return
+	}
+	gw.status = code
+	gw.wroteHeader = true
+
+	// Bodiless responses go out as they are
+	if code == http.StatusNoContent || code == http.StatusNotModified {
+		gw.start(false)
+	}
+}
+
+func (gw *gzipResponseWriter) Write(b []byte) (int, error) {
+	if !gw.wroteHeader {
+		gw.WriteHeader(http.StatusOK)
+	}
+	if gw.started {
+		if gw.gz != nil {
+			return gw.gz.Write(b)
+		}
+		return gw.ResponseWriter.Write(b)
+	}
+
+	gw.buf = append(gw.buf, b...)
+	if len(gw.buf) >= gzipMinSize {
+		if err := gw.start(true); err != nil {
+			return 0, err
+		}
+	}
+	return len(b), nil
+}
+
+// start sends the header, compressing the body if asked and the handler
+// hasn't chosen an encoding itself, then writes out whatever was buffered
+func (gw *gzipResponseWriter) start(compress bool) error {
+	gw.started = true
+	header := gw.Header()
+
+	if compress && header.Get("Content-Encoding") == "" {
+		header.Set("Content-Encoding", "gzip")
+		header.Del("Content-Length")
+		gw.ResponseWriter.WriteHeader(gw.status)
+		gw.gz = gzip.NewWriter(gw.ResponseWriter)
+		if len(gw.buf) == 0 {
+			return nil
+		}
+		_, err := gw.gz.Write(gw.buf)
+		gw.buf = nil
+		return err
+	}
+
+	gw.ResponseWriter.WriteHeader(gw.status)
+	if len(gw.buf) == 0 {
+		return nil
+	}
+	_, err := gw.ResponseWriter.Write(gw.buf)
+	gw.buf = nil
+	return err
+}
+
+// Flush sends everything written so far. A response flushed before it
+// reached gzipMinSize is compressed anyway, since more is likely to follow.
+func (gw *gzipResponseWriter) Flush() {
+	if !gw.started {
+		if !gw.wroteHeader {
+			gw.WriteHeader(http.StatusOK)
+		}
+		if !gw.started {
+			gw.start(len(gw.buf) > 0)
+		}
+	}
+	if gw.gz != nil {
+		gw.gz.Flush()
+	}
+	http.NewResponseController(gw.ResponseWriter).Flush()
+}
+
+// Close finishes the response: a body that never reached gzipMinSize is sent
+// uncompressed, and a compressed one gets its gzip trailer
+func (gw *gzipResponseWriter) Close() error {
+	if !gw.started {
+		if !gw.wroteHeader {
+			// The handler wrote nothing at all; let net/http send its default
+			return nil
+		}
+		if err := gw.start(false); err != nil {
+			return err
+		}
+	}
+	if gw.gz != nil {
+		return gw.gz.Close()
+	}
+	return nil
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer
+func (gw *gzipResponseWriter) Unwrap() http.ResponseWriter {
+	return gw.ResponseWriter
+}
@@ -0,0 +1,133 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func gzipRequest(h http.Handler, acceptEncoding string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/api/articles", nil)
+	if acceptEncoding != "" {
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	return w
+}
+
+func TestGzip(t *testing.T) {
+	large := strings.Repeat(`{"title":"compress me"},`, 200)
+	writeLarge := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		// Written in pieces so the size threshold is crossed mid-body
+		for i := 0; i < len(large); i += 100 {
+			w.Write([]byte(large[i:min(i+100, len(large))]))
+		}
+	})
+
+	t.Run("compresses large responses", func(t *testing.T) {
+		w := gzipRequest(Gzip()(writeLarge), "br, gzip;q=0.8")
+
+		if got := w.Header().Get("Content-Encoding"); got != "gzip" {
+			t.Fatalf("expected Content-Encoding gzip, got %q", got)
+		}
+		if got := w.Header().Get("Vary"); got != "Accept-Encoding" {
+			t.Errorf("expected Vary Accept-Encoding, got %q", got)
+		}
+
+		zr, err := gzip.NewReader(w.Body)
+		if err != nil {
+			t.Fatalf("failed to open gzip body: %v", err)
+		}
+		body, err := io.ReadAll(zr)
+		if err != nil {
+			t.Fatalf("failed to decompress body: %v", err)
+		}
+		if string(body) != large {
+			t.Error("decompressed body does not match what the handler wrote")
+		}
+	})
+
+	t.Run("leaves responses alone for clients without gzip", func(t *testing.T) {
+		for _, accept := range []string{"", "br", "gzip;q=0"} {
+			w := gzipRequest(Gzip()(writeLarge), accept)
+			if got := w.Header().Get("Content-Encoding"); got != "" {
+				t.Errorf("Accept-Encoding %q: expected no Content-Encoding, got %q", accept, got)
+			}
+			if w.Body.String() != large {
+				t.Errorf("Accept-Encoding %q: expected the body unchanged", accept)
+			}
+			if got := w.Header().Get("Vary"); got != "Accept-Encoding" {
+				t.Errorf("Accept-Encoding %q: expected Vary Accept-Encoding, got %q", accept, got)
+			}
+		}
+	})
+
+	t.Run("skips tiny responses", func(t *testing.T) {
+		h := Gzip()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"ok":true}`))
+		}))
+		w := gzipRequest(h, "gzip")
+
+		if got := w.Header().Get("Content-Encoding"); got != "" {
+			t.Errorf("expected no Content-Encoding, got %q", got)
+		}
+		if w.Code != http.StatusCreated || w.Body.String() != `{"ok":true}` {
+			t.Errorf("expected 201 with the original body, got %d %q", w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("skips already encoded responses", func(t *testing.T) {
+		h := Gzip()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Encoding", "br")
+			w.Write([]byte(large))
+		}))
+		w := gzipRequest(h, "gzip")
+
+		if got := w.Header().Get("Content-Encoding"); got != "br" {
+			t.Errorf("expected Content-Encoding br, got %q", got)
+		}
+		if w.Body.String() != large {
+			t.Error("expected the body unchanged")
+		}
+	})
+
+	t.Run("passes 204 No Content through", func(t *testing.T) {
+		h := Gzip()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		w := gzipRequest(h, "gzip")
+
+		if w.Code != http.StatusNoContent {
+			t.Errorf("expected status %d, got %d", http.StatusNoContent, w.Code)
+		}
+		if w.Body.Len() != 0 || w.Header().Get("Content-Encoding") != "" {
+			t.Errorf("expected an empty, unencoded body, got %q (%q)", w.Body.String(), w.Header().Get("Content-Encoding"))
+		}
+	})
+
+	t.Run("flushes compressed data through", func(t *testing.T) {
+		h := Gzip()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("first chunk"))
+			http.NewResponseController(w).Flush()
+		}))
+		w := gzipRequest(h, "gzip")
+
+		if !w.Flushed {
+			t.Error("expected the underlying writer to be flushed")
+		}
+		zr, err := gzip.NewReader(w.Body)
+		if err != nil {
+			t.Fatalf("failed to open gzip body: %v", err)
+		}
+		body, _ := io.ReadAll(zr)
+		if string(body) != "first chunk" {
+			t.Errorf("expected flushed body %q, got %q", "first chunk", body)
+		}
+	})
+}
@@ -0,0 +1,22 @@
+package middleware
+
+import "net/http"
+
+// MaxBodyBytes creates a middleware that caps request bodies at limit bytes
+// using http.MaxBytesReader. Reading past the limit fails with
+// *http.MaxBytesError, which handlers report as 413. Requests without a body
+// are passed through untouched, and a limit of zero or less disables the cap.
+func MaxBodyBytes(limit int64) func(http.Handler) http.Handler {
+	if limit <= 0 {
+		return func(next http.Handler) http.Handler { return next }
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Body != nil && r.Body != http.NoBody {
+				r.Body = http.MaxBytesReader(w, r.Body, limit)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
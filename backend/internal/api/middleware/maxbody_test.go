@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMaxBodyBytes(t *testing.T) {
+	// readBody reports the status the handler chooses after reading the body
+	readBody := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := io.ReadAll(r.Body)
+		var maxBytesErr *http.MaxBytesError
+		switch {
+		case errors.As(err, &maxBytesErr):
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+		case err != nil:
+			w.WriteHeader(http.StatusBadRequest)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	})
+
+	send := func(h http.Handler, body string) int {
+		req := httptest.NewRequest(http.MethodPost, "/api/articles", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	t.Run("rejects bodies over the limit", func(t *testing.T) {
+		h := MaxBodyBytes(16)(readBody)
+		if code := send(h, strings.Repeat("x", 17)); code != http.StatusRequestEntityTooLarge {
+			t.Errorf("expected status %d, got %d", http.StatusRequestEntityTooLarge, code)
+		}
+	})
+
+	t.Run("allows bodies up to the limit", func(t *testing.T) {
+		h := MaxBodyBytes(16)(readBody)
+		if code := send(h, strings.Repeat("x", 16)); code != http.StatusOK {
+			t.Errorf("expected status %d, got %d", http.StatusOK, code)
+		}
+	})
+
+	t.Run("disabled when the limit is not positive", func(t *testing.T) {
+		h := MaxBodyBytes(0)(readBody)
+		if code := send(h, strings.Repeat("x", 4096)); code != http.StatusOK {
+			t.Errorf("expected status %d, got %d", http.StatusOK, code)
+		}
+	})
+}
@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// unmatchedRoute labels requests that don't match any registered pattern, so
+// scanners probing random paths share a single series
+const unmatchedRoute = "unmatched"
+
+// MetricsConfig configures request metrics
+type MetricsConfig struct {
+	// Registerer receives the request collectors
+	Registerer prometheus.Registerer
+	// Routes resolves each request to the ServeMux pattern used as its route
+	// label, e.g. /api/articles/{slug}
+	Routes *http.ServeMux
+}
+
+// Metrics creates a middleware that records Prometheus request counts and
+// latencies labeled by method, route pattern and status code. Labeling by
+// pattern rather than the concrete path keeps the number of series bounded.
+// It panics if the collectors are already registered with config.Registerer.
+func Metrics(config MetricsConfig) func(http.Handler) http.Handler {
+	requests := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "Total HTTP requests by method, route and status code.",
+	}, []string{"method", "route", "status"})
+	duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "HTTP request latency by method and route.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route"})
+	config.Registerer.MustRegister(requests, duration)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Resolve the route up front: middleware that copies the request
+			// (e.g. to attach a context value) hides r.Pattern set by the mux
+			route := routePattern(config.Routes, r)
+			start := time.Now()
+			wrapped := wrapResponseWriter(w)
+
+			next.ServeHTTP(wrapped, r)
+
+			method := metricsMethod(r.Method)
+			requests.WithLabelValues(method, route, strconv.Itoa(wrapped.status)).Inc()
+			duration.WithLabelValues(method, route).Observe(time.Since(start).Seconds())
+		})
+	}
+}
+
+// metricsMethod folds methods the API doesn't use into "OTHER", since the
+// method label is client-controlled
+func metricsMethod(method string) string {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut,
+		http.MethodPatch, http.MethodDelete, http.MethodOptions:
+		return method
+	}
+	return "OTHER"
+}
+
+// routePattern returns the path part of the pattern mux would dispatch r to,
+// or unmatchedRoute. The method is dropped since it is a label of its own.
+func routePattern(mux *http.ServeMux, r *http.Request) string {
+	_, pattern := mux.Handler(r)
+	// "/" is the router's JSON 404/405 fallback, not a real route
+	if pattern == "" || pattern == "/" {
+		return unmatchedRoute
+	}
+	if _, path, ok := strings.Cut(pattern, " "); ok {
+		return path
+	}
+	return pattern
+}
@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestMetrics(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/profiles/{username}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("POST /api/profiles/{username}/follow", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	registry := prometheus.NewRegistry()
+	h := Metrics(MetricsConfig{Registerer: registry, Routes: mux})(mux)
+
+	send := func(method, path string) {
+		req := httptest.NewRequest(method, path, nil)
+		h.ServeHTTP(httptest.NewRecorder(), req)
+	}
+	send(http.MethodGet, "/api/profiles/alice")
+	send(http.MethodGet, "/api/profiles/bob")
+	send(http.MethodPost, "/api/profiles/alice/follow")
+	send(http.MethodGet, "/wp-login.php")
+	send("PROPFIND", "/api/profiles/alice")
+
+	tests := []struct {
+		labels []string
+		want   float64
+	}{
+		{[]string{"GET", "/api/profiles/{username}", "200"}, 2},
+		{[]string{"POST", "/api/profiles/{username}/follow", "401"}, 1},
+		{[]string{"GET", unmatchedRoute, "404"}, 1},
+		{[]string{"OTHER", unmatchedRoute, "405"}, 1},
+	}
+
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatalf("Gather() error = %v", err)
+	}
+	counts := make(map[string]float64)
+	for _, family := range families {
+		if family.GetName() != "http_requests_total" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			labels := make(map[string]string)
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			counts[labels["method"]+" "+labels["route"]+" "+labels["status"]] = metric.GetCounter().GetValue()
+		}
+	}
+
+	for _, tt := range tests {
+		if got := counts[strings.Join(tt.labels, " ")]; got != tt.want {
+			t.Errorf("http_requests_total%v = %v, want %v", tt.labels, got, tt.want)
+		}
+	}
+	if len(counts) != len(tests) {
+		t.Errorf("expected %d request series, got %d: %v", len(tests), len(counts), counts)
+	}
+}
@@ -0,0 +1,125 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// IPQuotaConfig configures the global per-IP request quota
+type IPQuotaConfig struct {
+	// RequestsPerMinute is the number of requests a single client IP may make
+	// per minute across all routes. Zero disables the quota.
+	RequestsPerMinute int
+	// TrustedProxies whose X-Forwarded-For header identifies the client
+	TrustedProxies TrustedProxies
+	// ExemptPaths are never counted against the quota
+	ExemptPaths []string
+}
+
+// DefaultIPQuotaConfig returns a disabled quota that exempts health checks
+func DefaultIPQuotaConfig() IPQuotaConfig {
+	return IPQuotaConfig{
+		RequestsPerMinute: 0,
+		ExemptPaths:       []string{"/health", "/health/ready"},
+	}
+}
+
+// quotaWindow tracks requests from one client in the current minute
+type quotaWindow struct {
+	start time.Time
+	count int
+}
+
+// ipQuota is a fixed-window request counter keyed by client IP
+type ipQuota struct {
+	mu        sync.Mutex
+	limit     int
+	window    time.Duration
+	clients   map[string]*quotaWindow
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// allow records a request and reports whether it is within the quota.
+// When it is not, the time until the window resets is returned.
+func (q *ipQuota) allow(ip string) (bool, time.Duration) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := q.now()
+	q.sweep(now)
+
+	w, ok := q.clients[ip]
+	if !ok || now.Sub(w.start) >= q.window {
+		q.clients[ip] = &quotaWindow{start: now, count: 1}
+		return true, 0
+	}
+
+	if w.count >= q.limit {
+		return false, w.start.Add(q.window).Sub(now)
+	}
+	w.count++
+	return true, 0
+}
+
+// sweep drops expired windows so idle clients don't accumulate in memory
+func (q *ipQuota) sweep(now time.Time) {
+	if now.Sub(q.lastSweep) < q.window {
+		return
+	}
+	for ip, w := range q.clients {
+		if now.Sub(w.start) >= q.window {
+			delete(q.clients, ip)
+		}
+	}
+	q.lastSweep = now
+}
+
+// IPQuota creates a middleware that limits how many requests each client IP
+// may make per minute. Requests over the quota receive 429 with Retry-After.
+func IPQuota(config IPQuotaConfig) func(http.Handler) http.Handler {
+	return ipQuotaWithClock(config, time.Now)
+}
+
+func ipQuotaWithClock(config IPQuotaConfig, now func() time.Time) func(http.Handler) http.Handler {
+	if config.RequestsPerMinute <= 0 {
+		return func(next http.Handler) http.Handler { return next }
+	}
+
+	exempt := make(map[string]bool, len(config.ExemptPaths))
+	for _, path := range config.ExemptPaths {
+		exempt[path] = true
+	}
+
+	quota := &ipQuota{
+		limit:     config.RequestsPerMinute,
+		window:    time.Minute,
+		clients:   make(map[string]*quotaWindow),
+		lastSweep: now(),
+		now:       now,
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if exempt[r.URL.Path] {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			allowed, retryAfter := quota.allow(ClientIP(r, config.TrustedProxies))
+			if !allowed {
+				// Round up so clients never retry before the window resets
+				seconds := int((retryAfter + time.Second - 1) / time.Second)
+				w.Header().Set("Retry-After", strconv.Itoa(seconds))
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusTooManyRequests)
+				w.Write([]byte(`{"errors":{"request":["too many requests"]}}`))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
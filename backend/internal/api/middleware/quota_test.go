@@ -0,0 +1,133 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newQuotaTestHandler(config IPQuotaConfig, now func() time.Time) http.Handler {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	return ipQuotaWithClock(config, now)(ok)
+}
+
+func quotaRequest(h http.Handler, path, remoteAddr string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.RemoteAddr = remoteAddr
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	return w
+}
+
+func TestIPQuota(t *testing.T) {
+	t.Run("rejects requests once the quota is saturated", func(t *testing.T) {
+		config := DefaultIPQuotaConfig()
+		config.RequestsPerMinute = 3
+		h := newQuotaTestHandler(config, time.Now)
+
+		for i := 0; i < 3; i++ {
+			if w := quotaRequest(h, "/api/articles", "192.0.2.1:1234"); w.Code != http.StatusOK {
+				t.Fatalf("request %d: expected status %d, got %d", i+1, http.StatusOK, w.Code)
+			}
+		}
+
+		w := quotaRequest(h, "/api/articles", "192.0.2.1:1234")
+		if w.Code != http.StatusTooManyRequests {
+			t.Fatalf("expected status %d, got %d", http.StatusTooManyRequests, w.Code)
+		}
+		if w.Header().Get("Retry-After") == "" {
+			t.Error("expected Retry-After header")
+		}
+
+		// Other clients have their own quota
+		if w := quotaRequest(h, "/api/articles", "192.0.2.2:1234"); w.Code != http.StatusOK {
+			t.Errorf("expected other client to be allowed, got %d", w.Code)
+		}
+	})
+
+	t.Run("health checks are not affected", func(t *testing.T) {
+		config := DefaultIPQuotaConfig()
+		config.RequestsPerMinute = 1
+		h := newQuotaTestHandler(config, time.Now)
+
+		quotaRequest(h, "/api/articles", "192.0.2.1:1234")
+		if w := quotaRequest(h, "/api/articles", "192.0.2.1:1234"); w.Code != http.StatusTooManyRequests {
+			t.Fatalf("expected quota to be saturated, got %d", w.Code)
+		}
+
+		for i := 0; i < 5; i++ {
+			if w := quotaRequest(h, "/health", "192.0.2.1:1234"); w.Code != http.StatusOK {
+				t.Fatalf("expected health check to succeed, got %d", w.Code)
+			}
+		}
+	})
+
+	t.Run("quota resets after a minute", func(t *testing.T) {
+		now := time.Now()
+		clock := func() time.Time { return now }
+
+		config := DefaultIPQuotaConfig()
+		config.RequestsPerMinute = 1
+		h := newQuotaTestHandler(config, clock)
+
+		quotaRequest(h, "/api/tags", "192.0.2.1:1234")
+		now = now.Add(30 * time.Second)
+		w := quotaRequest(h, "/api/tags", "192.0.2.1:1234")
+		if w.Code != http.StatusTooManyRequests {
+			t.Fatalf("expected status %d, got %d", http.StatusTooManyRequests, w.Code)
+		}
+		if got := w.Header().Get("Retry-After"); got != "30" {
+			t.Errorf("expected Retry-After 30, got %q", got)
+		}
+
+		now = now.Add(30 * time.Second)
+		if w := quotaRequest(h, "/api/tags", "192.0.2.1:1234"); w.Code != http.StatusOK {
+			t.Errorf("expected quota to reset, got %d", w.Code)
+		}
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		h := newQuotaTestHandler(DefaultIPQuotaConfig(), time.Now)
+
+		for i := 0; i < 100; i++ {
+			if w := quotaRequest(h, "/api/articles", "192.0.2.1:1234"); w.Code != http.StatusOK {
+				t.Fatalf("expected no quota, got %d", w.Code)
+			}
+		}
+	})
+}
+
+func TestClientIP(t *testing.T) {
+	trusted := ParseTrustedProxies([]string{"10.0.0.0/8", "192.0.2.10"})
+
+	tests := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor string
+		want         string
+	}{
+		{"uses remote address without proxy", "198.51.100.7:5000", "", "198.51.100.7"},
+		{"ignores forwarded header from untrusted peer", "198.51.100.7:5000", "203.0.113.9", "198.51.100.7"},
+		{"honors forwarded header from trusted proxy", "10.1.2.3:5000", "203.0.113.9", "203.0.113.9"},
+		{"honors single trusted proxy address", "192.0.2.10:5000", "203.0.113.9", "203.0.113.9"},
+		{"skips trusted hops right to left", "10.1.2.3:5000", "1.1.1.1, 203.0.113.9, 10.9.9.9", "203.0.113.9"},
+		{"falls back to proxy when header missing", "10.1.2.3:5000", "", "10.1.2.3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = tt.remoteAddr
+			if tt.forwardedFor != "" {
+				req.Header.Set("X-Forwarded-For", tt.forwardedFor)
+			}
+
+			if got := ClientIP(req, trusted); got != tt.want {
+				t.Errorf("ClientIP() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimitConfig configures the per-IP token bucket rate limit
+type RateLimitConfig struct {
+	// RequestsPerSecond is the rate at which a client's bucket refills.
+	// Zero disables the limit.
+	RequestsPerSecond float64
+	// Burst is the bucket size: how many requests a client may make at once
+	// after being idle. Values below 1 are treated as 1.
+	Burst int
+	// TrustedProxies whose X-Forwarded-For header identifies the client
+	TrustedProxies TrustedProxies
+	// ExemptPaths are never rate limited
+	ExemptPaths []string
+}
+
+// DefaultRateLimitConfig returns a disabled rate limit that exempts health checks
+func DefaultRateLimitConfig() RateLimitConfig {
+	return RateLimitConfig{
+		RequestsPerSecond: 0,
+		Burst:             1,
+		ExemptPaths:       []string{"/health", "/health/ready"},
+	}
+}
+
+// tokenBucket holds one client's remaining tokens as of last
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// rateLimiter is a token bucket per client IP
+type rateLimiter struct {
+	mu    sync.Mutex
+	rate  float64
+	burst float64
+	// idle is how long a bucket takes to refill completely; an entry left
+	// alone that long is indistinguishable from a new one and can be dropped
+	idle      time.Duration
+	clients   map[string]*tokenBucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// allow takes a token for ip and reports whether one was available. When
+// none was, the time until the next token arrives is returned.
+func (l *rateLimiter) allow(ip string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	b, ok := l.clients[ip]
+	if !ok {
+		b = &tokenBucket{tokens: l.burst, last: now}
+		l.clients[ip] = b
+	} else {
+		elapsed := now.Sub(b.last).Seconds()
+		b.tokens = math.Min(l.burst, b.tokens+elapsed*l.rate)
+		b.last = now
+	}
+
+	if b.tokens < 1 {
+		wait := time.Duration((1 - b.tokens) / l.rate * float64(time.Second))
+		return false, wait
+	}
+	b.tokens--
+	return true, 0
+}
+
+// sweep drops buckets that have refilled so idle clients don't accumulate in
+// memory
+func (l *rateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < l.idle {
+		return
+	}
+	for ip, b := range l.clients {
+		if now.Sub(b.last) >= l.idle {
+			delete(l.clients, ip)
+		}
+	}
+	l.lastSweep = now
+}
+
+// RateLimit creates a middleware that smooths each client IP's traffic with a
+// token bucket. Requests that find the bucket empty receive 429 with
+// Retry-After.
+func RateLimit(config RateLimitConfig) func(http.Handler) http.Handler {
+	return rateLimitWithClock(config, time.Now)
+}
+
+func rateLimitWithClock(config RateLimitConfig, now func() time.Time) func(http.Handler) http.Handler {
+	if config.RequestsPerSecond <= 0 {
+		return func(next http.Handler) http.Handler { return next }
+	}
+
+	exempt := make(map[string]bool, len(config.ExemptPaths))
+	for _, path := range config.ExemptPaths {
+		exempt[path] = true
+	}
+
+	burst := float64(max(config.Burst, 1))
+	limiter := &rateLimiter{
+		rate:      config.RequestsPerSecond,
+		burst:     burst,
+		idle:      max(time.Duration(burst/config.RequestsPerSecond*float64(time.Second)), time.Second),
+		clients:   make(map[string]*tokenBucket),
+		lastSweep: now(),
+		now:       now,
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if exempt[r.URL.Path] {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			allowed, retryAfter := limiter.allow(ClientIP(r, config.TrustedProxies))
+			if !allowed {
+				// Round up so clients never retry before a token is available
+				seconds := int((retryAfter + time.Second - 1) / time.Second)
+				w.Header().Set("Retry-After", strconv.Itoa(seconds))
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusTooManyRequests)
+				w.Write([]byte(`{"errors":{"request":["too many requests"]}}`))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newRateLimitTestHandler(config RateLimitConfig, now func() time.Time) http.Handler {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	return rateLimitWithClock(config, now)(ok)
+}
+
+func TestRateLimit(t *testing.T) {
+	t.Run("rejects requests beyond the burst", func(t *testing.T) {
+		now := time.Now()
+		clock := func() time.Time { return now }
+
+		config := DefaultRateLimitConfig()
+		config.RequestsPerSecond = 1
+		config.Burst = 3
+		h := newRateLimitTestHandler(config, clock)
+
+		for i := 0; i < 3; i++ {
+			if w := quotaRequest(h, "/api/articles", "192.0.2.1:1234"); w.Code != http.StatusOK {
+				t.Fatalf("request %d: expected status %d, got %d", i+1, http.StatusOK, w.Code)
+			}
+		}
+
+		w := quotaRequest(h, "/api/articles", "192.0.2.1:1234")
+		if w.Code != http.StatusTooManyRequests {
+			t.Fatalf("expected status %d, got %d", http.StatusTooManyRequests, w.Code)
+		}
+		if got := w.Header().Get("Retry-After"); got != "1" {
+			t.Errorf("expected Retry-After 1, got %q", got)
+		}
+
+		// Other clients have their own bucket
+		if w := quotaRequest(h, "/api/articles", "192.0.2.2:1234"); w.Code != http.StatusOK {
+			t.Errorf("expected other client to be allowed, got %d", w.Code)
+		}
+	})
+
+	t.Run("refills at the configured rate", func(t *testing.T) {
+		now := time.Now()
+		clock := func() time.Time { return now }
+
+		config := DefaultRateLimitConfig()
+		config.RequestsPerSecond = 2
+		config.Burst = 2
+		h := newRateLimitTestHandler(config, clock)
+
+		quotaRequest(h, "/api/tags", "192.0.2.1:1234")
+		quotaRequest(h, "/api/tags", "192.0.2.1:1234")
+		if w := quotaRequest(h, "/api/tags", "192.0.2.1:1234"); w.Code != http.StatusTooManyRequests {
+			t.Fatalf("expected bucket to be empty, got %d", w.Code)
+		}
+
+		// Half a second buys one token at 2 requests per second
+		now = now.Add(500 * time.Millisecond)
+		if w := quotaRequest(h, "/api/tags", "192.0.2.1:1234"); w.Code != http.StatusOK {
+			t.Fatalf("expected a refilled token, got %d", w.Code)
+		}
+		if w := quotaRequest(h, "/api/tags", "192.0.2.1:1234"); w.Code != http.StatusTooManyRequests {
+			t.Fatalf("expected only one token after half a second, got %d", w.Code)
+		}
+
+		// Idle long enough and the full burst is back, but no more
+		now = now.Add(10 * time.Second)
+		for i := 0; i < 2; i++ {
+			if w := quotaRequest(h, "/api/tags", "192.0.2.1:1234"); w.Code != http.StatusOK {
+				t.Fatalf("request %d after idle: expected status %d, got %d", i+1, http.StatusOK, w.Code)
+			}
+		}
+		if w := quotaRequest(h, "/api/tags", "192.0.2.1:1234"); w.Code != http.StatusTooManyRequests {
+			t.Errorf("expected the bucket to cap at the burst, got %d", w.Code)
+		}
+	})
+
+	t.Run("keys by forwarded client behind a trusted proxy", func(t *testing.T) {
+		config := DefaultRateLimitConfig()
+		config.RequestsPerSecond = 1
+		config.TrustedProxies = ParseTrustedProxies([]string{"10.0.0.0/8"})
+		h := newRateLimitTestHandler(config, time.Now)
+
+		forwarded := func(client string) int {
+			req := httptest.NewRequest(http.MethodGet, "/api/articles", nil)
+			req.RemoteAddr = "10.0.0.1:5000"
+			req.Header.Set("X-Forwarded-For", client)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+			return w.Code
+		}
+
+		if code := forwarded("203.0.113.1"); code != http.StatusOK {
+			t.Fatalf("expected first client to be allowed, got %d", code)
+		}
+		if code := forwarded("203.0.113.1"); code != http.StatusTooManyRequests {
+			t.Fatalf("expected first client to be limited, got %d", code)
+		}
+		if code := forwarded("203.0.113.2"); code != http.StatusOK {
+			t.Errorf("expected a different client behind the proxy to be allowed, got %d", code)
+		}
+	})
+
+	t.Run("evicts idle clients", func(t *testing.T) {
+		now := time.Now()
+		limiter := &rateLimiter{
+			rate:      1,
+			burst:     2,
+			idle:      2 * time.Second,
+			clients:   make(map[string]*tokenBucket),
+			lastSweep: now,
+			now:       func() time.Time { return now },
+		}
+
+		limiter.allow("192.0.2.1")
+		now = now.Add(3 * time.Second)
+		limiter.allow("192.0.2.2")
+
+		if _, ok := limiter.clients["192.0.2.1"]; ok {
+			t.Error("expected idle client to be evicted")
+		}
+		if _, ok := limiter.clients["192.0.2.2"]; !ok {
+			t.Error("expected active client to be tracked")
+		}
+	})
+
+	t.Run("health checks are not affected", func(t *testing.T) {
+		config := DefaultRateLimitConfig()
+		config.RequestsPerSecond = 1
+		h := newRateLimitTestHandler(config, time.Now)
+
+		for i := 0; i < 5; i++ {
+			if w := quotaRequest(h, "/health", "192.0.2.1:1234"); w.Code != http.StatusOK {
+				t.Fatalf("expected health check to succeed, got %d", w.Code)
+			}
+		}
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		h := newRateLimitTestHandler(DefaultRateLimitConfig(), time.Now)
+
+		for i := 0; i < 100; i++ {
+			if w := quotaRequest(h, "/api/articles", "192.0.2.1:1234"); w.Code != http.StatusOK {
+				t.Fatalf("expected no limit, got %d", w.Code)
+			}
+		}
+	})
+}
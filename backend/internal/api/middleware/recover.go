@@ -4,23 +4,54 @@ import (
 	"log/slog"
 	"net/http"
 	"runtime/debug"
+
+	"github.com/alexlee0213/realworld-conduit/backend/internal/api/handler"
 )
 
+// Recover creates a middleware that turns a handler panic into a 500 with
+// the standard JSON error envelope, logging the stack trace and request ID.
+// If the handler had already started the response, the status can't be
+// changed, so nothing more is written.
 func Recover(logger *slog.Logger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			wrapped := wrapResponseWriter(w)
 			defer func() {
-				if err := recover(); err != nil {
-					logger.Error("panic recovered",
-						"error", err,
-						"stack", string(debug.Stack()),
-						"method", r.Method,
-						"path", r.URL.Path,
-					)
-					http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				err := recover()
+				if err == nil {
+					return
+				}
+				if err == http.ErrAbortHandler {
+					// Deliberate abort: let net/http drop the connection quietly
+					panic(err)
+				}
+
+				// Recover sits outside RequestID, so the context here lacks the
+				// ID; RequestID has already put it on the response header
+				requestID := w.Header().Get(RequestIDHeader)
+				if requestID == "" {
+					requestID = handler.RequestIDFromContext(r.Context())
+				}
+				logger.Error("panic recovered",
+					"error", err,
+					"stack", string(debug.Stack()),
+					"method", r.Method,
+					"path", r.URL.Path,
+					"request_id", requestID,
+					"response_started", wrapped.wroteHeader,
+				)
+
+				if wrapped.wroteHeader {
+					return
 				}
+				header := w.Header()
+				header.Del("Content-Length")
+				header.Del("Content-Encoding")
+				header.Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(`{"errors":{"server":["internal server error"]}}`))
 			}()
-			next.ServeHTTP(w, r)
+			next.ServeHTTP(wrapped, r)
 		})
 	}
 }
@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRecover(t *testing.T) {
+	t.Run("renders a JSON 500 and logs the request ID", func(t *testing.T) {
+		var logs bytes.Buffer
+		logger := slog.New(slog.NewTextHandler(&logs, nil))
+		panicking := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/plain")
+			panic("boom")
+		})
+		h := Recover(logger)(RequestID()(panicking))
+
+		req := httptest.NewRequest(http.MethodGet, "/api/articles", nil)
+		req.Header.Set(RequestIDHeader, "req-panic-1")
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		if w.Code != http.StatusInternalServerError {
+			t.Fatalf("expected status %d, got %d", http.StatusInternalServerError, w.Code)
+		}
+		if got := w.Header().Get("Content-Type"); got != "application/json" {
+			t.Errorf("expected Content-Type application/json, got %q", got)
+		}
+		var resp struct {
+			Errors map[string][]string `json:"errors"`
+		}
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatalf("expected a JSON body: %v", err)
+		}
+		if got := resp.Errors["server"]; len(got) != 1 || got[0] != "internal server error" {
+			t.Errorf("expected server error envelope, got %v", resp.Errors)
+		}
+
+		logged := logs.String()
+		for _, want := range []string{"panic recovered", "request_id=req-panic-1", "stack="} {
+			if !strings.Contains(logged, want) {
+				t.Errorf("expected log to contain %q, got %s", want, logged)
+			}
+		}
+	})
+
+	t.Run("leaves a started response alone", func(t *testing.T) {
+		logger := slog.New(slog.NewTextHandler(&bytes.Buffer{}, nil))
+		panicking := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte("partial"))
+			panic("boom")
+		})
+		h := Recover(logger)(panicking)
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/articles", nil))
+
+		if w.Code != http.StatusOK {
+			t.Errorf("expected the original status %d, got %d", http.StatusOK, w.Code)
+		}
+		if got := w.Body.String(); got != "partial" {
+			t.Errorf("expected nothing appended to the body, got %q", got)
+		}
+	})
+
+	t.Run("re-panics on ErrAbortHandler", func(t *testing.T) {
+		logger := slog.New(slog.NewTextHandler(&bytes.Buffer{}, nil))
+		h := Recover(logger)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			panic(http.ErrAbortHandler)
+		}))
+
+		defer func() {
+			if err := recover(); err != http.ErrAbortHandler {
+				t.Errorf("expected ErrAbortHandler to propagate, got %v", err)
+			}
+		}()
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	})
+}
@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/alexlee0213/realworld-conduit/backend/internal/api/handler"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+func TestRequestID(t *testing.T) {
+	var seen string
+	h := RequestID()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = handler.RequestIDFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(incoming string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/articles", nil)
+		if incoming != "" {
+			req.Header.Set(RequestIDHeader, incoming)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("generates an ID when none is provided", func(t *testing.T) {
+		w := serve("")
+
+		got := w.Header().Get(RequestIDHeader)
+		if !uuidPattern.MatchString(got) {
+			t.Fatalf("expected a UUID in %s, got %q", RequestIDHeader, got)
+		}
+		if seen != got {
+			t.Errorf("expected context ID %q to match header, got %q", got, seen)
+		}
+
+		if other := serve("").Header().Get(RequestIDHeader); other == got {
+			t.Error("expected a fresh ID per request")
+		}
+	})
+
+	t.Run("preserves a client-provided ID", func(t *testing.T) {
+		w := serve("client-trace-42")
+
+		if got := w.Header().Get(RequestIDHeader); got != "client-trace-42" {
+			t.Errorf("expected header to echo client ID, got %q", got)
+		}
+		if seen != "client-trace-42" {
+			t.Errorf("expected context ID client-trace-42, got %q", seen)
+		}
+	})
+
+	t.Run("replaces a malformed client ID", func(t *testing.T) {
+		for _, bad := range []string{"has space", strings.Repeat("a", maxRequestIDLength+1)} {
+			got := serve(bad).Header().Get(RequestIDHeader)
+			if !uuidPattern.MatchString(got) {
+				t.Errorf("expected %q to be replaced by a UUID, got %q", bad, got)
+			}
+		}
+	})
+}
+
+func TestLoggingIncludesRequestID(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	h := RequestID()(Logging(logger)(ok))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tags", nil)
+	req.Header.Set(RequestIDHeader, "abc-123")
+	h.ServeHTTP(httptest.NewRecorder(), req)
+
+	if !strings.Contains(buf.String(), "request_id=abc-123") {
+		t.Errorf("expected request log to carry the request ID, got %q", buf.String())
+	}
+}
@@ -2,10 +2,37 @@ package middleware
 
 import (
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 )
 
+// SecurityConfig configures the security headers added to every response.
+// The defaults live in config (see config.DefaultContentSecurityPolicy).
+type SecurityConfig struct {
+	// FrameOptions is the X-Frame-Options value, e.g. DENY or SAMEORIGIN.
+	// Empty omits the header.
+	FrameOptions string
+	// ContentSecurityPolicy is the Content-Security-Policy value. Empty omits
+	// the header.
+	ContentSecurityPolicy string
+	// HSTSMaxAge enables Strict-Transport-Security for HTTPS requests with
+	// this max-age. Zero disables it.
+	HSTSMaxAge time.Duration
+	// HSTSIncludeSubdomains extends HSTS to every subdomain
+	HSTSIncludeSubdomains bool
+}
+
 // Security creates a middleware that adds security headers to responses
-func Security() func(http.Handler) http.Handler {
+func Security(config SecurityConfig) func(http.Handler) http.Handler {
+	hsts := ""
+	if config.HSTSMaxAge > 0 {
+		hsts = "max-age=" + strconv.FormatInt(int64(config.HSTSMaxAge/time.Second), 10)
+		if config.HSTSIncludeSubdomains {
+			hsts += "; includeSubDomains"
+		}
+	}
+
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			// Prevent MIME type sniffing
@@ -15,18 +42,37 @@ func Security() func(http.Handler) http.Handler {
 			w.Header().Set("X-XSS-Protection", "1; mode=block")
 
 			// Prevent clickjacking
-			w.Header().Set("X-Frame-Options", "DENY")
+			if config.FrameOptions != "" {
+				w.Header().Set("X-Frame-Options", config.FrameOptions)
+			}
 
 			// Referrer policy
 			w.Header().Set("Referrer-Policy", "strict-origin-when-cross-origin")
 
-			// Content Security Policy (API only, strict)
-			w.Header().Set("Content-Security-Policy", "default-src 'none'; frame-ancestors 'none'")
+			if config.ContentSecurityPolicy != "" {
+				w.Header().Set("Content-Security-Policy", config.ContentSecurityPolicy)
+			}
 
-			// Strict Transport Security (only in production with HTTPS)
-			// This header will be set by the reverse proxy/load balancer in production
+			// Browsers ignore HSTS received over plain HTTP, so only send it
+			// where it takes effect
+			if hsts != "" && isHTTPS(r) {
+				w.Header().Set("Strict-Transport-Security", hsts)
+			}
 
 			next.ServeHTTP(w, r)
 		})
 	}
 }
+
+// isHTTPS reports whether the client connected over TLS, either directly or
+// to a proxy that says so in X-Forwarded-Proto. The header needn't come from
+// a trusted proxy: a spoofed value can only add an HSTS header to a plain
+// HTTP response, where browsers disregard it.
+func isHTTPS(r *http.Request) bool {
+	if r.TLS != nil {
+		return true
+	}
+	// With several proxies the first entry is the client-facing protocol
+	proto, _, _ := strings.Cut(r.Header.Get("X-Forwarded-Proto"), ",")
+	return strings.EqualFold(strings.TrimSpace(proto), "https")
+}
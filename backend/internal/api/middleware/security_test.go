@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSecurity(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	serve := func(config SecurityConfig, req *http.Request) http.Header {
+		w := httptest.NewRecorder()
+		Security(config)(ok).ServeHTTP(w, req)
+		return w.Header()
+	}
+	const strictCSP = "default-src 'none'; frame-ancestors 'none'"
+	strict := func() SecurityConfig {
+		return SecurityConfig{FrameOptions: "DENY", ContentSecurityPolicy: strictCSP}
+	}
+
+	t.Run("strict headers without HSTS", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/tags", nil)
+		req.Header.Set("X-Forwarded-Proto", "https")
+		header := serve(strict(), req)
+
+		if got := header.Get("X-Frame-Options"); got != "DENY" {
+			t.Errorf("expected X-Frame-Options DENY, got %q", got)
+		}
+		if got := header.Get("Content-Security-Policy"); got != strictCSP {
+			t.Errorf("expected strict CSP, got %q", got)
+		}
+		if got := header.Get("X-Content-Type-Options"); got != "nosniff" {
+			t.Errorf("expected X-Content-Type-Options nosniff, got %q", got)
+		}
+		if got := header.Get("Strict-Transport-Security"); got != "" {
+			t.Errorf("expected no HSTS without a max-age, got %q", got)
+		}
+	})
+
+	t.Run("custom CSP and frame options", func(t *testing.T) {
+		config := strict()
+		config.FrameOptions = "SAMEORIGIN"
+		config.ContentSecurityPolicy = "default-src 'self'"
+		header := serve(config, httptest.NewRequest(http.MethodGet, "/api/tags", nil))
+
+		if got := header.Get("X-Frame-Options"); got != "SAMEORIGIN" {
+			t.Errorf("expected X-Frame-Options SAMEORIGIN, got %q", got)
+		}
+		if got := header.Get("Content-Security-Policy"); got != "default-src 'self'" {
+			t.Errorf("expected custom CSP, got %q", got)
+		}
+	})
+
+	t.Run("empty values omit the headers", func(t *testing.T) {
+		header := serve(SecurityConfig{}, httptest.NewRequest(http.MethodGet, "/api/tags", nil))
+
+		for _, name := range []string{"X-Frame-Options", "Content-Security-Policy"} {
+			if _, ok := header[name]; ok {
+				t.Errorf("expected %s to be omitted, got %q", name, header.Get(name))
+			}
+		}
+	})
+
+	t.Run("HSTS only over HTTPS", func(t *testing.T) {
+		config := strict()
+		config.HSTSMaxAge = 365 * 24 * time.Hour
+		config.HSTSIncludeSubdomains = true
+		want := "max-age=31536000; includeSubDomains"
+
+		direct := httptest.NewRequest(http.MethodGet, "/api/tags", nil)
+		direct.TLS = &tls.ConnectionState{}
+		if got := serve(config, direct).Get("Strict-Transport-Security"); got != want {
+			t.Errorf("expected HSTS %q over TLS, got %q", want, got)
+		}
+
+		proxied := httptest.NewRequest(http.MethodGet, "/api/tags", nil)
+		proxied.Header.Set("X-Forwarded-Proto", "https")
+		if got := serve(config, proxied).Get("Strict-Transport-Security"); got != want {
+			t.Errorf("expected HSTS %q behind a TLS-terminating proxy, got %q", want, got)
+		}
+
+		plain := httptest.NewRequest(http.MethodGet, "/api/tags", nil)
+		plain.Header.Set("X-Forwarded-Proto", "http")
+		if got := serve(config, plain).Get("Strict-Transport-Security"); got != "" {
+			t.Errorf("expected no HSTS over plain HTTP, got %q", got)
+		}
+	})
+}
@@ -0,0 +1,36 @@
+// Package buildinfo holds the version details stamped into the server binary
+// at build time, e.g.
+//
+//	go build -ldflags "-X github.com/alexlee0213/realworld-conduit/backend/internal/buildinfo.Version=v1.2.0 \
+//		-X github.com/alexlee0213/realworld-conduit/backend/internal/buildinfo.Commit=$(git rev-parse --short HEAD) \
+//		-X github.com/alexlee0213/realworld-conduit/backend/internal/buildinfo.BuildTime=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+//
+// The Makefile and Dockerfile do this; plain go build and go run leave the
+// defaults in place.
+package buildinfo
+
+// Set with -ldflags -X; these must stay plain string variables for that to work
+var (
+	// Version is the release version
+	Version = "dev"
+	// Commit is the git commit the binary was built from
+	Commit = "unknown"
+	// BuildTime is when the binary was built, in RFC 3339
+	BuildTime = "unknown"
+)
+
+// Info describes the running build
+type Info struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildTime string `json:"buildTime"`
+}
+
+// Get returns the build details of the running binary
+func Get() Info {
+	return Info{
+		Version:   Version,
+		Commit:    Commit,
+		BuildTime: BuildTime,
+	}
+}
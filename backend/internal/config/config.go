@@ -4,27 +4,89 @@ import (
 	"errors"
 	"log/slog"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
+	"golang.org/x/crypto/bcrypt"
 )
 
 // Default insecure JWT secret - must be changed in production
 const defaultJWTSecret = "your-secret-key-change-in-production"
 
+// DefaultJWTExpiry is the default access token lifetime. It is short because
+// clients renew access tokens with the refresh token returned at login.
+const DefaultJWTExpiry = 15 * time.Minute
+
+// DefaultMaxHeaderBytes is the default request header size limit (1 MB,
+// matching net/http's own default)
+const DefaultMaxHeaderBytes = 1 << 20
+
+// DefaultMaxBodyBytes is the default request body size limit (1 MB)
+const DefaultMaxBodyBytes = 1 << 20
+
+// DefaultPublicCacheControl is the default Cache-Control policy for anonymous
+// public GETs
+const DefaultPublicCacheControl = "public, max-age=30, stale-while-revalidate=60"
+
+// DefaultContentSecurityPolicy is the default Content-Security-Policy, which
+// suits a JSON API that serves no documents
+const DefaultContentSecurityPolicy = "default-src 'none'; frame-ancestors 'none'"
+
 // ErrInsecureJWTSecret is returned when the default JWT secret is used in production
 var ErrInsecureJWTSecret = errors.New("JWT_SECRET must be set to a secure value in production")
 
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	JWT      JWTConfig
-	CORS     CORSConfig
+	Server     ServerConfig
+	Database   DatabaseConfig
+	JWT        JWTConfig
+	CORS       CORSConfig
+	Security   SecurityConfig
+	Article    ArticleConfig
+	Comment    CommentConfig
+	Validation ValidationConfig
+	Pagination PaginationConfig
+	Account    AccountConfig
+	Admin      AdminConfig
 }
 
 type ServerConfig struct {
 	Port string
 	Env  string
+
+	// DeleteReturnsBody makes DELETE endpoints answer 200 with a small JSON
+	// confirmation instead of an empty 204
+	DeleteReturnsBody bool
+
+	// IPQuotaPerMinute caps requests per client IP across all routes
+	// (0 disables the quota)
+	IPQuotaPerMinute int
+	// RateLimitRPS is the sustained request rate per client IP, enforced
+	// with a token bucket (0 disables the limit)
+	RateLimitRPS float64
+	// RateLimitBurst is how many requests a client may send at once before
+	// RateLimitRPS applies
+	RateLimitBurst int
+	// TrustedProxies lists proxy IPs/CIDRs whose X-Forwarded-For is honored
+	TrustedProxies []string
+
+	// MaxHeaderBytes bounds the size of request headers (default 1 MB)
+	MaxHeaderBytes int
+	// MaxBodyBytes bounds the size of request bodies (default 1 MB)
+	MaxBodyBytes int
+
+	// PublicCacheControl is the Cache-Control policy for anonymous public
+	// GETs (empty disables caching headers)
+	PublicCacheControl string
+
+	// PublicURL is the site's public base URL used for links in feeds
+	// (empty derives it from each request's host)
+	PublicURL string
+
+	// APIVersionPrefix serves every /api route under this prefix as well,
+	// e.g. /api/v1 (empty serves only /api)
+	APIVersionPrefix string
 }
 
 type DatabaseConfig struct {
@@ -35,17 +97,130 @@ type DatabaseConfig struct {
 	Username string
 	Password string
 	SSLMode  string
+
+	// Startup connection retry policy. The first ping (and, for PostgreSQL,
+	// the migration run) is retried with exponential backoff until it
+	// succeeds or ConnectTimeout elapses.
+	ConnectTimeout        time.Duration
+	ConnectInitialBackoff time.Duration
+	ConnectMaxBackoff     time.Duration
+
+	// QueryTimeout bounds each repository call, derived from the request
+	// context (default 5s)
+	QueryTimeout time.Duration
+
+	// SQLite connection pragmas, applied once the database is opened.
+	// An empty journal mode leaves SQLite's default in place.
+	SQLiteJournalMode string
+	SQLiteBusyTimeout time.Duration
+	SQLiteForeignKeys bool
 }
 
 type JWTConfig struct {
 	Secret string
+	// Expiry is the lifetime of access tokens
 	Expiry time.Duration
+	// RefreshExpiry is the lifetime of refresh tokens
+	RefreshExpiry time.Duration
+	// RevocationCleanupInterval is how often expired entries are purged from
+	// the access token blocklist
+	RevocationCleanupInterval time.Duration
+	// ExpiredTokenCleanupInterval is how often expired refresh tokens and
+	// password reset tokens are deleted
+	ExpiredTokenCleanupInterval time.Duration
 }
 
 type CORSConfig struct {
 	AllowedOrigins []string
 }
 
+type SecurityConfig struct {
+	// FrameOptions is the X-Frame-Options value (empty omits the header)
+	FrameOptions string
+	// ContentSecurityPolicy is the Content-Security-Policy value (empty
+	// omits the header)
+	ContentSecurityPolicy string
+	// HSTSMaxAge enables Strict-Transport-Security on HTTPS requests
+	// (0 disables it)
+	HSTSMaxAge time.Duration
+	// HSTSIncludeSubdomains adds includeSubDomains to the HSTS header
+	HSTSIncludeSubdomains bool
+}
+
+type ArticleConfig struct {
+	// StatsAuthorOnly restricts /api/articles/{slug}/stats to the author
+	StatsAuthorOnly bool
+	// UniqueTitlePerAuthor rejects duplicate titles from the same author
+	UniqueTitlePerAuthor bool
+	// CuratedTags rejects tags that aren't already in the tags table
+	CuratedTags bool
+	// MaxTags is the most tags an article may have (0 disables the cap)
+	MaxTags int
+	// LintMarkdown rejects bodies with unterminated code fences
+	LintMarkdown bool
+	// WordsPerMinute is the reading speed behind readingTime estimates
+	WordsPerMinute int
+	// CountViews increments an article's view count when a non-author reads it
+	CountViews bool
+	// TagsCacheTTL is how long GET /api/tags results are cached (0 disables)
+	TagsCacheTTL time.Duration
+}
+
+type AccountConfig struct {
+	// MinAgeToPost is how old an account must be before it can create
+	// articles or comments (0 disables the check)
+	MinAgeToPost time.Duration
+	// PasswordResetTTL is how long a password reset token stays valid
+	PasswordResetTTL time.Duration
+	// MaxLoginFailures is how many failed logins for one email within
+	// LoginFailureWindow lock it out until the window ends (0 disables)
+	MaxLoginFailures   int
+	LoginFailureWindow time.Duration
+	// PasswordMinLength is the shortest password accepted when one is set
+	PasswordMinLength int
+	// PasswordMinClasses is how many of lowercase, uppercase, digits and
+	// symbols a new password must mix (0 disables)
+	PasswordMinClasses int
+	// BcryptCost is the cost new password hashes use; older hashes with a
+	// lower cost are upgraded on login
+	BcryptCost int
+}
+
+type PaginationConfig struct {
+	// MaxOffset is the largest offset list endpoints accept (0 disables the cap)
+	MaxOffset int
+}
+
+type ValidationConfig struct {
+	// RejectHTML rejects HTML tags in titles, descriptions, usernames and bios
+	RejectHTML bool
+}
+
+type CommentConfig struct {
+	// MinInterval is the cooldown between comments by the same user (0 = off)
+	MinInterval time.Duration
+	// DefaultSort is the comment order when ?sort is omitted ("oldest" or "newest")
+	DefaultSort string
+}
+
+type AdminConfig struct {
+	// MaintainerIDs lists the user IDs allowed to use the /api/admin
+	// endpoints, such as reviewing comment reports and recomputing counts
+	MaintainerIDs []string
+}
+
+// MaintainerUserIDs returns MaintainerIDs as integers, skipping entries that
+// don't parse (Validate reports those)
+func (c AdminConfig) MaintainerUserIDs() []int64 {
+	ids := make([]int64, 0, len(c.MaintainerIDs))
+	for _, raw := range c.MaintainerIDs {
+		if id, err := strconv.ParseInt(raw, 10, 64); err == nil && id > 0 {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
 func Load() (*Config, error) {
 	// Load .env file if it exists (ignore error if file doesn't exist)
 	// This allows environment variables to be set via .env file in development
@@ -82,20 +257,78 @@ func Load() (*Config, error) {
 
 	// Build database configuration
 	dbConfig := buildDatabaseConfig()
+	dbConfig.ConnectTimeout = getDuration("DB_CONNECT_TIMEOUT", 30*time.Second)
+	dbConfig.ConnectInitialBackoff = getDuration("DB_CONNECT_INITIAL_BACKOFF", 500*time.Millisecond)
+	dbConfig.ConnectMaxBackoff = getDuration("DB_CONNECT_MAX_BACKOFF", 5*time.Second)
+	dbConfig.QueryTimeout = getDuration("DB_QUERY_TIMEOUT", 5*time.Second)
+	dbConfig.SQLiteJournalMode = strings.ToUpper(getEnv("SQLITE_JOURNAL_MODE", "WAL"))
+	dbConfig.SQLiteBusyTimeout = getDuration("SQLITE_BUSY_TIMEOUT", 5*time.Second)
+	dbConfig.SQLiteForeignKeys = getBool("SQLITE_FOREIGN_KEYS", true)
 
 	cfg := &Config{
 		Server: ServerConfig{
-			Port: getEnv("SERVER_PORT", "8080"),
-			Env:  env,
+			Port:               getEnv("SERVER_PORT", "8080"),
+			Env:                env,
+			DeleteReturnsBody:  getBool("DELETE_RETURNS_BODY", false),
+			IPQuotaPerMinute:   getInt("IP_QUOTA_PER_MINUTE", 0),
+			RateLimitRPS:       getFloat("RATE_LIMIT_RPS", 0),
+			RateLimitBurst:     getInt("RATE_LIMIT_BURST", 20),
+			TrustedProxies:     splitAndTrim(getEnv("TRUSTED_PROXIES", ""), ","),
+			MaxHeaderBytes:     getInt("SERVER_MAX_HEADER_BYTES", DefaultMaxHeaderBytes),
+			MaxBodyBytes:       getInt("SERVER_MAX_BODY_BYTES", DefaultMaxBodyBytes),
+			PublicCacheControl: getEnv("PUBLIC_CACHE_CONTROL", DefaultPublicCacheControl),
+			PublicURL:          strings.TrimRight(getEnv("PUBLIC_URL", ""), "/"),
+			APIVersionPrefix:   strings.TrimRight(getEnv("API_VERSION_PREFIX", "/api/v1"), "/"),
 		},
 		Database: dbConfig,
 		JWT: JWTConfig{
-			Secret: jwtSecret,
-			Expiry: parseDuration(getEnv("JWT_EXPIRY", "72h")),
+			Secret:                      jwtSecret,
+			Expiry:                      getDuration("JWT_EXPIRY", DefaultJWTExpiry),
+			RefreshExpiry:               getDuration("JWT_REFRESH_EXPIRY", 30*24*time.Hour),
+			RevocationCleanupInterval:   getDuration("JWT_REVOCATION_CLEANUP_INTERVAL", time.Hour),
+			ExpiredTokenCleanupInterval: getDuration("EXPIRED_TOKEN_CLEANUP_INTERVAL", time.Hour),
 		},
 		CORS: CORSConfig{
 			AllowedOrigins: allowedOrigins,
 		},
+		Security: SecurityConfig{
+			FrameOptions:          strings.ToUpper(getEnv("SECURITY_FRAME_OPTIONS", "DENY")),
+			ContentSecurityPolicy: getEnv("SECURITY_CONTENT_SECURITY_POLICY", DefaultContentSecurityPolicy),
+			HSTSMaxAge:            getDuration("SECURITY_HSTS_MAX_AGE", 0),
+			HSTSIncludeSubdomains: getBool("SECURITY_HSTS_INCLUDE_SUBDOMAINS", false),
+		},
+		Article: ArticleConfig{
+			StatsAuthorOnly:      getBool("ARTICLE_STATS_AUTHOR_ONLY", false),
+			UniqueTitlePerAuthor: getBool("ARTICLE_UNIQUE_TITLE_PER_AUTHOR", false),
+			CuratedTags:          getBool("ARTICLE_CURATED_TAGS", false),
+			MaxTags:              getInt("ARTICLE_MAX_TAGS", 10),
+			LintMarkdown:         getBool("ARTICLE_LINT_MARKDOWN", false),
+			WordsPerMinute:       getInt("ARTICLE_WORDS_PER_MINUTE", 200),
+			CountViews:           getBool("ARTICLE_COUNT_VIEWS", false),
+			TagsCacheTTL:         getDuration("ARTICLE_TAGS_CACHE_TTL", 60*time.Second),
+		},
+		Comment: CommentConfig{
+			MinInterval: getDuration("COMMENT_MIN_INTERVAL", 0),
+			DefaultSort: getEnv("COMMENT_DEFAULT_SORT", "oldest"),
+		},
+		Validation: ValidationConfig{
+			RejectHTML: getBool("REJECT_HTML_IN_TEXT", false),
+		},
+		Pagination: PaginationConfig{
+			MaxOffset: getInt("PAGINATION_MAX_OFFSET", 10000),
+		},
+		Account: AccountConfig{
+			MinAgeToPost:       getDuration("MIN_ACCOUNT_AGE_TO_POST", 0),
+			PasswordResetTTL:   getDuration("PASSWORD_RESET_TTL", time.Hour),
+			MaxLoginFailures:   getInt("LOGIN_MAX_FAILURES", 5),
+			LoginFailureWindow: getDuration("LOGIN_FAILURE_WINDOW", 15*time.Minute),
+			PasswordMinLength:  getInt("PASSWORD_MIN_LENGTH", 8),
+			PasswordMinClasses: getInt("PASSWORD_MIN_CLASSES", 0),
+			BcryptCost:         getInt("BCRYPT_COST", bcrypt.DefaultCost),
+		},
+		Admin: AdminConfig{
+			MaintainerIDs: splitAndTrim(getEnv("MAINTAINER_USER_IDS", ""), ","),
+		},
 	}
 
 	return cfg, nil
@@ -108,6 +341,66 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+// getDuration reads a duration from the environment, falling back to the
+// default when the variable is unset or cannot be parsed
+func getDuration(key string, defaultValue time.Duration) time.Duration {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return defaultValue
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		slog.Warn("invalid duration in environment, using default", "key", key, "value", value)
+		return defaultValue
+	}
+	return d
+}
+
+// getInt reads an integer from the environment, falling back to the
+// default when the variable is unset or cannot be parsed
+func getInt(key string, defaultValue int) int {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return defaultValue
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		slog.Warn("invalid integer in environment, using default", "key", key, "value", value)
+		return defaultValue
+	}
+	return n
+}
+
+// getFloat reads a decimal number from the environment, falling back to the
+// default when the variable is unset or cannot be parsed
+func getFloat(key string, defaultValue float64) float64 {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return defaultValue
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		slog.Warn("invalid number in environment, using default", "key", key, "value", value)
+		return defaultValue
+	}
+	return f
+}
+
+// getBool reads a boolean from the environment, falling back to the
+// default when the variable is unset or cannot be parsed
+func getBool(key string, defaultValue bool) bool {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return defaultValue
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		slog.Warn("invalid boolean in environment, using default", "key", key, "value", value)
+		return defaultValue
+	}
+	return b
+}
+
 // buildDatabaseConfig creates database configuration from environment variables
 // Priority: DATABASE_URL > individual DB_* variables > default SQLite
 func buildDatabaseConfig() DatabaseConfig {
@@ -162,14 +455,6 @@ func buildPostgresURL(host, port, name, username, password, sslmode string) stri
 	return "postgres://" + host + ":" + port + "/" + name + "?sslmode=" + sslmode
 }
 
-func parseDuration(s string) time.Duration {
-	d, err := time.ParseDuration(s)
-	if err != nil {
-		return 72 * time.Hour
-	}
-	return d
-}
-
 // parseOrigins parses comma-separated CORS origins
 func parseOrigins(s string) []string {
 	if s == "" {
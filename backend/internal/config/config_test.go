@@ -0,0 +1,56 @@
+package config
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+// unsetenv clears key for the duration of the test
+func unsetenv(t *testing.T, key string) {
+	t.Helper()
+	t.Setenv(key, "")
+	os.Unsetenv(key)
+}
+
+func TestLoad_JWTExpiry(t *testing.T) {
+	t.Run("defaults to a short access token lifetime", func(t *testing.T) {
+		unsetenv(t, "JWT_EXPIRY")
+		unsetenv(t, "JWT_REFRESH_EXPIRY")
+
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if cfg.JWT.Expiry != 15*time.Minute {
+			t.Errorf("expected 15m access token expiry, got %s", cfg.JWT.Expiry)
+		}
+		if err := cfg.Validate(); err != nil {
+			t.Errorf("expected default config to validate, got %v", err)
+		}
+	})
+
+	t.Run("reads JWT_EXPIRY", func(t *testing.T) {
+		t.Setenv("JWT_EXPIRY", "72h")
+
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if cfg.JWT.Expiry != 72*time.Hour {
+			t.Errorf("expected 72h access token expiry, got %s", cfg.JWT.Expiry)
+		}
+	})
+
+	t.Run("falls back to the default on an invalid value", func(t *testing.T) {
+		t.Setenv("JWT_EXPIRY", "three days")
+
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if cfg.JWT.Expiry != DefaultJWTExpiry {
+			t.Errorf("expected %s, got %s", DefaultJWTExpiry, cfg.JWT.Expiry)
+		}
+	})
+}
@@ -0,0 +1,223 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// minProductionJWTSecretLength is the shortest JWT secret accepted in production
+const minProductionJWTSecretLength = 32
+
+// sqliteJournalModes lists the values PRAGMA journal_mode accepts
+var sqliteJournalModes = map[string]bool{
+	"DELETE":   true,
+	"TRUNCATE": true,
+	"PERSIST":  true,
+	"MEMORY":   true,
+	"WAL":      true,
+	"OFF":      true,
+}
+
+// Validate checks the loaded configuration for inconsistent or out-of-range
+// values. Every problem found is reported, joined into a single error.
+func (c *Config) Validate() error {
+	var errs []error
+	add := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	// Server
+	if port, err := strconv.Atoi(c.Server.Port); err != nil || port < 1 || port > 65535 {
+		add("SERVER_PORT must be a port number between 1 and 65535, got %q", c.Server.Port)
+	}
+	if c.Server.MaxHeaderBytes <= 0 {
+		add("SERVER_MAX_HEADER_BYTES must be positive, got %d", c.Server.MaxHeaderBytes)
+	}
+	if c.Server.MaxBodyBytes <= 0 {
+		add("SERVER_MAX_BODY_BYTES must be positive, got %d", c.Server.MaxBodyBytes)
+	}
+	if c.Server.IPQuotaPerMinute < 0 {
+		add("IP_QUOTA_PER_MINUTE must not be negative, got %d", c.Server.IPQuotaPerMinute)
+	}
+	if c.Server.RateLimitRPS < 0 {
+		add("RATE_LIMIT_RPS must not be negative, got %g", c.Server.RateLimitRPS)
+	}
+	if c.Server.RateLimitRPS > 0 && c.Server.RateLimitBurst < 1 {
+		add("RATE_LIMIT_BURST must be at least 1, got %d", c.Server.RateLimitBurst)
+	}
+	if c.Server.PublicURL != "" {
+		if u, err := url.Parse(c.Server.PublicURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			add("PUBLIC_URL must be an http(s) URL, got %q", c.Server.PublicURL)
+		}
+	}
+
+	if p := c.Server.APIVersionPrefix; p != "" && (!strings.HasPrefix(p, "/") || p == "/api" || strings.ContainsAny(p, " {}")) {
+		add("API_VERSION_PREFIX must be a path like /api/v1 other than /api, got %q", p)
+	}
+
+	// Database
+	if err := validateDatabaseURL(c.Database.URL); err != nil {
+		errs = append(errs, err)
+	}
+	if c.Database.ConnectTimeout <= 0 {
+		add("DB_CONNECT_TIMEOUT must be positive, got %s", c.Database.ConnectTimeout)
+	}
+	if c.Database.ConnectInitialBackoff <= 0 {
+		add("DB_CONNECT_INITIAL_BACKOFF must be positive, got %s", c.Database.ConnectInitialBackoff)
+	}
+	if c.Database.ConnectMaxBackoff < c.Database.ConnectInitialBackoff {
+		add("DB_CONNECT_MAX_BACKOFF (%s) must not be less than DB_CONNECT_INITIAL_BACKOFF (%s)",
+			c.Database.ConnectMaxBackoff, c.Database.ConnectInitialBackoff)
+	}
+	if c.Database.QueryTimeout <= 0 {
+		add("DB_QUERY_TIMEOUT must be positive, got %s", c.Database.QueryTimeout)
+	}
+	if c.Database.SQLiteJournalMode != "" && !sqliteJournalModes[c.Database.SQLiteJournalMode] {
+		add("SQLITE_JOURNAL_MODE must be one of DELETE, TRUNCATE, PERSIST, MEMORY, WAL or OFF, got %q", c.Database.SQLiteJournalMode)
+	}
+	if c.Database.SQLiteBusyTimeout < 0 {
+		add("SQLITE_BUSY_TIMEOUT must not be negative, got %s", c.Database.SQLiteBusyTimeout)
+	}
+
+	// JWT
+	if c.JWT.Secret == "" {
+		add("JWT_SECRET must not be empty")
+	} else if c.IsProduction() && len(c.JWT.Secret) < minProductionJWTSecretLength {
+		add("JWT_SECRET must be at least %d characters in production", minProductionJWTSecretLength)
+	}
+	if c.JWT.Expiry <= 0 {
+		add("JWT_EXPIRY must be positive, got %s", c.JWT.Expiry)
+	}
+	if c.JWT.RefreshExpiry <= c.JWT.Expiry {
+		add("JWT_REFRESH_EXPIRY must be longer than JWT_EXPIRY, got %s", c.JWT.RefreshExpiry)
+	}
+	if c.JWT.RevocationCleanupInterval <= 0 {
+		add("JWT_REVOCATION_CLEANUP_INTERVAL must be positive, got %s", c.JWT.RevocationCleanupInterval)
+	}
+	if c.JWT.ExpiredTokenCleanupInterval <= 0 {
+		add("EXPIRED_TOKEN_CLEANUP_INTERVAL must be positive, got %s", c.JWT.ExpiredTokenCleanupInterval)
+	}
+
+	// CORS
+	for _, origin := range c.CORS.AllowedOrigins {
+		if err := validateOrigin(origin); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Security headers
+	if f := c.Security.FrameOptions; f != "" && f != "DENY" && f != "SAMEORIGIN" {
+		add("SECURITY_FRAME_OPTIONS must be DENY, SAMEORIGIN or empty, got %q", f)
+	}
+	if c.Security.HSTSMaxAge < 0 {
+		add("SECURITY_HSTS_MAX_AGE must not be negative, got %s", c.Security.HSTSMaxAge)
+	}
+
+	// Feature settings
+	if c.Article.WordsPerMinute <= 0 {
+		add("ARTICLE_WORDS_PER_MINUTE must be positive, got %d", c.Article.WordsPerMinute)
+	}
+	if c.Article.MaxTags < 0 {
+		add("ARTICLE_MAX_TAGS must not be negative, got %d", c.Article.MaxTags)
+	}
+	if c.Article.TagsCacheTTL < 0 {
+		add("ARTICLE_TAGS_CACHE_TTL must not be negative, got %s", c.Article.TagsCacheTTL)
+	}
+	if c.Comment.MinInterval < 0 {
+		add("COMMENT_MIN_INTERVAL must not be negative, got %s", c.Comment.MinInterval)
+	}
+	for _, raw := range c.Admin.MaintainerIDs {
+		if id, err := strconv.ParseInt(raw, 10, 64); err != nil || id <= 0 {
+			add("MAINTAINER_USER_IDS must list positive user IDs, got %q", raw)
+		}
+	}
+	if c.Account.MinAgeToPost < 0 {
+		add("MIN_ACCOUNT_AGE_TO_POST must not be negative, got %s", c.Account.MinAgeToPost)
+	}
+	if c.Account.PasswordResetTTL <= 0 {
+		add("PASSWORD_RESET_TTL must be positive, got %s", c.Account.PasswordResetTTL)
+	}
+	if c.Account.MaxLoginFailures < 0 {
+		add("LOGIN_MAX_FAILURES must not be negative, got %d", c.Account.MaxLoginFailures)
+	}
+	if c.Account.MaxLoginFailures > 0 && c.Account.LoginFailureWindow <= 0 {
+		add("LOGIN_FAILURE_WINDOW must be positive when LOGIN_MAX_FAILURES is set, got %s", c.Account.LoginFailureWindow)
+	}
+	if c.Account.PasswordMinLength < 1 {
+		add("PASSWORD_MIN_LENGTH must be at least 1, got %d", c.Account.PasswordMinLength)
+	}
+	if c.Account.PasswordMinClasses < 0 || c.Account.PasswordMinClasses > 4 {
+		add("PASSWORD_MIN_CLASSES must be between 0 and 4, got %d", c.Account.PasswordMinClasses)
+	}
+	if c.Account.BcryptCost < bcrypt.MinCost || c.Account.BcryptCost > bcrypt.MaxCost {
+		add("BCRYPT_COST must be between %d and %d, got %d", bcrypt.MinCost, bcrypt.MaxCost, c.Account.BcryptCost)
+	}
+	if c.Pagination.MaxOffset < 0 {
+		add("PAGINATION_MAX_OFFSET must not be negative, got %d", c.Pagination.MaxOffset)
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateDatabaseURL accepts postgres://, postgresql:// and mysql:// URLs with
+// a host, sqlite:// and sqlite3:// URLs with a path, and bare SQLite file paths
+func validateDatabaseURL(raw string) error {
+	if raw == "" {
+		return errors.New("database URL must not be empty")
+	}
+
+	scheme, rest, hasScheme := strings.Cut(raw, "://")
+	if !hasScheme {
+		// Bare path, opened directly as a SQLite file
+		return nil
+	}
+
+	switch scheme {
+	case "postgres", "postgresql":
+		u, err := url.Parse(raw)
+		if err != nil {
+			return fmt.Errorf("malformed PostgreSQL database URL: %w", err)
+		}
+		if u.Host == "" {
+			return errors.New("PostgreSQL database URL must include a host")
+		}
+	case "mysql":
+		u, err := url.Parse(raw)
+		if err != nil {
+			return fmt.Errorf("malformed MySQL database URL: %w", err)
+		}
+		if u.Host == "" {
+			return errors.New("MySQL database URL must include a host")
+		}
+	case "sqlite", "sqlite3":
+		if rest == "" {
+			return errors.New("SQLite database URL must include a file path")
+		}
+	default:
+		return fmt.Errorf("unsupported database URL scheme %q (use postgres://, mysql:// or sqlite3://)", scheme)
+	}
+
+	return nil
+}
+
+// validateOrigin accepts "*" or an http(s) origin with a host
+func validateOrigin(origin string) error {
+	if origin == "*" {
+		return nil
+	}
+
+	u, err := url.Parse(origin)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("CORS_ALLOWED_ORIGINS contains an invalid origin %q", origin)
+	}
+	if u.Path != "" && u.Path != "/" {
+		return fmt.Errorf("CORS_ALLOWED_ORIGINS origin %q must not include a path", origin)
+	}
+
+	return nil
+}
@@ -1,25 +1,83 @@
 package domain
 
 import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 )
 
 // Article represents a blog article in the system
 type Article struct {
+	ID              int64      `json:"id"`
+	Slug            string     `json:"slug"`
+	Title           string     `json:"title"`
+	Description     string     `json:"description"`
+	Body            string     `json:"body"`
+	CoverImage      string     `json:"cover_image"`
+	Published       bool       `json:"published"`
+	CommentsEnabled bool       `json:"comments_enabled"`
+	PublishAt       *time.Time `json:"publish_at,omitempty"` // hidden from non-authors until then
+	ViewCount       int        `json:"view_count"`
+	AuthorID        int64      `json:"author_id"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+
+	// Related data (populated by queries)
+	Author          *User    `json:"author,omitempty"`
+	AuthorFollowing bool     `json:"author_following,omitempty"`
+	TagList         []string `json:"tagList"`
+	Favorited       bool     `json:"favorited"`
+	FavoritesCount  int      `json:"favoritesCount"`
+	CommentsCount   int      `json:"commentsCount"`
+}
+
+// IsVisibleTo reports whether viewerID (nil for anonymous) may see the article
+// at now. Authors always see their own articles; everyone else only once it is
+// published and any scheduled publishAt has passed.
+func (a *Article) IsVisibleTo(viewerID *int64, now time.Time) bool {
+	if viewerID != nil && *viewerID == a.AuthorID {
+		return true
+	}
+	return a.Published && (a.PublishAt == nil || !a.PublishAt.After(now))
+}
+
+// ArticleStats aggregates engagement counts for a single article
+type ArticleStats struct {
+	FavoritesCount int `json:"favoritesCount"`
+	CommentsCount  int `json:"commentsCount"`
+}
+
+// CounterFavorites names the denormalized favorites_count column in a
+// CountCorrection
+const CounterFavorites = "favoritesCount"
+
+// CountCorrection records a denormalized counter that had drifted from its
+// source table and was recomputed
+type CountCorrection struct {
+	ArticleID int64  `json:"articleId"`
+	Slug      string `json:"slug"`
+	Counter   string `json:"counter"`
+	Stored    int    `json:"stored"`
+	Actual    int    `json:"actual"`
+}
+
+// CountsRecomputation lists the counters corrected by a pass over every
+// article
+type CountsRecomputation struct {
+	Corrections []CountCorrection `json:"corrections"`
+}
+
+// ArticleRevision is a previous version of an article, captured each time the
+// article is updated
+type ArticleRevision struct {
 	ID          int64     `json:"id"`
-	Slug        string    `json:"slug"`
+	ArticleID   int64     `json:"-"`
 	Title       string    `json:"title"`
 	Description string    `json:"description"`
 	Body        string    `json:"body"`
-	AuthorID    int64     `json:"author_id"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
-
-	// Related data (populated by queries)
-	Author         *User    `json:"author,omitempty"`
-	TagList        []string `json:"tagList"`
-	Favorited      bool     `json:"favorited"`
-	FavoritesCount int      `json:"favoritesCount"`
+	UpdatedAt   time.Time `json:"updatedAt"` // when this version was saved
 }
 
 // ArticleResponse represents the article data returned to clients (RealWorld API format)
@@ -28,6 +86,7 @@ type ArticleResponse struct {
 	Title          string           `json:"title"`
 	Description    string           `json:"description"`
 	Body           string           `json:"body"`
+	CoverImage     string           `json:"coverImage"`
 	TagList        []string         `json:"tagList"`
 	CreatedAt      time.Time        `json:"createdAt"`
 	UpdatedAt      time.Time        `json:"updatedAt"`
@@ -47,6 +106,7 @@ func (a *Article) ToArticleResponse(author *ProfileResponse) *ArticleResponse {
 		Title:          a.Title,
 		Description:    a.Description,
 		Body:           a.Body,
+		CoverImage:     a.CoverImage,
 		TagList:        tagList,
 		CreatedAt:      a.CreatedAt,
 		UpdatedAt:      a.UpdatedAt,
@@ -56,28 +116,125 @@ func (a *Article) ToArticleResponse(author *ProfileResponse) *ArticleResponse {
 	}
 }
 
+// markdownImagePattern matches markdown images: ![alt](url) or ![alt](url "title")
+var markdownImagePattern = regexp.MustCompile(`!\[[^\]]*\]\(\s*<?([^\s)>]+)>?(?:\s+["'(][^)]*)?\)`)
+
+// ExtractCoverImage returns the URL of the first image in a markdown body,
+// or an empty string if the body contains no image
+func ExtractCoverImage(body string) string {
+	match := markdownImagePattern.FindStringSubmatch(body)
+	if match == nil {
+		return ""
+	}
+	return match[1]
+}
+
+// ResolveCoverImage returns the explicitly provided cover image if set,
+// otherwise the first image found in the body
+func ResolveCoverImage(explicit, body string) string {
+	if explicit = strings.TrimSpace(explicit); explicit != "" {
+		return explicit
+	}
+	return ExtractCoverImage(body)
+}
+
 // CreateArticleInput represents the input for creating a new article
 type CreateArticleInput struct {
-	Title       string   `json:"title"`
-	Description string   `json:"description"`
-	Body        string   `json:"body"`
-	TagList     []string `json:"tagList,omitempty"`
+	Title       string     `json:"title"`
+	Slug        string     `json:"slug,omitempty"` // optional; derived from the title when empty
+	Description string     `json:"description"`
+	Body        string     `json:"body"`
+	CoverImage  string     `json:"coverImage,omitempty"`
+	TagList     []string   `json:"tagList,omitempty"`
+	Published   *bool      `json:"published,omitempty"` // nil publishes; false saves a draft
+	PublishAt   *time.Time `json:"publishAt,omitempty"` // schedule visibility for later
 }
 
 // UpdateArticleInput represents the input for updating an article
 type UpdateArticleInput struct {
-	Title       *string `json:"title,omitempty"`
-	Description *string `json:"description,omitempty"`
-	Body        *string `json:"body,omitempty"`
+	Title          *string    `json:"title,omitempty"`
+	RegenerateSlug *bool      `json:"regenerateSlug,omitempty"` // nil or true derives a new slug from a changed title
+	Description    *string    `json:"description,omitempty"`
+	Body           *string    `json:"body,omitempty"`
+	CoverImage     *string    `json:"coverImage,omitempty"`
+	Published      *bool      `json:"published,omitempty"`
+	PublishAt      *time.Time `json:"publishAt,omitempty"`
 }
 
 // ArticleListParams represents parameters for listing articles
 type ArticleListParams struct {
-	Tag       string // Filter by tag
-	Author    string // Filter by author username
-	Favorited string // Filter by username who favorited
-	Limit     int    // Number of articles to return (default 20)
-	Offset    int    // Number of articles to skip (default 0)
+	Tag           string         // Filter by tag
+	Author        string         // Filter by author username
+	Favorited     string         // Filter by username who favorited
+	Query         string         // Case-insensitive text search over title, description and body
+	Sort          ArticleSort    // Result order (default newest first)
+	IncludeDrafts bool           // Also list the requester's own drafts
+	Before        *ArticleCursor // Keyset pagination: list articles after this cursor (takes precedence over Offset)
+	Limit         int            // Number of articles to return (default 20)
+	Offset        int            // Number of articles to skip (default 0)
+}
+
+// ArticleCursor marks a position in the newest-first article list for keyset
+// pagination. Unlike an offset it stays stable while articles are added.
+type ArticleCursor struct {
+	CreatedAt time.Time
+	ID        int64
+}
+
+// ErrInvalidCursor is returned when a pagination cursor can't be parsed
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// CursorFor returns the cursor that continues the list after article
+func CursorFor(article *Article) *ArticleCursor {
+	return &ArticleCursor{CreatedAt: article.CreatedAt, ID: article.ID}
+}
+
+// String encodes the cursor as "<created_at>,<id>" with an RFC 3339 UTC timestamp
+func (c *ArticleCursor) String() string {
+	return c.CreatedAt.UTC().Format(time.RFC3339Nano) + "," + strconv.FormatInt(c.ID, 10)
+}
+
+// ParseArticleCursor parses a cursor produced by ArticleCursor.String
+func ParseArticleCursor(value string) (*ArticleCursor, error) {
+	createdAt, id, ok := strings.Cut(value, ",")
+	if !ok {
+		return nil, ErrInvalidCursor
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	n, err := strconv.ParseInt(id, 10, 64)
+	if err != nil || n <= 0 {
+		return nil, ErrInvalidCursor
+	}
+
+	return &ArticleCursor{CreatedAt: t, ID: n}, nil
+}
+
+// ArticleSort is the order in which the article list is returned
+type ArticleSort string
+
+const (
+	// ArticleSortNewest lists the most recently created articles first
+	ArticleSortNewest ArticleSort = "newest"
+	// ArticleSortOldest lists the oldest articles first
+	ArticleSortOldest ArticleSort = "oldest"
+	// ArticleSortMostFavorited lists the most favorited articles first,
+	// newest first among ties
+	ArticleSortMostFavorited ArticleSort = "mostFavorited"
+)
+
+// ParseArticleSort parses a sort parameter, falling back to newest for
+// empty or unknown values
+func ParseArticleSort(value string) ArticleSort {
+	switch ArticleSort(value) {
+	case ArticleSortOldest, ArticleSortMostFavorited:
+		return ArticleSort(value)
+	default:
+		return ArticleSortNewest
+	}
 }
 
 // DefaultArticleListParams returns default list parameters
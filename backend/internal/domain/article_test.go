@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestExtractCoverImage(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "returns first image URL",
+			body: "Intro\n\n![first](https://example.com/a.png)\n\n![second](https://example.com/b.png)",
+			want: "https://example.com/a.png",
+		},
+		{
+			name: "ignores image title",
+			body: `![cover](https://example.com/cover.jpg "The cover")`,
+			want: "https://example.com/cover.jpg",
+		},
+		{
+			name: "handles angle-bracketed URL",
+			body: "![cover](<https://example.com/cover.jpg>)",
+			want: "https://example.com/cover.jpg",
+		},
+		{
+			name: "ignores plain links",
+			body: "[not an image](https://example.com/page)",
+			want: "",
+		},
+		{
+			name: "returns empty when no image present",
+			body: "Just some text without images",
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExtractCoverImage(tt.body); got != tt.want {
+				t.Errorf("ExtractCoverImage() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveCoverImage(t *testing.T) {
+	body := "![inline](https://example.com/inline.png)"
+
+	t.Run("prefers explicit cover image", func(t *testing.T) {
+		got := ResolveCoverImage("https://example.com/explicit.png", body)
+		if got != "https://example.com/explicit.png" {
+			t.Errorf("expected explicit cover, got %q", got)
+		}
+	})
+
+	t.Run("falls back to first image in body", func(t *testing.T) {
+		got := ResolveCoverImage("  ", body)
+		if got != "https://example.com/inline.png" {
+			t.Errorf("expected extracted cover, got %q", got)
+		}
+	})
+
+	t.Run("returns empty when nothing available", func(t *testing.T) {
+		if got := ResolveCoverImage("", "no images here"); got != "" {
+			t.Errorf("expected empty cover, got %q", got)
+		}
+	})
+}
+
+func TestParseArticleSort(t *testing.T) {
+	tests := map[string]ArticleSort{
+		"newest":        ArticleSortNewest,
+		"oldest":        ArticleSortOldest,
+		"mostFavorited": ArticleSortMostFavorited,
+		"":              ArticleSortNewest,
+		"random":        ArticleSortNewest,
+	}
+
+	for value, want := range tests {
+		if got := ParseArticleSort(value); got != want {
+			t.Errorf("ParseArticleSort(%q) = %q, want %q", value, got, want)
+		}
+	}
+}
+
+func TestArticle_IsVisibleTo(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+	authorID := int64(1)
+	otherID := int64(2)
+
+	tests := []struct {
+		name      string
+		published bool
+		publishAt *time.Time
+		viewerID  *int64
+		want      bool
+	}{
+		{name: "published to anonymous", published: true, want: true},
+		{name: "draft to anonymous", published: false, want: false},
+		{name: "draft to author", published: false, viewerID: &authorID, want: true},
+		{name: "scheduled to other user", published: true, publishAt: &future, viewerID: &otherID, want: false},
+		{name: "scheduled to author", published: true, publishAt: &future, viewerID: &authorID, want: true},
+		{name: "schedule passed", published: true, publishAt: &past, want: true},
+		{name: "schedule passed but unpublished", published: false, publishAt: &past, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			article := &Article{AuthorID: authorID, Published: tt.published, PublishAt: tt.publishAt}
+			if got := article.IsVisibleTo(tt.viewerID, now); got != tt.want {
+				t.Errorf("IsVisibleTo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestArticleCursor(t *testing.T) {
+	t.Run("round-trips through its string form", func(t *testing.T) {
+		createdAt := time.Date(2024, 3, 1, 12, 30, 0, 123456789, time.FixedZone("KST", 9*60*60))
+		cursor := &ArticleCursor{CreatedAt: createdAt, ID: 42}
+
+		parsed, err := ParseArticleCursor(cursor.String())
+		if err != nil {
+			t.Fatalf("ParseArticleCursor(%q) unexpected error: %v", cursor.String(), err)
+		}
+		if !parsed.CreatedAt.Equal(createdAt) || parsed.ID != 42 {
+			t.Errorf("ParseArticleCursor() = %+v, want %+v", parsed, cursor)
+		}
+	})
+
+	t.Run("rejects malformed cursors", func(t *testing.T) {
+		for _, value := range []string{"", "42", "not-a-time,42", "2024-03-01T12:30:00Z", "2024-03-01T12:30:00Z,abc", "2024-03-01T12:30:00Z,0"} {
+			if _, err := ParseArticleCursor(value); err != ErrInvalidCursor {
+				t.Errorf("ParseArticleCursor(%q) error = %v, want ErrInvalidCursor", value, err)
+			}
+		}
+	})
+}
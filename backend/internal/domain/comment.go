@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"strings"
 	"time"
 )
 
@@ -10,11 +11,15 @@ type Comment struct {
 	Body      string    `json:"body"`
 	ArticleID int64     `json:"article_id"`
 	AuthorID  int64     `json:"author_id"`
+	ParentID  *int64    `json:"parent_id,omitempty"` // comment this one replies to
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 
 	// Related data (populated by queries)
-	Author *User `json:"author,omitempty"`
+	Author          *User  `json:"author,omitempty"`
+	AuthorFollowing bool   `json:"author_following,omitempty"`
+	ArticleSlug     string `json:"article_slug,omitempty"`
+	ArticleTitle    string `json:"article_title,omitempty"`
 }
 
 // CommentResponse represents the comment data returned to clients (RealWorld API format)
@@ -37,9 +42,45 @@ func (c *Comment) ToCommentResponse(author *ProfileResponse) *CommentResponse {
 	}
 }
 
+// CommentSort is the order in which an article's comments are listed
+type CommentSort string
+
+const (
+	// CommentSortOldest lists comments oldest first (thread reading order)
+	CommentSortOldest CommentSort = "oldest"
+	// CommentSortNewest lists comments newest first
+	CommentSortNewest CommentSort = "newest"
+)
+
+// ParseCommentSort parses a sort parameter, reporting whether it is valid
+func ParseCommentSort(value string) (CommentSort, bool) {
+	switch CommentSort(value) {
+	case CommentSortOldest, CommentSortNewest:
+		return CommentSort(value), true
+	default:
+		return "", false
+	}
+}
+
+// CommentListParams represents parameters for listing an article's comments
+type CommentListParams struct {
+	Sort   CommentSort // Order (empty uses the configured default)
+	Limit  int         // Number of comments to return (default 20)
+	Offset int         // Number of comments to skip (default 0)
+}
+
+// DefaultCommentListParams returns default comment list parameters
+func DefaultCommentListParams() *CommentListParams {
+	return &CommentListParams{
+		Limit:  20,
+		Offset: 0,
+	}
+}
+
 // CreateCommentInput represents the input for creating a new comment
 type CreateCommentInput struct {
-	Body string `json:"body"`
+	Body     string `json:"body"`
+	ParentID *int64 `json:"parentId,omitempty"` // set to reply to another comment
 }
 
 // Validate validates the comment input
@@ -52,3 +93,52 @@ func (i *CreateCommentInput) Validate() *ValidationErrors {
 
 	return errors
 }
+
+// UpdateCommentInput represents the input for editing a comment
+type UpdateCommentInput struct {
+	Body string `json:"body"`
+}
+
+// Validate validates the comment update input
+func (i *UpdateCommentInput) Validate() *ValidationErrors {
+	errors := NewValidationErrors()
+
+	if strings.TrimSpace(i.Body) == "" {
+		errors.Add("body", "can't be blank")
+	}
+
+	return errors
+}
+
+// MaxReportReasonLength is the longest reason accepted with a comment report
+const MaxReportReasonLength = 500
+
+// CommentReport is a user's flag on a comment they consider abusive
+type CommentReport struct {
+	ID         int64     `json:"id"`
+	CommentID  int64     `json:"comment_id"`
+	ReporterID int64     `json:"reporter_id"`
+	Reason     string    `json:"reason"`
+	CreatedAt  time.Time `json:"created_at"`
+
+	// Related data (populated by queries)
+	CommentBody      string `json:"comment_body,omitempty"`
+	ArticleSlug      string `json:"article_slug,omitempty"`
+	ReporterUsername string `json:"reporter_username,omitempty"`
+}
+
+// ReportCommentInput represents the input for reporting a comment
+type ReportCommentInput struct {
+	Reason string `json:"reason"`
+}
+
+// Validate validates the report input; the reason is optional
+func (i *ReportCommentInput) Validate() *ValidationErrors {
+	errors := NewValidationErrors()
+
+	if len(strings.TrimSpace(i.Reason)) > MaxReportReasonLength {
+		errors.Add("reason", "is too long (maximum is 500 characters)")
+	}
+
+	return errors
+}
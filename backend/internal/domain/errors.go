@@ -3,6 +3,7 @@ package domain
 import (
 	"errors"
 	"fmt"
+	"time"
 )
 
 // Sentinel errors for common domain errors
@@ -13,6 +14,14 @@ var (
 	ErrEmailAlreadyTaken    = errors.New("email is already taken")
 	ErrUsernameAlreadyTaken = errors.New("username is already taken")
 	ErrInvalidCredentials   = errors.New("invalid email or password")
+	ErrAccountTooNew        = errors.New("account is too new to post")
+	ErrTooManyAttempts      = errors.New("too many failed login attempts")
+	ErrBlockedByUser        = errors.New("blocked by this user")
+
+	// Token errors
+	ErrInvalidRefreshToken = errors.New("refresh token is invalid or expired")
+	ErrInvalidResetToken   = errors.New("password reset token is invalid or expired")
+	ErrTokenRevoked        = errors.New("token has been revoked")
 
 	// Article errors
 	ErrArticleNotFound         = errors.New("article not found")
@@ -21,7 +30,10 @@ var (
 	ErrArticleNotFavorited     = errors.New("article not favorited")
 
 	// Comment errors
-	ErrCommentNotFound = errors.New("comment not found")
+	ErrCommentNotFound      = errors.New("comment not found")
+	ErrCommentsDisabled     = errors.New("comments are disabled for this article")
+	ErrCommentTooSoon       = errors.New("please wait before commenting again")
+	ErrInvalidParentComment = errors.New("parent comment must be on the same article")
 
 	// Authorization errors
 	ErrUnauthorized = errors.New("unauthorized")
@@ -34,6 +46,21 @@ var (
 	ErrDatabase = errors.New("database error")
 )
 
+// TooManyAttemptsError is returned while an account is locked out after
+// repeated failed logins. It matches ErrTooManyAttempts with errors.Is.
+type TooManyAttemptsError struct {
+	RetryAfter time.Duration
+}
+
+func (e *TooManyAttemptsError) Error() string {
+	return ErrTooManyAttempts.Error()
+}
+
+// Is reports whether target is ErrTooManyAttempts
+func (e *TooManyAttemptsError) Is(target error) bool {
+	return target == ErrTooManyAttempts
+}
+
 // ValidationError represents a validation error with field-level details
 type ValidationError struct {
 	Field   string `json:"field"`
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultWordsPerMinute is the reading speed used for reading-time estimates
+const DefaultWordsPerMinute = 200
+
+// ReadingTime estimates how many minutes it takes to read a markdown body at
+// wordsPerMinute, rounding up with a minimum of one minute. Code fence
+// delimiter lines aren't counted as words; the code inside them is.
+func ReadingTime(body string, wordsPerMinute int) int {
+	if wordsPerMinute <= 0 {
+		wordsPerMinute = DefaultWordsPerMinute
+	}
+
+	words := 0
+	for _, line := range strings.Split(body, "\n") {
+		if _, _, _, ok := parseFence(line); ok {
+			continue
+		}
+		words += len(strings.Fields(line))
+	}
+
+	minutes := (words + wordsPerMinute - 1) / wordsPerMinute
+	if minutes < 1 {
+		return 1
+	}
+	return minutes
+}
+
+// LintMarkdown runs lightweight structural checks on a markdown body and
+// returns a description of each problem found. It doesn't render the body;
+// it only catches mistakes that would swallow the rest of the article, such
+// as a code fence that is never closed.
+func LintMarkdown(body string) []string {
+	var problems []string
+
+	var fenceChar byte
+	fenceLen, fenceLine := 0, 0
+	for i, line := range strings.Split(body, "\n") {
+		char, length, info, ok := parseFence(line)
+		if !ok {
+			continue
+		}
+
+		if fenceLen == 0 {
+			// Backtick fences can't have backticks in the info string
+			if char == '`' && strings.Contains(info, "`") {
+				continue
+			}
+			fenceChar, fenceLen, fenceLine = char, length, i+1
+			continue
+		}
+
+		// A closing fence uses the same character, is at least as long and
+		// carries no info string
+		if char == fenceChar && length >= fenceLen && info == "" {
+			fenceLen = 0
+		}
+	}
+
+	if fenceLen > 0 {
+		problems = append(problems, fmt.Sprintf("has an unterminated code fence starting on line %d", fenceLine))
+	}
+
+	return problems
+}
+
+// parseFence reports whether a line is a code fence (``` or ~~~, indented by
+// at most three spaces) and returns its character, length and info string
+func parseFence(line string) (byte, int, string, bool) {
+	trimmed := strings.TrimLeft(line, " ")
+	if len(line)-len(trimmed) > 3 || len(trimmed) < 3 {
+		return 0, 0, "", false
+	}
+
+	char := trimmed[0]
+	if char != '`' && char != '~' {
+		return 0, 0, "", false
+	}
+
+	length := 0
+	for length < len(trimmed) && trimmed[length] == char {
+		length++
+	}
+	if length < 3 {
+		return 0, 0, "", false
+	}
+
+	return char, length, strings.TrimSpace(trimmed[length:]), true
+}
@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLintMarkdown(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		problems int
+	}{
+		{
+			name:     "plain text",
+			body:     "Just a paragraph with `inline code`.",
+			problems: 0,
+		},
+		{
+			name:     "closed backtick fence",
+			body:     "Intro\n\n```go\nfmt.Println(\"hi\")\n```\n\nOutro",
+			problems: 0,
+		},
+		{
+			name:     "closed tilde fence containing backticks",
+			body:     "~~~\n```\n~~~",
+			problems: 0,
+		},
+		{
+			name:     "longer closing fence",
+			body:     "```\ncode\n`````",
+			problems: 0,
+		},
+		{
+			name:     "unterminated fence",
+			body:     "Intro\n\n```go\nfmt.Println(\"hi\")\n\nOutro",
+			problems: 1,
+		},
+		{
+			name:     "shorter closing fence does not close",
+			body:     "````\ncode\n```",
+			problems: 1,
+		},
+		{
+			name:     "mismatched fence character does not close",
+			body:     "```\ncode\n~~~",
+			problems: 1,
+		},
+		{
+			name:     "indented code is not a fence",
+			body:     "    ```\n    code",
+			problems: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LintMarkdown(tt.body); len(got) != tt.problems {
+				t.Errorf("LintMarkdown() = %v, want %d problem(s)", got, tt.problems)
+			}
+		})
+	}
+}
+
+func TestReadingTime(t *testing.T) {
+	words := func(n int) string {
+		return strings.TrimSpace(strings.Repeat("word ", n))
+	}
+
+	tests := []struct {
+		name string
+		body string
+		wpm  int
+		want int
+	}{
+		{name: "empty body is one minute", body: "", wpm: 200, want: 1},
+		{name: "whitespace only is one minute", body: "  \n\n\t", wpm: 200, want: 1},
+		{name: "exactly one minute", body: words(200), wpm: 200, want: 1},
+		{name: "rounds up", body: words(201), wpm: 200, want: 2},
+		{
+			name: "counts words across paragraphs",
+			body: words(150) + "\n\n" + words(150) + "\n\n" + words(150),
+			wpm:  200,
+			want: 3,
+		},
+		{
+			name: "skips fence lines but counts code",
+			body: "```go\n" + words(100) + "\n```\n\n" + words(100),
+			wpm:  100,
+			want: 2,
+		},
+		{name: "non-positive speed uses the default", body: words(400), wpm: 0, want: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ReadingTime(tt.body, tt.wpm); got != tt.want {
+				t.Errorf("ReadingTime() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
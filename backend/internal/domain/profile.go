@@ -10,6 +10,10 @@ type Profile struct {
 	Bio       string `json:"bio"`
 	Image     string `json:"image"`
 	Following bool   `json:"following"`
+
+	// Follow counts (populated by the profile endpoints only)
+	FollowersCount int `json:"followersCount"`
+	FollowingCount int `json:"followingCount"`
 }
 
 // Follow represents a follow relationship between two users
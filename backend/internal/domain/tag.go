@@ -6,6 +6,12 @@ type Tag struct {
 	Name string `json:"name"`
 }
 
+// TagCount is a tag with the number of published articles using it
+type TagCount struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
 // TagsResponse represents the tags list returned to clients (RealWorld API format)
 type TagsResponse struct {
 	Tags []string `json:"tags"`
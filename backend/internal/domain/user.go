@@ -1,7 +1,10 @@
 package domain
 
 import (
+	"fmt"
 	"time"
+	"unicode"
+	"unicode/utf8"
 )
 
 // User represents a user in the system
@@ -69,3 +72,56 @@ type UpdateUserInput struct {
 	Bio      *string `json:"bio,omitempty"`
 	Image    *string `json:"image,omitempty"`
 }
+
+// DefaultPasswordMinLength is the default shortest accepted password
+const DefaultPasswordMinLength = 8
+
+// PasswordPolicy describes the rules a new password must satisfy
+type PasswordPolicy struct {
+	// MinLength is the minimum number of characters
+	MinLength int
+	// MinCharClasses is how many of lowercase letters, uppercase letters,
+	// digits and symbols a password must mix (0 or 1 disables the rule)
+	MinCharClasses int
+}
+
+// DefaultPasswordPolicy returns the default policy: a minimum length only
+func DefaultPasswordPolicy() PasswordPolicy {
+	return PasswordPolicy{MinLength: DefaultPasswordMinLength}
+}
+
+// Check adds an error under field to errors for every rule password breaks,
+// so clients see all problems at once
+func (p PasswordPolicy) Check(errors *ValidationErrors, field, password string) {
+	if utf8.RuneCountInString(password) < p.MinLength {
+		errors.Add(field, fmt.Sprintf("is too short (minimum is %d characters)", p.MinLength))
+	}
+	if p.MinCharClasses > 1 && passwordCharClasses(password) < p.MinCharClasses {
+		errors.Add(field, fmt.Sprintf("must mix at least %d of lowercase letters, uppercase letters, digits and symbols", p.MinCharClasses))
+	}
+}
+
+// passwordCharClasses counts the character classes that appear in password
+func passwordCharClasses(password string) int {
+	var lower, upper, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+
+	count := 0
+	for _, present := range []bool{lower, upper, digit, symbol} {
+		if present {
+			count++
+		}
+	}
+	return count
+}
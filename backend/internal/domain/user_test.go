@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestPasswordPolicy_Check(t *testing.T) {
+	mixed := PasswordPolicy{MinLength: 8, MinCharClasses: 2}
+
+	tests := []struct {
+		name     string
+		policy   PasswordPolicy
+		password string
+		want     int
+	}{
+		{name: "too short", policy: DefaultPasswordPolicy(), password: "abc123", want: 1},
+		{name: "all numeric passes the default policy", policy: DefaultPasswordPolicy(), password: "12345678", want: 0},
+		{name: "all numeric fails a mixed-class policy", policy: mixed, password: "12345678", want: 1},
+		{name: "short and single class reports both", policy: mixed, password: "1234", want: 2},
+		{name: "acceptable", policy: mixed, password: "correct horse 42", want: 0},
+		{name: "length counts characters, not bytes", policy: DefaultPasswordPolicy(), password: "密码密码密码密码", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errors := NewValidationErrors()
+			tt.policy.Check(errors, "password", tt.password)
+
+			if len(errors.Errors) != tt.want {
+				t.Fatalf("Check(%q) = %v, want %d errors", tt.password, errors.Errors, tt.want)
+			}
+			for _, err := range errors.Errors {
+				if err.Field != "password" {
+					t.Errorf("error field = %q, want password", err.Field)
+				}
+			}
+		})
+	}
+}
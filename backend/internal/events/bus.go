@@ -0,0 +1,74 @@
+package events
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+)
+
+// Handler reacts to a published event
+type Handler func(ctx context.Context, event Event)
+
+// Bus is a lightweight in-process event bus. Publishing is synchronous, but
+// each subscriber is isolated: a panicking subscriber is logged and the
+// remaining subscribers and the publishing request carry on.
+type Bus struct {
+	mu       sync.RWMutex
+	handlers []Handler
+	logger   *slog.Logger
+}
+
+// NewBus creates a new event bus
+func NewBus(logger *slog.Logger) *Bus {
+	return &Bus{
+		logger: logger,
+	}
+}
+
+// Subscribe registers a handler for every published event. Handlers that
+// only care about some events should type-switch on the event.
+func (b *Bus) Subscribe(handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers = append(b.handlers, handler)
+}
+
+// Publish delivers the event to all subscribers in registration order.
+// Publishing on a nil bus is a no-op so services work without one.
+func (b *Bus) Publish(ctx context.Context, event Event) {
+	if b == nil {
+		return
+	}
+
+	b.mu.RLock()
+	handlers := make([]Handler, len(b.handlers))
+	copy(handlers, b.handlers)
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		b.deliver(ctx, handler, event)
+	}
+}
+
+// deliver runs one handler, recovering from panics
+func (b *Bus) deliver(ctx context.Context, handler Handler, event Event) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			b.logger.Error("event subscriber panicked",
+				"event", event.EventName(),
+				"panic", rec,
+			)
+		}
+	}()
+	handler(ctx, event)
+}
+
+// AuditLogger returns a subscriber that records every event in the log
+func AuditLogger(logger *slog.Logger) Handler {
+	return func(ctx context.Context, event Event) {
+		logger.Info("domain event",
+			"event", event.EventName(),
+			"payload", event,
+		)
+	}
+}
@@ -0,0 +1,75 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+)
+
+func newTestLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+		Level: slog.LevelError + 1,
+	}))
+}
+
+func TestBus_Publish(t *testing.T) {
+	t.Run("delivers events to every subscriber", func(t *testing.T) {
+		bus := NewBus(newTestLogger())
+
+		var first, second []Event
+		bus.Subscribe(func(ctx context.Context, event Event) { first = append(first, event) })
+		bus.Subscribe(func(ctx context.Context, event Event) { second = append(second, event) })
+
+		created := ArticleCreated{ArticleID: 1, Slug: "hello", AuthorID: 2}
+		bus.Publish(context.Background(), created)
+		bus.Publish(context.Background(), Followed{FollowerID: 2, FollowingID: 3, Following: true})
+
+		for name, got := range map[string][]Event{"first": first, "second": second} {
+			if len(got) != 2 {
+				t.Fatalf("%s subscriber: expected 2 events, got %d", name, len(got))
+			}
+			if got[0] != created {
+				t.Errorf("%s subscriber: expected %+v, got %+v", name, created, got[0])
+			}
+			if got[1].EventName() != "user.followed" {
+				t.Errorf("%s subscriber: expected user.followed, got %s", name, got[1].EventName())
+			}
+		}
+	})
+
+	t.Run("a panicking subscriber does not affect others", func(t *testing.T) {
+		bus := NewBus(newTestLogger())
+
+		delivered := 0
+		bus.Subscribe(func(ctx context.Context, event Event) { panic("boom") })
+		bus.Subscribe(func(ctx context.Context, event Event) { delivered++ })
+
+		bus.Publish(context.Background(), ArticleDeleted{ArticleID: 1, Slug: "hello"})
+
+		if delivered != 1 {
+			t.Errorf("expected later subscriber to receive the event, got %d deliveries", delivered)
+		}
+	})
+
+	t.Run("publishing on a nil bus is a no-op", func(t *testing.T) {
+		var bus *Bus
+		bus.Publish(context.Background(), ArticleDeleted{ArticleID: 1})
+	})
+}
+
+func TestAuditLogger_RedactsPasswordResetToken(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+
+	AuditLogger(logger)(context.Background(), PasswordResetRequested{UserID: 7, Email: "a@example.com", Token: "secret-token"})
+
+	if strings.Contains(buf.String(), "secret-token") {
+		t.Errorf("expected token to be redacted, got %q", buf.String())
+	}
+	if !strings.Contains(buf.String(), "a@example.com") {
+		t.Errorf("expected email in log, got %q", buf.String())
+	}
+}
@@ -0,0 +1,79 @@
+package events
+
+import "log/slog"
+
+// Event is a domain change that subscribers can react to
+type Event interface {
+	// EventName identifies the event type, e.g. "article.created"
+	EventName() string
+}
+
+// ArticleCreated is published when an article is created or a draft is saved
+type ArticleCreated struct {
+	ArticleID int64
+	Slug      string
+	AuthorID  int64
+}
+
+// EventName implements Event
+func (ArticleCreated) EventName() string { return "article.created" }
+
+// ArticleUpdated is published when an article's content or slug changes
+type ArticleUpdated struct {
+	ArticleID int64
+	Slug      string
+	OldSlug   string
+	AuthorID  int64
+}
+
+// EventName implements Event
+func (ArticleUpdated) EventName() string { return "article.updated" }
+
+// ArticleDeleted is published after an article is removed
+type ArticleDeleted struct {
+	ArticleID int64
+	Slug      string
+	AuthorID  int64
+}
+
+// EventName implements Event
+func (ArticleDeleted) EventName() string { return "article.deleted" }
+
+// Favorited is published when a user favorites or unfavorites an article
+type Favorited struct {
+	ArticleID int64
+	Slug      string
+	UserID    int64
+	// Favorited is false when the favorite was removed
+	Favorited bool
+}
+
+// EventName implements Event
+func (Favorited) EventName() string { return "article.favorited" }
+
+// Followed is published when a user follows or unfollows another user
+type Followed struct {
+	FollowerID  int64
+	FollowingID int64
+	// Following is false when the follow was removed
+	Following bool
+}
+
+// EventName implements Event
+func (Followed) EventName() string { return "user.followed" }
+
+// PasswordResetRequested is published when a user asks to reset their
+// password. Subscribers deliver Token to Email; nothing else should see it.
+type PasswordResetRequested struct {
+	UserID int64
+	Email  string
+	Token  string
+}
+
+// EventName implements Event
+func (PasswordResetRequested) EventName() string { return "user.password_reset_requested" }
+
+// LogValue implements slog.LogValuer so the token never reaches the logs
+func (e PasswordResetRequested) LogValue() slog.Value {
+	return slog.GroupValue(slog.Int64("UserID", e.UserID), slog.String("Email", e.Email))
+}
@@ -0,0 +1,94 @@
+// Package feed renders article lists as syndication feeds.
+package feed
+
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
+)
+
+// ContentTypeRSS is the media type for RSS documents
+const ContentTypeRSS = "application/rss+xml; charset=utf-8"
+
+// Channel describes the feed itself
+type Channel struct {
+	Title       string
+	Link        string
+	Description string
+}
+
+type rssDocument struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	Description string  `xml:"description"`
+	PubDate     string  `xml:"pubDate"`
+	GUID        rssGUID `xml:"guid"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// RSS marshals articles into an RSS 2.0 document. linkFor returns the public
+// URL of an article. Each item's guid is the article slug and its summary is
+// the article description.
+func RSS(channel Channel, articles []*domain.Article, linkFor func(*domain.Article) string) ([]byte, error) {
+	doc := rssDocument{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:       channel.Title,
+			Link:        channel.Link,
+			Description: channel.Description,
+			Items:       make([]rssItem, 0, len(articles)),
+		},
+	}
+
+	var lastBuild time.Time
+	for _, article := range articles {
+		published := publishedAt(article)
+		if published.After(lastBuild) {
+			lastBuild = published
+		}
+		doc.Channel.Items = append(doc.Channel.Items, rssItem{
+			Title:       article.Title,
+			Link:        linkFor(article),
+			Description: article.Description,
+			PubDate:     published.UTC().Format(time.RFC1123Z),
+			GUID:        rssGUID{IsPermaLink: false, Value: article.Slug},
+		})
+	}
+	if !lastBuild.IsZero() {
+		doc.Channel.LastBuildDate = lastBuild.UTC().Format(time.RFC1123Z)
+	}
+
+	out, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), out...), nil
+}
+
+// publishedAt is when an article went public: its scheduled time if it had
+// one, otherwise when it was created
+func publishedAt(article *domain.Article) time.Time {
+	if article.PublishAt != nil && article.PublishAt.After(article.CreatedAt) {
+		return *article.PublishAt
+	}
+	return article.CreatedAt
+}
@@ -0,0 +1,86 @@
+package feed
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
+)
+
+func TestRSS(t *testing.T) {
+	created := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	scheduled := created.Add(48 * time.Hour)
+	articles := []*domain.Article{
+		{Slug: "scheduled", Title: "Scheduled <post>", Description: "Later & better", CreatedAt: created, PublishAt: &scheduled},
+		{Slug: "first", Title: "First", Description: "The first one", CreatedAt: created},
+	}
+
+	out, err := RSS(Channel{Title: "jake's articles", Link: "https://example.com/profile/jake", Description: "Latest"},
+		articles, func(a *domain.Article) string { return "https://example.com/article/" + a.Slug })
+	if err != nil {
+		t.Fatalf("RSS() unexpected error: %v", err)
+	}
+
+	if !strings.HasPrefix(string(out), xml.Header) {
+		t.Errorf("RSS() output doesn't start with the XML header:\n%s", out)
+	}
+
+	var doc struct {
+		Version string `xml:"version,attr"`
+		Channel struct {
+			Title string `xml:"title"`
+			Items []struct {
+				Title       string `xml:"title"`
+				Link        string `xml:"link"`
+				Description string `xml:"description"`
+				PubDate     string `xml:"pubDate"`
+				GUID        struct {
+					IsPermaLink string `xml:"isPermaLink,attr"`
+					Value       string `xml:",chardata"`
+				} `xml:"guid"`
+			} `xml:"item"`
+		} `xml:"channel"`
+	}
+	if err := xml.Unmarshal(out, &doc); err != nil {
+		t.Fatalf("RSS() output is not valid XML: %v\n%s", err, out)
+	}
+
+	if doc.Version != "2.0" {
+		t.Errorf("version = %q, want 2.0", doc.Version)
+	}
+	if doc.Channel.Title != "jake's articles" {
+		t.Errorf("channel title = %q", doc.Channel.Title)
+	}
+	if len(doc.Channel.Items) != 2 {
+		t.Fatalf("got %d items, want 2", len(doc.Channel.Items))
+	}
+
+	item := doc.Channel.Items[0]
+	if item.Title != "Scheduled <post>" || item.Description != "Later & better" {
+		t.Errorf("item text not round-tripped: %+v", item)
+	}
+	if item.Link != "https://example.com/article/scheduled" {
+		t.Errorf("item link = %q", item.Link)
+	}
+	if item.GUID.Value != "scheduled" || item.GUID.IsPermaLink != "false" {
+		t.Errorf("item guid = %+v, want the slug with isPermaLink=false", item.GUID)
+	}
+	if item.PubDate != "Sun, 03 Mar 2024 12:00:00 +0000" {
+		t.Errorf("scheduled item pubDate = %q, want the publishAt time", item.PubDate)
+	}
+	if doc.Channel.Items[1].PubDate != "Fri, 01 Mar 2024 12:00:00 +0000" {
+		t.Errorf("item pubDate = %q, want the created time", doc.Channel.Items[1].PubDate)
+	}
+}
+
+func TestRSS_Empty(t *testing.T) {
+	out, err := RSS(Channel{Title: "empty"}, nil, func(*domain.Article) string { return "" })
+	if err != nil {
+		t.Fatalf("RSS() unexpected error: %v", err)
+	}
+	if strings.Contains(string(out), "<item>") || strings.Contains(string(out), "lastBuildDate") {
+		t.Errorf("empty feed has items or a build date:\n%s", out)
+	}
+}
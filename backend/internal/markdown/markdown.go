@@ -0,0 +1,46 @@
+// Package markdown renders article and comment markdown to HTML that is safe
+// to embed in a page.
+//
+// Parsing is CommonMark with the GitHub flavoured extensions via goldmark.
+// Raw HTML in the source is not passed through by the renderer, and the
+// result then goes through bluemonday's user generated content policy, so
+// the output can't carry script elements, event handler attributes or
+// javascript: URLs even if the parser lets something unexpected through.
+package markdown
+
+import (
+	"bytes"
+	"log/slog"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+)
+
+var (
+	renderer = goldmark.New(goldmark.WithExtensions(extension.GFM))
+	policy   = newPolicy()
+)
+
+// newPolicy returns the sanitizing policy applied to rendered output
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowURLSchemes("http", "https", "mailto")
+	p.RequireNoFollowOnLinks(true)
+	// Keep the attributes goldmark emits for fenced code languages and for
+	// ordered lists that don't start at 1
+	p.AllowAttrs("class").Matching(bluemonday.SpaceSeparatedTokens).OnElements("code")
+	p.AllowAttrs("start").Matching(bluemonday.Integer).OnElements("ol")
+	return p
+}
+
+// Render converts a markdown document to sanitized HTML
+func Render(src string) string {
+	var buf bytes.Buffer
+	if err := renderer.Convert([]byte(src), &buf); err != nil {
+		// Conversion only fails when writing to buf does, which it doesn't
+		slog.Error("failed to render markdown", "error", err)
+		return ""
+	}
+	return policy.Sanitize(buf.String())
+}
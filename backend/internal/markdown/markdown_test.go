@@ -0,0 +1,128 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "paragraphs",
+			src:  "First paragraph\ncontinues here.\n\nSecond paragraph.",
+			want: "<p>First paragraph\ncontinues here.</p>\n<p>Second paragraph.</p>\n",
+		},
+		{
+			name: "headings",
+			src:  "# Title\n\n### Section ###",
+			want: "<h1>Title</h1>\n<h3>Section</h3>\n",
+		},
+		{
+			name: "emphasis and code",
+			src:  "Some **bold**, *italic*, _also italic_ and `a < b` text with snake_case_name.",
+			want: "<p>Some <strong>bold</strong>, <em>italic</em>, <em>also italic</em> and <code>a &lt; b</code> text with snake_case_name.</p>\n",
+		},
+		{
+			name: "fenced code block",
+			src:  "```go\nfmt.Println(\"<hi>\")\n```",
+			want: "<pre><code class=\"language-go\">fmt.Println(&#34;&lt;hi&gt;&#34;)\n</code></pre>\n",
+		},
+		{
+			name: "lists",
+			src:  "- one\n- two\n\n3. three\n4. four",
+			want: "<ul>\n<li>one</li>\n<li>two</li>\n</ul>\n<ol start=\"3\">\n<li>three</li>\n<li>four</li>\n</ol>\n",
+		},
+		{
+			name: "block quote and rule",
+			src:  "> quoted\n\n---",
+			want: "<blockquote>\n<p>quoted</p>\n</blockquote>\n<hr>\n",
+		},
+		{
+			name: "links and images",
+			src:  "[site](https://example.com \"Example\") ![cover](/img/a.png)",
+			want: "<p><a href=\"https://example.com\" title=\"Example\" rel=\"nofollow\">site</a> <img src=\"/img/a.png\" alt=\"cover\"></p>\n",
+		},
+		{
+			name: "autolink",
+			src:  "See <https://example.com/a?b=1&c=2>",
+			want: "<p>See <a href=\"https://example.com/a?b=1&amp;c=2\" rel=\"nofollow\">https://example.com/a?b=1&amp;c=2</a></p>\n",
+		},
+		{
+			name: "tables and strikethrough",
+			src:  "| a | b |\n|---|---|\n| 1 | ~~2~~ |",
+			want: "<table>\n<thead>\n<tr>\n<th>a</th>\n<th>b</th>\n</tr>\n</thead>\n<tbody>\n<tr>\n<td>1</td>\n<td><del>2</del></td>\n</tr>\n</tbody>\n</table>\n",
+		},
+		{
+			name: "empty body",
+			src:  "",
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Render(tt.src); got != tt.want {
+				t.Errorf("Render() =\n%q\nwant\n%q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRender_Sanitizes(t *testing.T) {
+	tests := []struct {
+		name      string
+		src       string
+		forbidden []string
+	}{
+		{
+			name:      "script tag is removed",
+			src:       "Hello <script>alert(1)</script>",
+			forbidden: []string{"<script"},
+		},
+		{
+			name:      "event handler attributes are removed",
+			src:       `<img src=x onerror="alert(1)"> <a onclick="steal()">click</a>`,
+			forbidden: []string{"<img src=x", "<a onclick"},
+		},
+		{
+			name:      "javascript link is dropped",
+			src:       "[click](javascript:alert(1))",
+			forbidden: []string{"href", "javascript:"},
+		},
+		{
+			name:      "javascript link with mixed case and tab is dropped",
+			src:       "[click](JavaScript:alert(1)) [tab](java\tscript:alert(1))",
+			forbidden: []string{"href"},
+		},
+		{
+			name:      "data image source is dropped",
+			src:       "![x](data:image/svg+xml;base64,PHN2Zz4=)",
+			forbidden: []string{"src=", "data:"},
+		},
+		{
+			name:      "quotes can't break out of attributes",
+			src:       `[x](https://example.com/"onmouseover="alert(1))`,
+			forbidden: []string{`"onmouseover`},
+		},
+		{
+			name:      "unsafe code language is omitted",
+			src:       "```\"><script>\ncode\n```",
+			forbidden: []string{"<script", "class="},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Render(tt.src)
+			for _, bad := range tt.forbidden {
+				if strings.Contains(got, bad) {
+					t.Errorf("Render() output contains %q:\n%s", bad, got)
+				}
+			}
+		})
+	}
+}
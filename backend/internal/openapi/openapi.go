@@ -0,0 +1,26 @@
+// Package openapi serves the OpenAPI 3 description of the Conduit API.
+//
+// The document is maintained by hand in openapi.json and embedded at build
+// time; update it alongside any change to a route or request/response DTO.
+package openapi
+
+import (
+	_ "embed"
+	"net/http"
+)
+
+//go:embed openapi.json
+var document []byte
+
+// Document returns the OpenAPI document as JSON
+func Document() []byte {
+	return document
+}
+
+// Handler serves the OpenAPI document
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(document)
+	})
+}
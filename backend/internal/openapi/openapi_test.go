@@ -0,0 +1,70 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+)
+
+func TestHandler(t *testing.T) {
+	w := httptest.NewRecorder()
+	Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/openapi.json", nil))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if got := w.Header().Get("Content-Type"); got != "application/json" {
+		t.Errorf("expected Content-Type application/json, got %q", got)
+	}
+
+	var doc struct {
+		OpenAPI string                     `json:"openapi"`
+		Paths   map[string]json.RawMessage `json:"paths"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("document is not valid JSON: %v", err)
+	}
+	if doc.OpenAPI != "3.0.3" {
+		t.Errorf("expected openapi 3.0.3, got %q", doc.OpenAPI)
+	}
+	for _, path := range []string{
+		"/api/users",
+		"/api/users/login",
+		"/api/user",
+		"/api/profiles/{username}",
+		"/api/profiles/{username}/follow",
+		"/api/articles",
+		"/api/articles/feed",
+		"/api/articles/{slug}",
+		"/api/articles/{slug}/favorite",
+		"/api/articles/{slug}/comments",
+		"/api/articles/{slug}/comments/{id}",
+		"/api/tags",
+	} {
+		if _, ok := doc.Paths[path]; !ok {
+			t.Errorf("expected path %s to be documented", path)
+		}
+	}
+}
+
+func TestDocumentReferencesResolve(t *testing.T) {
+	var doc struct {
+		Components map[string]map[string]json.RawMessage `json:"components"`
+	}
+	if err := json.Unmarshal(Document(), &doc); err != nil {
+		t.Fatalf("document is not valid JSON: %v", err)
+	}
+
+	refs := regexp.MustCompile(`"\$ref":\s*"#/components/([^/"]+)/([^"]+)"`).FindAllSubmatch(Document(), -1)
+	if len(refs) == 0 {
+		t.Fatal("expected the document to use component references")
+	}
+	for _, ref := range refs {
+		kind, name := string(ref[1]), string(ref[2])
+		if _, ok := doc.Components[kind][name]; !ok {
+			t.Errorf("unresolved reference #/components/%s/%s", kind, name)
+		}
+	}
+}
@@ -16,16 +16,48 @@ type ArticleRepository interface {
 	CreateArticle(ctx context.Context, article *domain.Article, tags []string) error
 	GetArticleByID(ctx context.Context, id int64) (*domain.Article, error)
 	GetArticleBySlug(ctx context.Context, slug string) (*domain.Article, error)
+	GetArticlesByIDs(ctx context.Context, ids []int64, currentUserID *int64) (map[int64]*domain.Article, error)
 	UpdateArticle(ctx context.Context, article *domain.Article) error
+	ListRevisions(ctx context.Context, articleID int64) ([]*domain.ArticleRevision, error)
+	SetCommentsEnabled(ctx context.Context, articleID int64, enabled bool) error
+	IncrementViewCount(ctx context.Context, articleID int64) error
+	GetArticleStats(ctx context.Context, articleID int64) (*domain.ArticleStats, error)
+	// ReconcileFavoritesCounts recomputes the denormalized favorites count of
+	// up to limit articles with IDs above afterID from the favorites table. It
+	// returns the corrections made and the last ID checked, 0 once none remain.
+	ReconcileFavoritesCounts(ctx context.Context, afterID int64, limit int) ([]domain.CountCorrection, int64, error)
 	DeleteArticle(ctx context.Context, id int64) error
 	ListArticles(ctx context.Context, params *domain.ArticleListParams, currentUserID *int64) ([]*domain.Article, int, error)
 	GetFeed(ctx context.Context, userID int64, params *domain.ArticleFeedParams) ([]*domain.Article, int, error)
+	GetFriendsFavorites(ctx context.Context, userID int64, params *domain.ArticleFeedParams) ([]*domain.Article, int, error)
+	GetRelatedArticles(ctx context.Context, articleID int64, limit int, currentUserID *int64) ([]*domain.Article, error)
 	SlugExists(ctx context.Context, slug string) bool
+	ResolveSlugRedirect(ctx context.Context, oldSlug string) (string, error)
+	AuthorHasTitle(ctx context.Context, authorID int64, title string, excludeArticleID int64) (bool, error)
 	GetAllTags(ctx context.Context) ([]string, error)
+	GetTagCounts(ctx context.Context, limit int) ([]domain.TagCount, error)
+	SearchTags(ctx context.Context, prefix string, limit int) ([]string, error)
+	GetExistingTags(ctx context.Context, names []string) (map[string]bool, error)
 	FavoriteArticle(ctx context.Context, articleID, userID int64) error
 	UnfavoriteArticle(ctx context.Context, articleID, userID int64) error
 }
 
+// ArticlesInOrder lists the articles of a GetArticlesByIDs result in the order
+// of ids, skipping IDs that weren't found and repeats
+func ArticlesInOrder(ids []int64, byID map[int64]*domain.Article) []*domain.Article {
+	articles := make([]*domain.Article, 0, len(ids))
+	seen := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		article, ok := byID[id]
+		if !ok || seen[id] {
+			continue
+		}
+		seen[id] = true
+		articles = append(articles, article)
+	}
+	return articles
+}
+
 // SQLiteArticleRepository implements ArticleRepository for SQLite
 type SQLiteArticleRepository struct {
 	db     *sql.DB
@@ -42,6 +74,9 @@ func NewSQLiteArticleRepository(db *sql.DB, logger *slog.Logger) *SQLiteArticleR
 
 // CreateArticle inserts a new article with tags into the database
 func (r *SQLiteArticleRepository) CreateArticle(ctx context.Context, article *domain.Article, tags []string) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		r.logger.Error("failed to begin transaction", "error", err)
@@ -55,10 +90,10 @@ func (r *SQLiteArticleRepository) CreateArticle(ctx context.Context, article *do
 
 	// Insert article
 	result, err := tx.ExecContext(ctx, `
-		INSERT INTO articles (slug, title, description, body, author_id, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?)
-	`, article.Slug, article.Title, article.Description, article.Body,
-		article.AuthorID, article.CreatedAt, article.UpdatedAt)
+		INSERT INTO articles (slug, title, description, body, cover_image, published, publish_at, author_id, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, article.Slug, article.Title, article.Description, article.Body, article.CoverImage,
+		article.Published, article.PublishAt, article.AuthorID, article.CreatedAt, article.UpdatedAt)
 
 	if err != nil {
 		if isUniqueConstraintError(err) {
@@ -77,6 +112,7 @@ func (r *SQLiteArticleRepository) CreateArticle(ctx context.Context, article *do
 		return errors.Join(domain.ErrDatabase, err)
 	}
 	article.ID = id
+	article.CommentsEnabled = true // column default
 
 	// Insert tags if provided
 	if len(tags) > 0 {
@@ -155,9 +191,12 @@ func (r *SQLiteArticleRepository) getOrCreateTag(ctx context.Context, tx *sql.Tx
 
 // GetArticleByID retrieves an article by its ID
 func (r *SQLiteArticleRepository) GetArticleByID(ctx context.Context, id int64) (*domain.Article, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	article := &domain.Article{}
 	err := r.db.QueryRowContext(ctx, `
-		SELECT id, slug, title, description, body, author_id, created_at, updated_at
+		SELECT id, slug, title, description, body, cover_image, published, comments_enabled, publish_at, view_count, author_id, created_at, updated_at, favorites_count
 		FROM articles
 		WHERE id = ?
 	`, id).Scan(
@@ -166,9 +205,15 @@ func (r *SQLiteArticleRepository) GetArticleByID(ctx context.Context, id int64)
 		&article.Title,
 		&article.Description,
 		&article.Body,
+		&article.CoverImage,
+		&article.Published,
+		&article.CommentsEnabled,
+		&article.PublishAt,
+		&article.ViewCount,
 		&article.AuthorID,
 		&article.CreatedAt,
 		&article.UpdatedAt,
+		&article.FavoritesCount,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -185,20 +230,17 @@ func (r *SQLiteArticleRepository) GetArticleByID(ctx context.Context, id int64)
 	}
 	article.TagList = tags
 
-	// Load favorites count
-	article.FavoritesCount, err = r.getFavoritesCount(ctx, article.ID)
-	if err != nil {
-		return nil, err
-	}
-
 	return article, nil
 }
 
 // GetArticleBySlug retrieves an article by its slug
 func (r *SQLiteArticleRepository) GetArticleBySlug(ctx context.Context, slug string) (*domain.Article, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	article := &domain.Article{}
 	err := r.db.QueryRowContext(ctx, `
-		SELECT id, slug, title, description, body, author_id, created_at, updated_at
+		SELECT id, slug, title, description, body, cover_image, published, comments_enabled, publish_at, view_count, author_id, created_at, updated_at, favorites_count
 		FROM articles
 		WHERE slug = ?
 	`, slug).Scan(
@@ -207,9 +249,15 @@ func (r *SQLiteArticleRepository) GetArticleBySlug(ctx context.Context, slug str
 		&article.Title,
 		&article.Description,
 		&article.Body,
+		&article.CoverImage,
+		&article.Published,
+		&article.CommentsEnabled,
+		&article.PublishAt,
+		&article.ViewCount,
 		&article.AuthorID,
 		&article.CreatedAt,
 		&article.UpdatedAt,
+		&article.FavoritesCount,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -226,12 +274,6 @@ func (r *SQLiteArticleRepository) GetArticleBySlug(ctx context.Context, slug str
 	}
 	article.TagList = tags
 
-	// Load favorites count
-	article.FavoritesCount, err = r.getFavoritesCount(ctx, article.ID)
-	if err != nil {
-		return nil, err
-	}
-
 	return article, nil
 }
 
@@ -272,29 +314,120 @@ func (r *SQLiteArticleRepository) getArticleTags(ctx context.Context, articleID
 	return tags, nil
 }
 
-// getFavoritesCount returns the number of favorites for an article
-func (r *SQLiteArticleRepository) getFavoritesCount(ctx context.Context, articleID int64) (int, error) {
-	var count int
+// GetArticleStats returns engagement counts for an article in a single query
+func (r *SQLiteArticleRepository) GetArticleStats(ctx context.Context, articleID int64) (*domain.ArticleStats, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	stats := &domain.ArticleStats{}
 	err := r.db.QueryRowContext(ctx, `
-		SELECT COUNT(*) FROM favorites WHERE article_id = ?
-	`, articleID).Scan(&count)
+		SELECT
+			(SELECT COUNT(*) FROM favorites WHERE article_id = ?),
+			(SELECT COUNT(*) FROM comments WHERE article_id = ?)
+	`, articleID, articleID).Scan(&stats.FavoritesCount, &stats.CommentsCount)
 	if err != nil {
-		r.logger.Error("failed to get favorites count", "error", err, "article_id", articleID)
-		return 0, errors.Join(domain.ErrDatabase, err)
+		r.logger.Error("failed to get article stats", "error", err, "article_id", articleID)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+	return stats, nil
+}
+
+// ReconcileFavoritesCounts checks favorites_count for up to limit articles
+// with IDs above afterID, in ID order, and recomputes any that drifted from
+// the favorites table
+func (r *SQLiteArticleRepository) ReconcileFavoritesCounts(ctx context.Context, afterID int64, limit int) ([]domain.CountCorrection, int64, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT a.id, a.slug, a.favorites_count,
+			(SELECT COUNT(*) FROM favorites f WHERE f.article_id = a.id)
+		FROM articles a
+		WHERE a.id > ?
+		ORDER BY a.id
+		LIMIT ?
+	`, afterID, limit)
+	if err != nil {
+		r.logger.Error("failed to load favorites counts", "error", err, "after_id", afterID)
+		return nil, 0, errors.Join(domain.ErrDatabase, err)
+	}
+
+	var lastID int64
+	var drifted []domain.CountCorrection
+	for rows.Next() {
+		c := domain.CountCorrection{Counter: domain.CounterFavorites}
+		if err := rows.Scan(&c.ArticleID, &c.Slug, &c.Stored, &c.Actual); err != nil {
+			rows.Close()
+			r.logger.Error("failed to scan favorites count", "error", err)
+			return nil, 0, errors.Join(domain.ErrDatabase, err)
+		}
+		lastID = c.ArticleID
+		if c.Stored != c.Actual {
+			drifted = append(drifted, c)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		r.logger.Error("error iterating favorites counts", "error", err)
+		return nil, 0, errors.Join(domain.ErrDatabase, err)
+	}
+	rows.Close()
+
+	// The count is recomputed in the UPDATE itself so a favorite landing
+	// between the read and the write isn't lost
+	corrections := make([]domain.CountCorrection, 0, len(drifted))
+	for _, c := range drifted {
+		result, err := r.db.ExecContext(ctx, `
+			UPDATE articles
+			SET favorites_count = (SELECT COUNT(*) FROM favorites f WHERE f.article_id = articles.id)
+			WHERE id = ? AND favorites_count != (SELECT COUNT(*) FROM favorites f WHERE f.article_id = articles.id)
+		`, c.ArticleID)
+		if err != nil {
+			r.logger.Error("failed to correct favorites count", "error", err, "article_id", c.ArticleID)
+			return nil, 0, errors.Join(domain.ErrDatabase, err)
+		}
+		if n, err := result.RowsAffected(); err == nil && n > 0 {
+			corrections = append(corrections, c)
+		}
 	}
-	return count, nil
+
+	return corrections, lastID, nil
 }
 
 // UpdateArticle updates an existing article in the database
 func (r *SQLiteArticleRepository) UpdateArticle(ctx context.Context, article *domain.Article) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error("failed to begin transaction", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+	defer tx.Rollback()
+
+	// Load the current version, which becomes a revision once replaced
+	var oldSlug string
+	previous := &domain.ArticleRevision{ArticleID: article.ID}
+	err = tx.QueryRowContext(ctx, `
+		SELECT slug, title, description, body, updated_at FROM articles WHERE id = ?
+	`, article.ID).Scan(&oldSlug, &previous.Title, &previous.Description, &previous.Body, &previous.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrArticleNotFound
+		}
+		r.logger.Error("failed to get current article", "error", err, "article_id", article.ID)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
 	article.UpdatedAt = time.Now()
 
-	result, err := r.db.ExecContext(ctx, `
+	_, err = tx.ExecContext(ctx, `
 		UPDATE articles
-		SET slug = ?, title = ?, description = ?, body = ?, updated_at = ?
+		SET slug = ?, title = ?, description = ?, body = ?, cover_image = ?, published = ?, publish_at = ?, updated_at = ?
 		WHERE id = ?
-	`, article.Slug, article.Title, article.Description, article.Body,
-		article.UpdatedAt, article.ID)
+	`, article.Slug, article.Title, article.Description, article.Body, article.CoverImage,
+		article.Published, article.PublishAt, article.UpdatedAt, article.ID)
 
 	if err != nil {
 		if isUniqueConstraintError(err) {
@@ -307,6 +440,123 @@ func (r *SQLiteArticleRepository) UpdateArticle(ctx context.Context, article *do
 		return errors.Join(domain.ErrDatabase, err)
 	}
 
+	// Record the replaced version in the same transaction as the update
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO article_revisions (article_id, title, description, body, updated_at)
+		VALUES (?, ?, ?, ?, ?)
+	`, previous.ArticleID, previous.Title, previous.Description, previous.Body, previous.UpdatedAt); err != nil {
+		r.logger.Error("failed to record article revision",
+			"error", err,
+			"article_id", article.ID,
+		)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	// Keep the old slug resolvable so existing links can be redirected
+	if oldSlug != article.Slug {
+		if _, err := tx.ExecContext(ctx, `
+		INSERT INTO slug_redirects (old_slug, article_id, created_at)
+		VALUES (?, ?, ?)
+		ON CONFLICT (old_slug) DO UPDATE SET article_id = excluded.article_id, created_at = excluded.created_at
+		`, oldSlug, article.ID, article.UpdatedAt); err != nil {
+			r.logger.Error("failed to record slug redirect",
+				"error", err,
+				"article_id", article.ID,
+				"old_slug", oldSlug,
+			)
+			return errors.Join(domain.ErrDatabase, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.Error("failed to commit transaction", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	r.logger.Info("article updated",
+		"article_id", article.ID,
+		"slug", article.Slug,
+	)
+
+	return nil
+}
+
+// ListRevisions returns an article's previous versions, newest first
+func (r *SQLiteArticleRepository) ListRevisions(ctx context.Context, articleID int64) ([]*domain.ArticleRevision, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, article_id, title, description, body, updated_at
+		FROM article_revisions
+		WHERE article_id = ?
+		ORDER BY id DESC
+	`, articleID)
+	if err != nil {
+		r.logger.Error("failed to list article revisions", "error", err, "article_id", articleID)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+	defer rows.Close()
+
+	revisions := []*domain.ArticleRevision{}
+	for rows.Next() {
+		revision := &domain.ArticleRevision{}
+		if err := rows.Scan(&revision.ID, &revision.ArticleID, &revision.Title,
+			&revision.Description, &revision.Body, &revision.UpdatedAt); err != nil {
+			r.logger.Error("failed to scan article revision", "error", err)
+			return nil, errors.Join(domain.ErrDatabase, err)
+		}
+		revisions = append(revisions, revision)
+	}
+	if err := rows.Err(); err != nil {
+		r.logger.Error("failed to iterate article revisions", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return revisions, nil
+}
+
+// IncrementViewCount atomically adds one view to an article
+func (r *SQLiteArticleRepository) IncrementViewCount(ctx context.Context, articleID int64) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE articles SET view_count = view_count + 1 WHERE id = ?
+	`, articleID)
+	if err != nil {
+		r.logger.Error("failed to increment view count", "error", err, "article_id", articleID)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrArticleNotFound
+	}
+
+	return nil
+}
+
+// SetCommentsEnabled toggles whether new comments are accepted on an article
+func (r *SQLiteArticleRepository) SetCommentsEnabled(ctx context.Context, articleID int64, enabled bool) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE articles SET comments_enabled = ? WHERE id = ?
+	`, enabled, articleID)
+	if err != nil {
+		r.logger.Error("failed to update comments setting",
+			"error", err,
+			"article_id", articleID,
+		)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
 		r.logger.Error("failed to get rows affected", "error", err)
@@ -317,17 +567,45 @@ func (r *SQLiteArticleRepository) UpdateArticle(ctx context.Context, article *do
 		return domain.ErrArticleNotFound
 	}
 
-	r.logger.Info("article updated",
-		"article_id", article.ID,
-		"slug", article.Slug,
+	r.logger.Info("article comments setting updated",
+		"article_id", articleID,
+		"comments_enabled", enabled,
 	)
 
 	return nil
 }
 
-// DeleteArticle removes an article from the database
+// DeleteArticle removes an article from the database along with any tags
+// that no other article uses
 func (r *SQLiteArticleRepository) DeleteArticle(ctx context.Context, id int64) error {
-	result, err := r.db.ExecContext(ctx, `DELETE FROM articles WHERE id = ?`, id)
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error("failed to begin transaction", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+	defer tx.Rollback()
+
+	// Drop the article's tags that only it uses while its article_tags rows
+	// still identify them
+	tagsResult, err := tx.ExecContext(ctx, `
+		DELETE FROM tags
+		WHERE id IN (SELECT tag_id FROM article_tags WHERE article_id = ?)
+			AND NOT EXISTS (SELECT 1 FROM article_tags at WHERE at.tag_id = tags.id AND at.article_id != ?)
+	`, id, id)
+	if err != nil {
+		r.logger.Error("failed to delete orphaned tags", "error", err, "article_id", id)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM article_tags WHERE article_id = ?`, id); err != nil {
+		r.logger.Error("failed to unlink article tags", "error", err, "article_id", id)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM articles WHERE id = ?`, id)
 	if err != nil {
 		r.logger.Error("failed to delete article", "error", err, "id", id)
 		return errors.Join(domain.ErrDatabase, err)
@@ -343,16 +621,43 @@ func (r *SQLiteArticleRepository) DeleteArticle(ctx context.Context, id int64) e
 		return domain.ErrArticleNotFound
 	}
 
-	r.logger.Info("article deleted", "article_id", id)
+	if err := tx.Commit(); err != nil {
+		r.logger.Error("failed to commit transaction", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	tagsDeleted, _ := tagsResult.RowsAffected()
+	r.logger.Info("article deleted", "article_id", id, "orphaned_tags_deleted", tagsDeleted)
 
 	return nil
 }
 
+// listArticleColumns is the select list for article listings. It includes the
+// denormalized favorites count, which the mostFavorited sort orders by and so
+// must be selected alongside DISTINCT
+const listArticleColumns = `a.id, a.slug, a.title, a.description, a.body, a.cover_image, a.published, a.comments_enabled, a.publish_at, a.view_count, a.author_id, a.created_at, a.updated_at, a.favorites_count`
+
+// articleListOrderBy returns the ORDER BY expression for a list sort. The id
+// tie-breaker keeps pages stable when timestamps collide.
+func articleListOrderBy(sort domain.ArticleSort) string {
+	switch sort {
+	case domain.ArticleSortOldest:
+		return "a.created_at ASC, a.id ASC"
+	case domain.ArticleSortMostFavorited:
+		return "a.favorites_count DESC, a.created_at DESC, a.id DESC"
+	default:
+		return "a.created_at DESC, a.id DESC"
+	}
+}
+
 // ListArticles retrieves articles with optional filters
 func (r *SQLiteArticleRepository) ListArticles(ctx context.Context, params *domain.ArticleListParams, currentUserID *int64) ([]*domain.Article, int, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	// Build query
 	query := `
-		SELECT DISTINCT a.id, a.slug, a.title, a.description, a.body, a.author_id, a.created_at, a.updated_at
+		SELECT DISTINCT ` + listArticleColumns + `
 		FROM articles a
 		LEFT JOIN users u ON a.author_id = u.id
 	`
@@ -369,7 +674,7 @@ func (r *SQLiteArticleRepository) ListArticles(ctx context.Context, params *doma
 	// Filter by tag
 	if params.Tag != "" {
 		query = `
-			SELECT DISTINCT a.id, a.slug, a.title, a.description, a.body, a.author_id, a.created_at, a.updated_at
+			SELECT DISTINCT ` + listArticleColumns + `
 			FROM articles a
 			LEFT JOIN users u ON a.author_id = u.id
 			INNER JOIN article_tags at ON a.id = at.article_id
@@ -395,7 +700,7 @@ func (r *SQLiteArticleRepository) ListArticles(ctx context.Context, params *doma
 	// Filter by favorited
 	if params.Favorited != "" {
 		query = `
-			SELECT DISTINCT a.id, a.slug, a.title, a.description, a.body, a.author_id, a.created_at, a.updated_at
+			SELECT DISTINCT ` + listArticleColumns + `
 			FROM articles a
 			LEFT JOIN users u ON a.author_id = u.id
 			INNER JOIN favorites f ON a.id = f.article_id
@@ -412,6 +717,28 @@ func (r *SQLiteArticleRepository) ListArticles(ctx context.Context, params *doma
 		args = append(args, params.Favorited)
 	}
 
+	// Drafts are only listed to their author, and only when requested
+	if params.IncludeDrafts && currentUserID != nil {
+		conditions = append(conditions, "((a.published = 1 AND (a.publish_at IS NULL OR a.publish_at <= ?)) OR a.author_id = ?)")
+		args = append(args, time.Now().UTC(), *currentUserID)
+	} else {
+		conditions = append(conditions, "a.published = 1 AND (a.publish_at IS NULL OR a.publish_at <= ?)")
+		args = append(args, time.Now().UTC())
+	}
+
+	// Hide authors the requester has blocked
+	if currentUserID != nil {
+		conditions = append(conditions, "NOT EXISTS (SELECT 1 FROM blocks b WHERE b.blocker_id = ? AND b.blocked_id = a.author_id)")
+		args = append(args, *currentUserID)
+	}
+
+	// Filter by search text (SQLite's LIKE is case-insensitive for ASCII)
+	if params.Query != "" {
+		pattern := likePattern(params.Query)
+		conditions = append(conditions, `(a.title LIKE ? ESCAPE '\' OR a.description LIKE ? ESCAPE '\' OR a.body LIKE ? ESCAPE '\')`)
+		args = append(args, pattern, pattern, pattern)
+	}
+
 	// Add WHERE clause if conditions exist
 	if len(conditions) > 0 {
 		whereClause := " WHERE " + strings.Join(conditions, " AND ")
@@ -427,9 +754,18 @@ func (r *SQLiteArticleRepository) ListArticles(ctx context.Context, params *doma
 		return nil, 0, errors.Join(domain.ErrDatabase, err)
 	}
 
+	// Keyset pagination continues after the cursor; the total above ignores it.
+	// created_at is stored in local time, so compare in the same zone.
+	offset := params.Offset
+	if params.Before != nil {
+		query += " AND (a.created_at, a.id) < (?, ?)"
+		args = append(args, params.Before.CreatedAt.Local(), params.Before.ID)
+		offset = 0
+	}
+
 	// Add ordering and pagination
-	query += " ORDER BY a.created_at DESC LIMIT ? OFFSET ?"
-	args = append(args, params.Limit, params.Offset)
+	query += " ORDER BY " + articleListOrderBy(params.Sort) + " LIMIT ? OFFSET ?"
+	args = append(args, params.Limit, offset)
 
 	// Execute query
 	rows, err := r.db.QueryContext(ctx, query, args...)
@@ -448,35 +784,21 @@ func (r *SQLiteArticleRepository) ListArticles(ctx context.Context, params *doma
 			&article.Title,
 			&article.Description,
 			&article.Body,
+			&article.CoverImage,
+			&article.Published,
+			&article.CommentsEnabled,
+			&article.PublishAt,
+			&article.ViewCount,
 			&article.AuthorID,
 			&article.CreatedAt,
 			&article.UpdatedAt,
+			&article.FavoritesCount,
 		)
 		if err != nil {
 			r.logger.Error("failed to scan article", "error", err)
 			return nil, 0, errors.Join(domain.ErrDatabase, err)
 		}
 
-		// Load tags for each article
-		article.TagList, err = r.getArticleTags(ctx, article.ID)
-		if err != nil {
-			return nil, 0, err
-		}
-
-		// Load favorites count
-		article.FavoritesCount, err = r.getFavoritesCount(ctx, article.ID)
-		if err != nil {
-			return nil, 0, err
-		}
-
-		// Check if current user has favorited this article
-		if currentUserID != nil {
-			article.Favorited, err = r.isArticleFavoritedByUser(ctx, article.ID, *currentUserID)
-			if err != nil {
-				return nil, 0, err
-			}
-		}
-
 		articles = append(articles, article)
 	}
 
@@ -485,6 +807,10 @@ func (r *SQLiteArticleRepository) ListArticles(ctx context.Context, params *doma
 		return nil, 0, errors.Join(domain.ErrDatabase, err)
 	}
 
+	if err := r.loadListDetails(ctx, articles, currentUserID); err != nil {
+		return nil, 0, err
+	}
+
 	if articles == nil {
 		articles = []*domain.Article{}
 	}
@@ -492,33 +818,140 @@ func (r *SQLiteArticleRepository) ListArticles(ctx context.Context, params *doma
 	return articles, total, nil
 }
 
-// isArticleFavoritedByUser checks if a user has favorited an article
-func (r *SQLiteArticleRepository) isArticleFavoritedByUser(ctx context.Context, articleID, userID int64) (bool, error) {
-	var exists int
-	err := r.db.QueryRowContext(ctx, `
-		SELECT 1 FROM favorites WHERE article_id = ? AND user_id = ?
-	`, articleID, userID).Scan(&exists)
+// likePattern builds a "contains" LIKE pattern, escaping the wildcard
+// characters in the search text so they match literally
+func likePattern(query string) string {
+	return "%" + escapeLike(query) + "%"
+}
+
+// escapeLike escapes the LIKE wildcard characters and the escape character
+// itself so text matches literally with ESCAPE '\'
+func escapeLike(text string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(text)
+}
+
+// loadListDetails fills in the tags and the current user's favorited flag for
+// a page of articles, issuing one query per relation rather than one per
+// article
+func (r *SQLiteArticleRepository) loadListDetails(ctx context.Context, articles []*domain.Article, currentUserID *int64) error {
+	if len(articles) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(articles))
+	ids := make([]interface{}, len(articles))
+	for i, article := range articles {
+		placeholders[i] = "?"
+		ids[i] = article.ID
+	}
+	in := strings.Join(placeholders, ", ")
+
+	tags, err := r.getTagsByArticleIDs(ctx, in, ids)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return false, nil
+		return err
+	}
+
+	var favorited map[int64]bool
+	if currentUserID != nil {
+		favorited, err = r.getFavoritedArticleIDs(ctx, in, ids, *currentUserID)
+		if err != nil {
+			return err
 		}
-		r.logger.Error("failed to check favorite", "error", err)
-		return false, errors.Join(domain.ErrDatabase, err)
 	}
-	return true, nil
+
+	for _, article := range articles {
+		article.TagList = tags[article.ID]
+		if article.TagList == nil {
+			article.TagList = []string{}
+		}
+		article.Favorited = favorited[article.ID]
+	}
+
+	return nil
+}
+
+// getTagsByArticleIDs returns the sorted tags of each article in the IN list
+func (r *SQLiteArticleRepository) getTagsByArticleIDs(ctx context.Context, in string, ids []interface{}) (map[int64][]string, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT at.article_id, t.name
+		FROM tags t
+		INNER JOIN article_tags at ON t.id = at.tag_id
+		WHERE at.article_id IN (`+in+`)
+		ORDER BY at.article_id, t.name
+	`, ids...)
+	if err != nil {
+		r.logger.Error("failed to get article tags", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+	defer rows.Close()
+
+	tags := make(map[int64][]string)
+	for rows.Next() {
+		var articleID int64
+		var tag string
+		if err := rows.Scan(&articleID, &tag); err != nil {
+			r.logger.Error("failed to scan tag", "error", err)
+			return nil, errors.Join(domain.ErrDatabase, err)
+		}
+		tags[articleID] = append(tags[articleID], tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error iterating tags", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return tags, nil
+}
+
+// getFavoritedArticleIDs returns which articles in the IN list userID has favorited
+func (r *SQLiteArticleRepository) getFavoritedArticleIDs(ctx context.Context, in string, ids []interface{}, userID int64) (map[int64]bool, error) {
+	args := append(append([]interface{}{}, ids...), userID)
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT article_id
+		FROM favorites
+		WHERE article_id IN (`+in+`) AND user_id = ?
+	`, args...)
+	if err != nil {
+		r.logger.Error("failed to check favorites", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+	defer rows.Close()
+
+	favorited := make(map[int64]bool)
+	for rows.Next() {
+		var articleID int64
+		if err := rows.Scan(&articleID); err != nil {
+			r.logger.Error("failed to scan favorite", "error", err)
+			return nil, errors.Join(domain.ErrDatabase, err)
+		}
+		favorited[articleID] = true
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error iterating favorites", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return favorited, nil
 }
 
 // GetFeed retrieves articles from followed users
 func (r *SQLiteArticleRepository) GetFeed(ctx context.Context, userID int64, params *domain.ArticleFeedParams) ([]*domain.Article, int, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	// Get total count
 	countQuery := `
 		SELECT COUNT(*)
 		FROM articles a
 		INNER JOIN follows f ON a.author_id = f.following_id
-		WHERE f.follower_id = ?
+		WHERE f.follower_id = ? AND a.published = 1 AND (a.publish_at IS NULL OR a.publish_at <= ?)
+			AND NOT EXISTS (SELECT 1 FROM blocks b WHERE b.blocker_id = f.follower_id AND b.blocked_id = a.author_id)
 	`
 	var total int
-	err := r.db.QueryRowContext(ctx, countQuery, userID).Scan(&total)
+	now := time.Now().UTC()
+	err := r.db.QueryRowContext(ctx, countQuery, userID, now).Scan(&total)
 	if err != nil {
 		r.logger.Error("failed to count feed articles", "error", err)
 		return nil, 0, errors.Join(domain.ErrDatabase, err)
@@ -526,15 +959,16 @@ func (r *SQLiteArticleRepository) GetFeed(ctx context.Context, userID int64, par
 
 	// Get articles
 	query := `
-		SELECT a.id, a.slug, a.title, a.description, a.body, a.author_id, a.created_at, a.updated_at
+		SELECT a.id, a.slug, a.title, a.description, a.body, a.cover_image, a.published, a.comments_enabled, a.publish_at, a.view_count, a.author_id, a.created_at, a.updated_at, a.favorites_count
 		FROM articles a
 		INNER JOIN follows f ON a.author_id = f.following_id
-		WHERE f.follower_id = ?
+		WHERE f.follower_id = ? AND a.published = 1 AND (a.publish_at IS NULL OR a.publish_at <= ?)
+			AND NOT EXISTS (SELECT 1 FROM blocks b WHERE b.blocker_id = f.follower_id AND b.blocked_id = a.author_id)
 		ORDER BY a.created_at DESC
 		LIMIT ? OFFSET ?
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, userID, params.Limit, params.Offset)
+	rows, err := r.db.QueryContext(ctx, query, userID, now, params.Limit, params.Offset)
 	if err != nil {
 		r.logger.Error("failed to get feed", "error", err)
 		return nil, 0, errors.Join(domain.ErrDatabase, err)
@@ -550,33 +984,21 @@ func (r *SQLiteArticleRepository) GetFeed(ctx context.Context, userID int64, par
 			&article.Title,
 			&article.Description,
 			&article.Body,
+			&article.CoverImage,
+			&article.Published,
+			&article.CommentsEnabled,
+			&article.PublishAt,
+			&article.ViewCount,
 			&article.AuthorID,
 			&article.CreatedAt,
 			&article.UpdatedAt,
+			&article.FavoritesCount,
 		)
 		if err != nil {
 			r.logger.Error("failed to scan article", "error", err)
 			return nil, 0, errors.Join(domain.ErrDatabase, err)
 		}
 
-		// Load tags
-		article.TagList, err = r.getArticleTags(ctx, article.ID)
-		if err != nil {
-			return nil, 0, err
-		}
-
-		// Load favorites count
-		article.FavoritesCount, err = r.getFavoritesCount(ctx, article.ID)
-		if err != nil {
-			return nil, 0, err
-		}
-
-		// Check if current user has favorited
-		article.Favorited, err = r.isArticleFavoritedByUser(ctx, article.ID, userID)
-		if err != nil {
-			return nil, 0, err
-		}
-
 		articles = append(articles, article)
 	}
 
@@ -585,6 +1007,10 @@ func (r *SQLiteArticleRepository) GetFeed(ctx context.Context, userID int64, par
 		return nil, 0, errors.Join(domain.ErrDatabase, err)
 	}
 
+	if err := r.loadListDetails(ctx, articles, &userID); err != nil {
+		return nil, 0, err
+	}
+
 	if articles == nil {
 		articles = []*domain.Article{}
 	}
@@ -592,10 +1018,245 @@ func (r *SQLiteArticleRepository) GetFeed(ctx context.Context, userID int64, par
 	return articles, total, nil
 }
 
-// SlugExists checks if a slug already exists in the database
-func (r *SQLiteArticleRepository) SlugExists(ctx context.Context, slug string) bool {
-	var exists int
-	err := r.db.QueryRowContext(ctx, `SELECT 1 FROM articles WHERE slug = ?`, slug).Scan(&exists)
+// GetFriendsFavorites retrieves articles favorited by users that userID follows,
+// ranked by how many of those users favorited each article. The caller's own
+// articles are excluded.
+func (r *SQLiteArticleRepository) GetFriendsFavorites(ctx context.Context, userID int64, params *domain.ArticleFeedParams) ([]*domain.Article, int, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	// Get total count
+	countQuery := `
+		SELECT COUNT(DISTINCT a.id)
+		FROM follows f
+		INNER JOIN favorites fav ON fav.user_id = f.following_id
+		INNER JOIN articles a ON a.id = fav.article_id
+		WHERE f.follower_id = ? AND a.author_id != ? AND a.published = 1 AND (a.publish_at IS NULL OR a.publish_at <= ?)
+	`
+	var total int
+	now := time.Now().UTC()
+	err := r.db.QueryRowContext(ctx, countQuery, userID, userID, now).Scan(&total)
+	if err != nil {
+		r.logger.Error("failed to count friends favorites", "error", err)
+		return nil, 0, errors.Join(domain.ErrDatabase, err)
+	}
+
+	// Get articles ranked by number of followed users who favorited them
+	query := `
+		SELECT a.id, a.slug, a.title, a.description, a.body, a.cover_image, a.published, a.comments_enabled, a.publish_at, a.view_count, a.author_id, a.created_at, a.updated_at, a.favorites_count
+		FROM follows f
+		INNER JOIN favorites fav ON fav.user_id = f.following_id
+		INNER JOIN articles a ON a.id = fav.article_id
+		WHERE f.follower_id = ? AND a.author_id != ? AND a.published = 1 AND (a.publish_at IS NULL OR a.publish_at <= ?)
+		GROUP BY a.id
+		ORDER BY COUNT(DISTINCT fav.user_id) DESC, a.created_at DESC
+		LIMIT ? OFFSET ?
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, userID, now, params.Limit, params.Offset)
+	if err != nil {
+		r.logger.Error("failed to get friends favorites", "error", err)
+		return nil, 0, errors.Join(domain.ErrDatabase, err)
+	}
+	defer rows.Close()
+
+	var articles []*domain.Article
+	for rows.Next() {
+		article := &domain.Article{}
+		err := rows.Scan(
+			&article.ID,
+			&article.Slug,
+			&article.Title,
+			&article.Description,
+			&article.Body,
+			&article.CoverImage,
+			&article.Published,
+			&article.CommentsEnabled,
+			&article.PublishAt,
+			&article.ViewCount,
+			&article.AuthorID,
+			&article.CreatedAt,
+			&article.UpdatedAt,
+			&article.FavoritesCount,
+		)
+		if err != nil {
+			r.logger.Error("failed to scan article", "error", err)
+			return nil, 0, errors.Join(domain.ErrDatabase, err)
+		}
+
+		articles = append(articles, article)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error iterating friends favorites", "error", err)
+		return nil, 0, errors.Join(domain.ErrDatabase, err)
+	}
+
+	if err := r.loadListDetails(ctx, articles, &userID); err != nil {
+		return nil, 0, err
+	}
+
+	if articles == nil {
+		articles = []*domain.Article{}
+	}
+
+	return articles, total, nil
+}
+
+// GetRelatedArticles retrieves up to limit other visible articles sharing tags
+// with articleID, ordered by the number of shared tags and then recency.
+// An article without tags has no related articles.
+func (r *SQLiteArticleRepository) GetRelatedArticles(ctx context.Context, articleID int64, limit int, currentUserID *int64) ([]*domain.Article, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT a.id, a.slug, a.title, a.description, a.body, a.cover_image, a.published, a.comments_enabled, a.publish_at, a.view_count, a.author_id, a.created_at, a.updated_at, a.favorites_count
+		FROM article_tags src
+		INNER JOIN article_tags other ON other.tag_id = src.tag_id AND other.article_id != src.article_id
+		INNER JOIN articles a ON a.id = other.article_id
+		WHERE src.article_id = ? AND a.published = 1 AND (a.publish_at IS NULL OR a.publish_at <= ?)
+		GROUP BY a.id
+		ORDER BY COUNT(*) DESC, a.created_at DESC, a.id DESC
+		LIMIT ?
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, articleID, time.Now().UTC(), limit)
+	if err != nil {
+		r.logger.Error("failed to get related articles", "error", err, "article_id", articleID)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+	defer rows.Close()
+
+	var articles []*domain.Article
+	for rows.Next() {
+		article := &domain.Article{}
+		err := rows.Scan(
+			&article.ID,
+			&article.Slug,
+			&article.Title,
+			&article.Description,
+			&article.Body,
+			&article.CoverImage,
+			&article.Published,
+			&article.CommentsEnabled,
+			&article.PublishAt,
+			&article.ViewCount,
+			&article.AuthorID,
+			&article.CreatedAt,
+			&article.UpdatedAt,
+			&article.FavoritesCount,
+		)
+		if err != nil {
+			r.logger.Error("failed to scan article", "error", err)
+			return nil, errors.Join(domain.ErrDatabase, err)
+		}
+
+		articles = append(articles, article)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error iterating related articles", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+
+	if err := r.loadListDetails(ctx, articles, currentUserID); err != nil {
+		return nil, err
+	}
+
+	if articles == nil {
+		articles = []*domain.Article{}
+	}
+
+	return articles, nil
+}
+
+// GetArticlesByIDs loads the articles with the given IDs, keyed by ID, with
+// tags and the current user's favorited flag filled in by batch queries. IDs
+// without an article are absent from the map. Like GetArticleByID it applies
+// no visibility rules; ArticlesInOrder restores the callers' ordering.
+func (r *SQLiteArticleRepository) GetArticlesByIDs(ctx context.Context, ids []int64, currentUserID *int64) (map[int64]*domain.Article, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	byID := make(map[int64]*domain.Article, len(ids))
+	if len(ids) == 0 {
+		return byID, nil
+	}
+
+	placeholders := make([]string, 0, len(ids))
+	args := make([]interface{}, 0, len(ids))
+	seen := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		args = append(args, id)
+		placeholders = append(placeholders, "?")
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+listArticleColumns+`
+		FROM articles a
+		WHERE a.id IN (`+strings.Join(placeholders, ", ")+`)
+	`, args...)
+	if err != nil {
+		r.logger.Error("failed to get articles by ids", "error", err, "count", len(args))
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+	defer rows.Close()
+
+	var articles []*domain.Article
+	for rows.Next() {
+		article := &domain.Article{}
+		err := rows.Scan(
+			&article.ID,
+			&article.Slug,
+			&article.Title,
+			&article.Description,
+			&article.Body,
+			&article.CoverImage,
+			&article.Published,
+			&article.CommentsEnabled,
+			&article.PublishAt,
+			&article.ViewCount,
+			&article.AuthorID,
+			&article.CreatedAt,
+			&article.UpdatedAt,
+			&article.FavoritesCount,
+		)
+		if err != nil {
+			r.logger.Error("failed to scan article", "error", err)
+			return nil, errors.Join(domain.ErrDatabase, err)
+		}
+
+		articles = append(articles, article)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error iterating articles", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+
+	if err := r.loadListDetails(ctx, articles, currentUserID); err != nil {
+		return nil, err
+	}
+
+	for _, article := range articles {
+		byID[article.ID] = article
+	}
+
+	return byID, nil
+}
+
+// SlugExists checks if a slug already exists in the database
+func (r *SQLiteArticleRepository) SlugExists(ctx context.Context, slug string) bool {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var exists int
+	err := r.db.QueryRowContext(ctx, `SELECT 1 FROM articles WHERE slug = ?`, slug).Scan(&exists)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return false
@@ -606,8 +1267,68 @@ func (r *SQLiteArticleRepository) SlugExists(ctx context.Context, slug string) b
 	return true
 }
 
+// ResolveSlugRedirect returns the current slug of the article that used to be
+// published under oldSlug, or ErrArticleNotFound if there is none
+func (r *SQLiteArticleRepository) ResolveSlugRedirect(ctx context.Context, oldSlug string) (string, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var slug string
+	err := r.db.QueryRowContext(ctx, `
+		SELECT a.slug
+		FROM slug_redirects sr
+		INNER JOIN articles a ON a.id = sr.article_id
+		WHERE sr.old_slug = ?
+	`, oldSlug).Scan(&slug)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", domain.ErrArticleNotFound
+		}
+		r.logger.Error("failed to resolve slug redirect", "error", err, "slug", oldSlug)
+		return "", errors.Join(domain.ErrDatabase, err)
+	}
+	return slug, nil
+}
+
+// AuthorHasTitle checks if the author already has an article with the given
+// title, ignoring the article with ID excludeArticleID (0 excludes nothing).
+// Titles are compared case-insensitively after trimming surrounding whitespace.
+// The comparison runs in Go because SQL LOWER only folds ASCII on SQLite.
+func (r *SQLiteArticleRepository) AuthorHasTitle(ctx context.Context, authorID int64, title string, excludeArticleID int64) (bool, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx, `SELECT title FROM articles WHERE author_id = ? AND id != ?`, authorID, excludeArticleID)
+	if err != nil {
+		r.logger.Error("failed to check author title", "error", err, "author_id", authorID)
+		return false, errors.Join(domain.ErrDatabase, err)
+	}
+	defer rows.Close()
+
+	title = strings.TrimSpace(title)
+	for rows.Next() {
+		var existing string
+		if err := rows.Scan(&existing); err != nil {
+			r.logger.Error("failed to scan author title", "error", err, "author_id", authorID)
+			return false, errors.Join(domain.ErrDatabase, err)
+		}
+		if strings.EqualFold(strings.TrimSpace(existing), title) {
+			return true, nil
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error iterating author titles", "error", err, "author_id", authorID)
+		return false, errors.Join(domain.ErrDatabase, err)
+	}
+	return false, nil
+}
+
 // GetAllTags retrieves all unique tags from the database
 func (r *SQLiteArticleRepository) GetAllTags(ctx context.Context) ([]string, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	rows, err := r.db.QueryContext(ctx, `SELECT name FROM tags ORDER BY name`)
 	if err != nil {
 		r.logger.Error("failed to get all tags", "error", err)
@@ -637,30 +1358,151 @@ func (r *SQLiteArticleRepository) GetAllTags(ctx context.Context) ([]string, err
 	return tags, nil
 }
 
+// GetTagCounts returns up to limit tags with the number of distinct published
+// articles using each, most used first. Tags only attached to drafts or
+// scheduled articles are left out.
+func (r *SQLiteArticleRepository) GetTagCounts(ctx context.Context, limit int) ([]domain.TagCount, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT t.name, COUNT(DISTINCT a.id) AS article_count
+		FROM tags t
+		INNER JOIN article_tags at ON at.tag_id = t.id
+		INNER JOIN articles a ON a.id = at.article_id
+		WHERE a.published = 1 AND (a.publish_at IS NULL OR a.publish_at <= ?)
+		GROUP BY t.id, t.name
+		ORDER BY article_count DESC, t.name
+		LIMIT ?
+	`, time.Now().UTC(), limit)
+	if err != nil {
+		r.logger.Error("failed to get tag counts", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+	defer rows.Close()
+
+	counts := []domain.TagCount{}
+	for rows.Next() {
+		var tc domain.TagCount
+		if err := rows.Scan(&tc.Name, &tc.Count); err != nil {
+			r.logger.Error("failed to scan tag count", "error", err)
+			return nil, errors.Join(domain.ErrDatabase, err)
+		}
+		counts = append(counts, tc)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error iterating tag counts", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return counts, nil
+}
+
+// SearchTags returns up to limit tag names starting with prefix, compared
+// case-insensitively, in alphabetical order
+func (r *SQLiteArticleRepository) SearchTags(ctx context.Context, prefix string, limit int) ([]string, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	// SQLite's LIKE is case-insensitive for ASCII
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT name FROM tags
+		WHERE name LIKE ? || '%' ESCAPE '\'
+		ORDER BY name
+		LIMIT ?
+	`, escapeLike(prefix), limit)
+	if err != nil {
+		r.logger.Error("failed to search tags", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+	defer rows.Close()
+
+	tags := []string{}
+	for rows.Next() {
+		var tag string
+		if err := rows.Scan(&tag); err != nil {
+			r.logger.Error("failed to scan tag", "error", err)
+			return nil, errors.Join(domain.ErrDatabase, err)
+		}
+		tags = append(tags, tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error iterating tags", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return tags, nil
+}
+
+// GetExistingTags reports which of the given tag names already exist
+// Names that don't exist are omitted from the result
+func (r *SQLiteArticleRepository) GetExistingTags(ctx context.Context, names []string) (map[string]bool, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	existing := make(map[string]bool, len(names))
+	if len(names) == 0 {
+		return existing, nil
+	}
+
+	placeholders := make([]string, len(names))
+	args := make([]interface{}, len(names))
+	for i, name := range names {
+		placeholders[i] = "?"
+		args[i] = name
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT name FROM tags WHERE name IN (`+strings.Join(placeholders, ", ")+`)
+	`, args...)
+	if err != nil {
+		r.logger.Error("failed to get existing tags", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			r.logger.Error("failed to scan tag", "error", err)
+			return nil, errors.Join(domain.ErrDatabase, err)
+		}
+		existing[name] = true
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error iterating tags", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return existing, nil
+}
+
 // FavoriteArticle adds a favorite relationship between a user and an article
+// and increments the article's favorites count in the same transaction.
+// Favoriting an article twice returns ErrArticleAlreadyFavorited and leaves
+// the count unchanged.
 func (r *SQLiteArticleRepository) FavoriteArticle(ctx context.Context, articleID, userID int64) error {
-	// Check if already favorited
-	var exists int
-	err := r.db.QueryRowContext(ctx, `
-		SELECT 1 FROM favorites WHERE article_id = ? AND user_id = ?
-	`, articleID, userID).Scan(&exists)
-	if err == nil {
-		return domain.ErrArticleAlreadyFavorited
-	}
-	if !errors.Is(err, sql.ErrNoRows) {
-		r.logger.Error("failed to check favorite exists", "error", err)
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error("failed to begin transaction", "error", err)
 		return errors.Join(domain.ErrDatabase, err)
 	}
+	defer tx.Rollback()
 
-	// Insert favorite
-	_, err = r.db.ExecContext(ctx, `
+	// Insert favorite; the primary key turns a concurrent or repeated favorite
+	// into a no-op rather than a second count increment
+	result, err := tx.ExecContext(ctx, `
 		INSERT INTO favorites (article_id, user_id, created_at)
 		VALUES (?, ?, ?)
+		ON CONFLICT (user_id, article_id) DO NOTHING
 	`, articleID, userID, time.Now())
 	if err != nil {
-		if isUniqueConstraintError(err) {
-			return domain.ErrArticleAlreadyFavorited
-		}
 		r.logger.Error("failed to favorite article",
 			"error", err,
 			"article_id", articleID,
@@ -669,6 +1511,29 @@ func (r *SQLiteArticleRepository) FavoriteArticle(ctx context.Context, articleID
 		return errors.Join(domain.ErrDatabase, err)
 	}
 
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	if inserted == 0 {
+		return domain.ErrArticleAlreadyFavorited
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE articles SET favorites_count = favorites_count + 1 WHERE id = ?
+	`, articleID)
+	if err != nil {
+		r.logger.Error("failed to increment favorites count", "error", err, "article_id", articleID)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.Error("failed to commit transaction", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
 	r.logger.Info("article favorited",
 		"article_id", articleID,
 		"user_id", userID,
@@ -677,9 +1542,20 @@ func (r *SQLiteArticleRepository) FavoriteArticle(ctx context.Context, articleID
 	return nil
 }
 
-// UnfavoriteArticle removes a favorite relationship between a user and an article
+// UnfavoriteArticle removes a favorite relationship between a user and an
+// article and decrements the article's favorites count in the same transaction
 func (r *SQLiteArticleRepository) UnfavoriteArticle(ctx context.Context, articleID, userID int64) error {
-	result, err := r.db.ExecContext(ctx, `
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error("failed to begin transaction", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
 		DELETE FROM favorites WHERE article_id = ? AND user_id = ?
 	`, articleID, userID)
 	if err != nil {
@@ -701,6 +1577,19 @@ func (r *SQLiteArticleRepository) UnfavoriteArticle(ctx context.Context, article
 		return domain.ErrArticleNotFavorited
 	}
 
+	_, err = tx.ExecContext(ctx, `
+		UPDATE articles SET favorites_count = MAX(favorites_count - 1, 0) WHERE id = ?
+	`, articleID)
+	if err != nil {
+		r.logger.Error("failed to decrement favorites count", "error", err, "article_id", articleID)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.Error("failed to commit transaction", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
 	r.logger.Info("article unfavorited",
 		"article_id", articleID,
 		"user_id", userID,
@@ -3,9 +3,14 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"log/slog"
 	"os"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
 	_ "github.com/mattn/go-sqlite3"
@@ -27,6 +32,7 @@ func setupTestArticleDB(t *testing.T) (*sql.DB, func()) {
 	// Drop existing tables (for shared cache cleanup between tests)
 	db.Exec("DROP TABLE IF EXISTS article_tags")
 	db.Exec("DROP TABLE IF EXISTS favorites")
+	db.Exec("DROP TABLE IF EXISTS blocks")
 	db.Exec("DROP TABLE IF EXISTS follows")
 	db.Exec("DROP TABLE IF EXISTS tags")
 	db.Exec("DROP TABLE IF EXISTS articles")
@@ -57,6 +63,12 @@ func setupTestArticleDB(t *testing.T) (*sql.DB, func()) {
 			title TEXT NOT NULL,
 			description TEXT NOT NULL DEFAULT '',
 			body TEXT NOT NULL DEFAULT '',
+			cover_image TEXT NOT NULL DEFAULT '',
+			published BOOLEAN NOT NULL DEFAULT 1,
+			comments_enabled BOOLEAN NOT NULL DEFAULT 1,
+			publish_at TIMESTAMP,
+			view_count INTEGER NOT NULL DEFAULT 0,
+			favorites_count INTEGER NOT NULL DEFAULT 0,
 			author_id INTEGER NOT NULL,
 			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
 			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
@@ -108,6 +120,35 @@ func setupTestArticleDB(t *testing.T) (*sql.DB, func()) {
 		t.Fatalf("failed to create favorites table: %v", err)
 	}
 
+	// Create slug redirects table
+	_, err = db.Exec(`
+		CREATE TABLE slug_redirects (
+			old_slug TEXT PRIMARY KEY,
+			article_id INTEGER NOT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+		)
+	`)
+	if err != nil {
+		t.Fatalf("failed to create slug_redirects table: %v", err)
+	}
+
+	// Create article revisions table
+	_, err = db.Exec(`
+		CREATE TABLE article_revisions (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			article_id INTEGER NOT NULL,
+			title TEXT NOT NULL,
+			description TEXT NOT NULL,
+			body TEXT NOT NULL,
+			updated_at TIMESTAMP NOT NULL,
+			FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+		)
+	`)
+	if err != nil {
+		t.Fatalf("failed to create article_revisions table: %v", err)
+	}
+
 	// Create follows table
 	_, err = db.Exec(`
 		CREATE TABLE follows (
@@ -123,6 +164,21 @@ func setupTestArticleDB(t *testing.T) (*sql.DB, func()) {
 		t.Fatalf("failed to create follows table: %v", err)
 	}
 
+	// Create blocks table
+	_, err = db.Exec(`
+		CREATE TABLE blocks (
+			blocker_id INTEGER NOT NULL,
+			blocked_id INTEGER NOT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (blocker_id, blocked_id),
+			FOREIGN KEY (blocker_id) REFERENCES users(id) ON DELETE CASCADE,
+			FOREIGN KEY (blocked_id) REFERENCES users(id) ON DELETE CASCADE
+		)
+	`)
+	if err != nil {
+		t.Fatalf("failed to create blocks table: %v", err)
+	}
+
 	return db, func() {
 		db.Close()
 	}
@@ -323,261 +379,1266 @@ func TestArticleRepository_UpdateArticle(t *testing.T) {
 	if updated.Description != "Updated description" {
 		t.Errorf("UpdateArticle() description = %v, want 'Updated description'", updated.Description)
 	}
-}
-
-func TestArticleRepository_DeleteArticle(t *testing.T) {
-	db, cleanup := setupTestArticleDB(t)
-	defer cleanup()
-
-	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
-	repo := NewSQLiteArticleRepository(db, logger)
-
-	authorID := createTestUser(t, db, "testuser", "test@example.com")
 
-	// Create a test article
-	article := &domain.Article{
-		Slug:        "to-delete",
-		Title:       "To Delete",
-		Description: "This will be deleted",
-		Body:        "Delete me",
-		AuthorID:    authorID,
-	}
-	err := repo.CreateArticle(context.Background(), article, []string{"deletable"})
-	if err != nil {
-		t.Fatalf("failed to create test article: %v", err)
+	// The old slug is freed but still redirects to the new one
+	if repo.SlugExists(context.Background(), "original-slug") {
+		t.Error("SlugExists(original-slug) = true after rename, want false")
 	}
-
-	// Delete the article
-	err = repo.DeleteArticle(context.Background(), article.ID)
+	newSlug, err := repo.ResolveSlugRedirect(context.Background(), "original-slug")
 	if err != nil {
-		t.Errorf("DeleteArticle() unexpected error: %v", err)
-		return
+		t.Fatalf("ResolveSlugRedirect() unexpected error: %v", err)
 	}
-
-	// Verify deletion
-	_, err = repo.GetArticleBySlug(context.Background(), "to-delete")
-	if err != domain.ErrArticleNotFound {
-		t.Errorf("GetArticleBySlug() after delete error = %v, want ErrArticleNotFound", err)
+	if newSlug != "updated-slug" {
+		t.Errorf("ResolveSlugRedirect() = %v, want 'updated-slug'", newSlug)
 	}
 }
 
-func TestArticleRepository_ListArticles(t *testing.T) {
+func TestArticleRepository_CoverImage(t *testing.T) {
 	db, cleanup := setupTestArticleDB(t)
 	defer cleanup()
 
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
 	repo := NewSQLiteArticleRepository(db, logger)
+	ctx := context.Background()
 
-	// Create test users
-	author1ID := createTestUser(t, db, "author1", "author1@example.com")
-	author2ID := createTestUser(t, db, "author2", "author2@example.com")
+	authorID := createTestUser(t, db, "testuser", "test@example.com")
 
-	// Create test articles
-	articles := []struct {
-		article *domain.Article
-		tags    []string
-	}{
-		{
-			article: &domain.Article{
-				Slug:        "go-basics",
-				Title:       "Go Basics",
-				Description: "Learn Go",
-				Body:        "Go is great",
-				AuthorID:    author1ID,
-			},
-			tags: []string{"go", "tutorial"},
-		},
-		{
-			article: &domain.Article{
-				Slug:        "python-basics",
-				Title:       "Python Basics",
-				Description: "Learn Python",
-				Body:        "Python is cool",
-				AuthorID:    author1ID,
-			},
-			tags: []string{"python", "tutorial"},
-		},
-		{
-			article: &domain.Article{
-				Slug:        "rust-basics",
-				Title:       "Rust Basics",
-				Description: "Learn Rust",
-				Body:        "Rust is fast",
-				AuthorID:    author2ID,
-			},
-			tags: []string{"rust", "systems"},
-		},
-	}
+	t.Run("round-trips the cover image", func(t *testing.T) {
+		article := &domain.Article{
+			Slug:        "with-cover",
+			Title:       "With Cover",
+			Description: "Test description",
+			Body:        "Test body",
+			CoverImage:  "https://example.com/cover.png",
+			Published:   true,
+			AuthorID:    authorID,
+		}
+		if err := repo.CreateArticle(ctx, article, nil); err != nil {
+			t.Fatalf("failed to create article: %v", err)
+		}
 
-	for _, a := range articles {
-		err := repo.CreateArticle(context.Background(), a.article, a.tags)
+		stored, err := repo.GetArticleBySlug(ctx, "with-cover")
 		if err != nil {
-			t.Fatalf("failed to create test article: %v", err)
+			t.Fatalf("GetArticleBySlug() unexpected error: %v", err)
+		}
+		if stored.CoverImage != "https://example.com/cover.png" {
+			t.Errorf("CoverImage = %q, want %q", stored.CoverImage, "https://example.com/cover.png")
 		}
-	}
-
-	tests := []struct {
-		name       string
-		params     *domain.ArticleListParams
-		wantCount  int
-		wantTitles []string
-	}{
-		{
-			name: "list all articles",
-			params: &domain.ArticleListParams{
-				Limit:  20,
-				Offset: 0,
-			},
-			wantCount: 3,
-		},
-		{
-			name: "filter by tag",
-			params: &domain.ArticleListParams{
-				Tag:    "go",
-				Limit:  20,
-				Offset: 0,
-			},
-			wantCount:  1,
-			wantTitles: []string{"Go Basics"},
-		},
-		{
-			name: "filter by tutorial tag",
-			params: &domain.ArticleListParams{
-				Tag:    "tutorial",
-				Limit:  20,
-				Offset: 0,
-			},
-			wantCount: 2,
-		},
-		{
-			name: "filter by author",
-			params: &domain.ArticleListParams{
-				Author: "author1",
-				Limit:  20,
-				Offset: 0,
-			},
-			wantCount: 2,
-		},
-		{
-			name: "pagination - limit",
-			params: &domain.ArticleListParams{
-				Limit:  2,
-				Offset: 0,
-			},
-			wantCount: 2,
-		},
-		{
-			name: "pagination - offset",
-			params: &domain.ArticleListParams{
-				Limit:  20,
-				Offset: 2,
-			},
-			wantCount: 1,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result, total, err := repo.ListArticles(context.Background(), tt.params, nil)
-			if err != nil {
-				t.Errorf("ListArticles() unexpected error: %v", err)
-				return
-			}
 
-			if len(result) != tt.wantCount {
-				t.Errorf("ListArticles() count = %v, want %v", len(result), tt.wantCount)
-			}
+		stored.CoverImage = "https://example.com/new.png"
+		if err := repo.UpdateArticle(ctx, stored); err != nil {
+			t.Fatalf("UpdateArticle() unexpected error: %v", err)
+		}
 
-			// For non-paginated queries, total should be >= count
-			if tt.params.Offset == 0 && tt.params.Limit >= 20 && total < tt.wantCount {
-				t.Errorf("ListArticles() total = %v, want >= %v", total, tt.wantCount)
-			}
+		articles, _, err := repo.ListArticles(ctx, &domain.ArticleListParams{Limit: 10}, nil)
+		if err != nil {
+			t.Fatalf("ListArticles() unexpected error: %v", err)
+		}
+		if len(articles) != 1 || articles[0].CoverImage != "https://example.com/new.png" {
+			t.Errorf("ListArticles() cover image not updated: %+v", articles)
+		}
+	})
+
+	t.Run("defaults to an empty string", func(t *testing.T) {
+		article := &domain.Article{
+			Slug:        "without-cover",
+			Title:       "Without Cover",
+			Description: "Test description",
+			Body:        "Test body",
+			AuthorID:    authorID,
+		}
+		if err := repo.CreateArticle(ctx, article, nil); err != nil {
+			t.Fatalf("failed to create article: %v", err)
+		}
 
-			if len(tt.wantTitles) > 0 {
-				for i, title := range tt.wantTitles {
-					if i < len(result) && result[i].Title != title {
-						t.Errorf("ListArticles() result[%d].Title = %v, want %v", i, result[i].Title, title)
-					}
-				}
-			}
-		})
-	}
+		stored, err := repo.GetArticleBySlug(ctx, "without-cover")
+		if err != nil {
+			t.Fatalf("GetArticleBySlug() unexpected error: %v", err)
+		}
+		if stored.CoverImage != "" {
+			t.Errorf("CoverImage = %q, want empty", stored.CoverImage)
+		}
+	})
 }
 
-func TestArticleRepository_SlugExists(t *testing.T) {
+func TestArticleRepository_ListRevisions(t *testing.T) {
 	db, cleanup := setupTestArticleDB(t)
 	defer cleanup()
 
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
 	repo := NewSQLiteArticleRepository(db, logger)
+	ctx := context.Background()
 
 	authorID := createTestUser(t, db, "testuser", "test@example.com")
 
-	// Create a test article
 	article := &domain.Article{
-		Slug:        "existing-slug",
-		Title:       "Existing Title",
-		Description: "Existing description",
-		Body:        "Existing body",
+		Slug:        "revised",
+		Title:       "Title 1",
+		Description: "Description 1",
+		Body:        "Body 1",
 		AuthorID:    authorID,
 	}
-	err := repo.CreateArticle(context.Background(), article, nil)
-	if err != nil {
+	if err := repo.CreateArticle(ctx, article, nil); err != nil {
 		t.Fatalf("failed to create test article: %v", err)
 	}
 
-	tests := []struct {
-		name   string
-		slug   string
-		exists bool
-	}{
-		{
-			name:   "existing slug",
-			slug:   "existing-slug",
-			exists: true,
-		},
-		{
-			name:   "non-existing slug",
-			slug:   "non-existing-slug",
-			exists: false,
-		},
+	revisions, err := repo.ListRevisions(ctx, article.ID)
+	if err != nil {
+		t.Fatalf("ListRevisions() unexpected error: %v", err)
+	}
+	if len(revisions) != 0 {
+		t.Errorf("ListRevisions() before any update returned %d revisions, want 0", len(revisions))
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := repo.SlugExists(context.Background(), tt.slug)
-			if result != tt.exists {
-				t.Errorf("SlugExists(%q) = %v, want %v", tt.slug, result, tt.exists)
-			}
-		})
+	for _, n := range []string{"2", "3"} {
+		article.Title = "Title " + n
+		article.Body = "Body " + n
+		if err := repo.UpdateArticle(ctx, article); err != nil {
+			t.Fatalf("UpdateArticle() unexpected error: %v", err)
+		}
+	}
+
+	revisions, err = repo.ListRevisions(ctx, article.ID)
+	if err != nil {
+		t.Fatalf("ListRevisions() unexpected error: %v", err)
+	}
+	if len(revisions) != 2 {
+		t.Fatalf("ListRevisions() returned %d revisions, want 2", len(revisions))
+	}
+	if revisions[0].Title != "Title 2" || revisions[1].Title != "Title 1" {
+		t.Errorf("ListRevisions() titles = %q, %q, want newest first", revisions[0].Title, revisions[1].Title)
+	}
+	if revisions[1].Description != "Description 1" || revisions[1].Body != "Body 1" {
+		t.Errorf("ListRevisions() oldest revision = %+v, want the original content", revisions[1])
+	}
+	if revisions[1].UpdatedAt.IsZero() {
+		t.Error("ListRevisions() revision has zero UpdatedAt")
 	}
 }
 
-func TestArticleRepository_GetArticleTags(t *testing.T) {
+func TestArticleRepository_GetRelatedArticles(t *testing.T) {
 	db, cleanup := setupTestArticleDB(t)
 	defer cleanup()
 
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
 	repo := NewSQLiteArticleRepository(db, logger)
+	ctx := context.Background()
 
 	authorID := createTestUser(t, db, "testuser", "test@example.com")
 
-	// Create articles with tags
-	article1 := &domain.Article{
-		Slug:        "article-1",
-		Title:       "Article 1",
-		Description: "Desc 1",
-		Body:        "Body 1",
-		AuthorID:    authorID,
-	}
-	err := repo.CreateArticle(context.Background(), article1, []string{"go", "tutorial"})
-	if err != nil {
-		t.Fatalf("failed to create test article 1: %v", err)
+	create := func(slug string, published bool, tags ...string) *domain.Article {
+		t.Helper()
+		article := &domain.Article{
+			Slug:        slug,
+			Title:       slug,
+			Description: "Test description",
+			Body:        "Test body",
+			Published:   published,
+			AuthorID:    authorID,
+		}
+		if err := repo.CreateArticle(ctx, article, tags); err != nil {
+			t.Fatalf("failed to create article %s: %v", slug, err)
+		}
+		return article
 	}
 
-	article2 := &domain.Article{
-		Slug:        "article-2",
-		Title:       "Article 2",
+	source := create("source", true, "go", "sql", "testing")
+	create("one-shared", true, "go")
+	create("two-shared", true, "go", "sql")
+	create("newer-one-shared", true, "testing")
+	create("unrelated", true, "cooking")
+	create("draft-three-shared", false, "go", "sql", "testing")
+	untagged := create("untagged", true)
+
+	t.Run("orders by shared tags then recency", func(t *testing.T) {
+		articles, err := repo.GetRelatedArticles(ctx, source.ID, 5, nil)
+		if err != nil {
+			t.Fatalf("GetRelatedArticles() unexpected error: %v", err)
+		}
+
+		want := []string{"two-shared", "newer-one-shared", "one-shared"}
+		if len(articles) != len(want) {
+			t.Fatalf("GetRelatedArticles() returned %d articles, want %d", len(articles), len(want))
+		}
+		for i, slug := range want {
+			if articles[i].Slug != slug {
+				t.Errorf("GetRelatedArticles()[%d] = %s, want %s", i, articles[i].Slug, slug)
+			}
+		}
+		if len(articles[0].TagList) != 2 {
+			t.Errorf("GetRelatedArticles() did not load tags: %v", articles[0].TagList)
+		}
+	})
+
+	t.Run("respects the limit", func(t *testing.T) {
+		articles, err := repo.GetRelatedArticles(ctx, source.ID, 1, nil)
+		if err != nil {
+			t.Fatalf("GetRelatedArticles() unexpected error: %v", err)
+		}
+		if len(articles) != 1 || articles[0].Slug != "two-shared" {
+			t.Errorf("GetRelatedArticles() with limit 1 = %v, want [two-shared]", articles)
+		}
+	})
+
+	t.Run("returns an empty list for an untagged article", func(t *testing.T) {
+		articles, err := repo.GetRelatedArticles(ctx, untagged.ID, 5, nil)
+		if err != nil {
+			t.Fatalf("GetRelatedArticles() unexpected error: %v", err)
+		}
+		if articles == nil || len(articles) != 0 {
+			t.Errorf("GetRelatedArticles() = %v, want empty non-nil slice", articles)
+		}
+	})
+}
+
+func TestArticleRepository_GetArticlesByIDs(t *testing.T) {
+	db, cleanup := setupTestArticleDB(t)
+	defer cleanup()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	repo := NewSQLiteArticleRepository(db, logger)
+	ctx := context.Background()
+
+	authorID := createTestUser(t, db, "testuser", "test@example.com")
+	readerID := createTestUser(t, db, "reader", "reader@example.com")
+
+	var ids []int64
+	for _, slug := range []string{"first", "second", "third"} {
+		article := &domain.Article{Slug: slug, Title: slug, Description: "d", Body: "b", Published: true, AuthorID: authorID}
+		if err := repo.CreateArticle(ctx, article, []string{slug, "shared"}); err != nil {
+			t.Fatalf("failed to create article %s: %v", slug, err)
+		}
+		ids = append(ids, article.ID)
+	}
+	if err := repo.FavoriteArticle(ctx, ids[1], readerID); err != nil {
+		t.Fatalf("failed to favorite article: %v", err)
+	}
+
+	const missingID = 9999
+	requested := []int64{ids[2], missingID, ids[0], ids[1], ids[2]}
+
+	t.Run("returns existing articles with details", func(t *testing.T) {
+		byID, err := repo.GetArticlesByIDs(ctx, requested, &readerID)
+		if err != nil {
+			t.Fatalf("GetArticlesByIDs() unexpected error: %v", err)
+		}
+		if len(byID) != 3 {
+			t.Fatalf("expected 3 articles, got %d", len(byID))
+		}
+		if _, ok := byID[missingID]; ok {
+			t.Error("expected missing ID to be absent")
+		}
+
+		second := byID[ids[1]]
+		if second.Slug != "second" {
+			t.Errorf("expected slug second, got %s", second.Slug)
+		}
+		if got := strings.Join(second.TagList, ","); got != "second,shared" {
+			t.Errorf("expected tags [second shared], got %v", second.TagList)
+		}
+		if !second.Favorited || second.FavoritesCount != 1 {
+			t.Errorf("expected favorited with count 1, got favorited=%v count=%d", second.Favorited, second.FavoritesCount)
+		}
+		if byID[ids[0]].Favorited {
+			t.Error("expected first article not to be favorited")
+		}
+	})
+
+	t.Run("favorited is false without a current user", func(t *testing.T) {
+		byID, err := repo.GetArticlesByIDs(ctx, ids, nil)
+		if err != nil {
+			t.Fatalf("GetArticlesByIDs() unexpected error: %v", err)
+		}
+		if byID[ids[1]].Favorited {
+			t.Error("expected favorited false for anonymous reader")
+		}
+	})
+
+	t.Run("empty input returns an empty map", func(t *testing.T) {
+		byID, err := repo.GetArticlesByIDs(ctx, nil, nil)
+		if err != nil {
+			t.Fatalf("GetArticlesByIDs() unexpected error: %v", err)
+		}
+		if byID == nil || len(byID) != 0 {
+			t.Errorf("expected empty map, got %v", byID)
+		}
+	})
+
+	t.Run("ArticlesInOrder follows the requested order", func(t *testing.T) {
+		byID, err := repo.GetArticlesByIDs(ctx, requested, nil)
+		if err != nil {
+			t.Fatalf("GetArticlesByIDs() unexpected error: %v", err)
+		}
+
+		var slugs []string
+		for _, article := range ArticlesInOrder(requested, byID) {
+			slugs = append(slugs, article.Slug)
+		}
+		if got := strings.Join(slugs, ","); got != "third,first,second" {
+			t.Errorf("expected order third,first,second, got %s", got)
+		}
+	})
+}
+
+func TestArticleRepository_IncrementViewCount(t *testing.T) {
+	db, cleanup := setupTestArticleDB(t)
+	defer cleanup()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	repo := NewSQLiteArticleRepository(db, logger)
+	ctx := context.Background()
+
+	authorID := createTestUser(t, db, "testuser", "test@example.com")
+
+	article := &domain.Article{
+		Slug:        "viewed",
+		Title:       "Viewed",
+		Description: "Test description",
+		Body:        "Test body",
+		AuthorID:    authorID,
+	}
+	if err := repo.CreateArticle(ctx, article, nil); err != nil {
+		t.Fatalf("failed to create test article: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if err := repo.IncrementViewCount(ctx, article.ID); err != nil {
+			t.Fatalf("IncrementViewCount() unexpected error: %v", err)
+		}
+	}
+
+	stored, err := repo.GetArticleBySlug(ctx, "viewed")
+	if err != nil {
+		t.Fatalf("GetArticleBySlug() unexpected error: %v", err)
+	}
+	if stored.ViewCount != 3 {
+		t.Errorf("ViewCount = %d, want 3", stored.ViewCount)
+	}
+
+	if err := repo.IncrementViewCount(ctx, 99999); err != domain.ErrArticleNotFound {
+		t.Errorf("IncrementViewCount() for missing article error = %v, want ErrArticleNotFound", err)
+	}
+}
+
+func TestArticleRepository_FavoritesCount(t *testing.T) {
+	db, cleanup := setupTestArticleDB(t)
+	defer cleanup()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	repo := NewSQLiteArticleRepository(db, logger)
+	ctx := context.Background()
+
+	authorID := createTestUser(t, db, "testuser", "test@example.com")
+	fanA := createTestUser(t, db, "fana", "fana@example.com")
+	fanB := createTestUser(t, db, "fanb", "fanb@example.com")
+
+	article := &domain.Article{Slug: "counted", Title: "Counted", Description: "d", Body: "b", Published: true, AuthorID: authorID}
+	if err := repo.CreateArticle(ctx, article, nil); err != nil {
+		t.Fatalf("failed to create test article: %v", err)
+	}
+
+	storedCount := func(t *testing.T) int {
+		t.Helper()
+		var count int
+		if err := db.QueryRow(`SELECT favorites_count FROM articles WHERE id = ?`, article.ID).Scan(&count); err != nil {
+			t.Fatalf("failed to read favorites_count: %v", err)
+		}
+		return count
+	}
+
+	t.Run("column tracks favorite and unfavorite", func(t *testing.T) {
+		for _, userID := range []int64{fanA, fanB} {
+			if err := repo.FavoriteArticle(ctx, article.ID, userID); err != nil {
+				t.Fatalf("FavoriteArticle() unexpected error: %v", err)
+			}
+		}
+		if got := storedCount(t); got != 2 {
+			t.Errorf("favorites_count after two favorites = %d, want 2", got)
+		}
+
+		if err := repo.FavoriteArticle(ctx, article.ID, fanA); !errors.Is(err, domain.ErrArticleAlreadyFavorited) {
+			t.Errorf("FavoriteArticle() duplicate error = %v, want %v", err, domain.ErrArticleAlreadyFavorited)
+		}
+		if got := storedCount(t); got != 2 {
+			t.Errorf("favorites_count after duplicate favorite = %d, want 2", got)
+		}
+
+		if err := repo.UnfavoriteArticle(ctx, article.ID, fanA); err != nil {
+			t.Fatalf("UnfavoriteArticle() unexpected error: %v", err)
+		}
+		if err := repo.UnfavoriteArticle(ctx, article.ID, fanA); !errors.Is(err, domain.ErrArticleNotFavorited) {
+			t.Errorf("UnfavoriteArticle() repeat error = %v, want %v", err, domain.ErrArticleNotFavorited)
+		}
+		if got := storedCount(t); got != 1 {
+			t.Errorf("favorites_count after unfavorite = %d, want 1", got)
+		}
+	})
+
+	t.Run("reads use the stored column", func(t *testing.T) {
+		// Skew the column so a read that counted favorites would disagree
+		if _, err := db.Exec(`UPDATE articles SET favorites_count = 42 WHERE id = ?`, article.ID); err != nil {
+			t.Fatalf("failed to skew favorites_count: %v", err)
+		}
+
+		got, err := repo.GetArticleBySlug(ctx, article.Slug)
+		if err != nil {
+			t.Fatalf("GetArticleBySlug() unexpected error: %v", err)
+		}
+		if got.FavoritesCount != 42 {
+			t.Errorf("GetArticleBySlug() FavoritesCount = %d, want 42", got.FavoritesCount)
+		}
+
+		articles, _, err := repo.ListArticles(ctx, &domain.ArticleListParams{Limit: 10}, nil)
+		if err != nil {
+			t.Fatalf("ListArticles() unexpected error: %v", err)
+		}
+		if len(articles) != 1 || articles[0].FavoritesCount != 42 {
+			t.Errorf("ListArticles() FavoritesCount = %+v, want 42", articles)
+		}
+	})
+
+	t.Run("reconcile restores the true count", func(t *testing.T) {
+		corrections, lastID, err := repo.ReconcileFavoritesCounts(ctx, 0, 10)
+		if err != nil {
+			t.Fatalf("ReconcileFavoritesCounts() unexpected error: %v", err)
+		}
+		want := domain.CountCorrection{ArticleID: article.ID, Slug: article.Slug, Counter: domain.CounterFavorites, Stored: 42, Actual: 1}
+		if len(corrections) != 1 || corrections[0] != want {
+			t.Errorf("ReconcileFavoritesCounts() corrections = %+v, want [%+v]", corrections, want)
+		}
+		if lastID != article.ID {
+			t.Errorf("ReconcileFavoritesCounts() lastID = %d, want %d", lastID, article.ID)
+		}
+		if got := storedCount(t); got != 1 {
+			t.Errorf("favorites_count after reconcile = %d, want 1", got)
+		}
+
+		corrections, _, err = repo.ReconcileFavoritesCounts(ctx, 0, 10)
+		if err != nil {
+			t.Fatalf("ReconcileFavoritesCounts() unexpected error: %v", err)
+		}
+		if len(corrections) != 0 {
+			t.Errorf("ReconcileFavoritesCounts() on consistent data corrections = %+v, want none", corrections)
+		}
+
+		// Past the last article there is nothing left to check
+		corrections, lastID, err = repo.ReconcileFavoritesCounts(ctx, article.ID, 10)
+		if err != nil || len(corrections) != 0 || lastID != 0 {
+			t.Errorf("ReconcileFavoritesCounts() past the end = %+v, %d, %v; want none, 0, nil", corrections, lastID, err)
+		}
+	})
+}
+
+func TestArticleRepository_ConcurrentFavorite(t *testing.T) {
+	db, cleanup := setupTestArticleDB(t)
+	defer cleanup()
+	// The shared-cache memory database reports table locks instead of
+	// waiting, so serialize connections; the transactions still interleave
+	db.SetMaxOpenConns(1)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	repo := NewSQLiteArticleRepository(db, logger)
+	ctx := context.Background()
+
+	authorID := createTestUser(t, db, "testuser", "test@example.com")
+	fanID := createTestUser(t, db, "fan", "fan@example.com")
+
+	article := &domain.Article{Slug: "raced", Title: "Raced", Description: "d", Body: "b", AuthorID: authorID}
+	if err := repo.CreateArticle(ctx, article, nil); err != nil {
+		t.Fatalf("failed to create test article: %v", err)
+	}
+
+	const attempts = 2
+	errs := make(chan error, attempts)
+	var wg sync.WaitGroup
+	for range attempts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- repo.FavoriteArticle(ctx, article.ID, fanID)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	var succeeded int
+	for err := range errs {
+		switch {
+		case err == nil:
+			succeeded++
+		case errors.Is(err, domain.ErrArticleAlreadyFavorited):
+		default:
+			t.Errorf("FavoriteArticle() unexpected error: %v", err)
+		}
+	}
+	if succeeded != 1 {
+		t.Errorf("expected exactly one favorite to succeed, got %d", succeeded)
+	}
+
+	var count int
+	if err := db.QueryRow(`SELECT favorites_count FROM articles WHERE id = ?`, article.ID).Scan(&count); err != nil {
+		t.Fatalf("failed to read favorites_count: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("favorites_count after concurrent favorites = %d, want 1", count)
+	}
+}
+
+func TestArticleRepository_DeleteArticle(t *testing.T) {
+	db, cleanup := setupTestArticleDB(t)
+	defer cleanup()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	repo := NewSQLiteArticleRepository(db, logger)
+
+	authorID := createTestUser(t, db, "testuser", "test@example.com")
+
+	// Create a test article
+	article := &domain.Article{
+		Slug:        "to-delete",
+		Title:       "To Delete",
+		Description: "This will be deleted",
+		Body:        "Delete me",
+		AuthorID:    authorID,
+	}
+	err := repo.CreateArticle(context.Background(), article, []string{"deletable"})
+	if err != nil {
+		t.Fatalf("failed to create test article: %v", err)
+	}
+
+	// Delete the article
+	err = repo.DeleteArticle(context.Background(), article.ID)
+	if err != nil {
+		t.Errorf("DeleteArticle() unexpected error: %v", err)
+		return
+	}
+
+	// Verify deletion
+	_, err = repo.GetArticleBySlug(context.Background(), "to-delete")
+	if err != domain.ErrArticleNotFound {
+		t.Errorf("GetArticleBySlug() after delete error = %v, want ErrArticleNotFound", err)
+	}
+
+	// Deleting again reports not found
+	if err := repo.DeleteArticle(context.Background(), article.ID); err != domain.ErrArticleNotFound {
+		t.Errorf("DeleteArticle() twice error = %v, want ErrArticleNotFound", err)
+	}
+}
+
+func TestArticleRepository_DeleteArticleOrphanedTags(t *testing.T) {
+	db, cleanup := setupTestArticleDB(t)
+	defer cleanup()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	repo := NewSQLiteArticleRepository(db, logger)
+	ctx := context.Background()
+
+	authorID := createTestUser(t, db, "testuser", "test@example.com")
+
+	doomed := &domain.Article{Slug: "doomed", Title: "Doomed", Description: "d", Body: "b", AuthorID: authorID}
+	if err := repo.CreateArticle(ctx, doomed, []string{"unique-tag", "shared-tag"}); err != nil {
+		t.Fatalf("failed to create test article: %v", err)
+	}
+	kept := &domain.Article{Slug: "kept", Title: "Kept", Description: "d", Body: "b", AuthorID: authorID}
+	if err := repo.CreateArticle(ctx, kept, []string{"shared-tag"}); err != nil {
+		t.Fatalf("failed to create test article: %v", err)
+	}
+
+	if err := repo.DeleteArticle(ctx, doomed.ID); err != nil {
+		t.Fatalf("DeleteArticle() unexpected error: %v", err)
+	}
+
+	tags, err := repo.GetAllTags(ctx)
+	if err != nil {
+		t.Fatalf("GetAllTags() unexpected error: %v", err)
+	}
+	if got := strings.Join(tags, ","); got != "shared-tag" {
+		t.Errorf("GetAllTags() after delete = %q, want %q", got, "shared-tag")
+	}
+
+	var links int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM article_tags WHERE article_id = ?`, doomed.ID).Scan(&links); err != nil {
+		t.Fatalf("failed to count article_tags: %v", err)
+	}
+	if links != 0 {
+		t.Errorf("expected deleted article's tag links to be removed, got %d", links)
+	}
+}
+
+func TestArticleRepository_ListArticles(t *testing.T) {
+	db, cleanup := setupTestArticleDB(t)
+	defer cleanup()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	repo := NewSQLiteArticleRepository(db, logger)
+
+	// Create test users
+	author1ID := createTestUser(t, db, "author1", "author1@example.com")
+	author2ID := createTestUser(t, db, "author2", "author2@example.com")
+
+	// Create test articles
+	articles := []struct {
+		article *domain.Article
+		tags    []string
+	}{
+		{
+			article: &domain.Article{
+				Slug:        "go-basics",
+				Title:       "Go Basics",
+				Description: "Learn Go",
+				Body:        "Go is great",
+				AuthorID:    author1ID,
+				Published:   true,
+			},
+			tags: []string{"go", "tutorial"},
+		},
+		{
+			article: &domain.Article{
+				Slug:        "python-basics",
+				Title:       "Python Basics",
+				Description: "Learn Python",
+				Body:        "Python is cool",
+				AuthorID:    author1ID,
+				Published:   true,
+			},
+			tags: []string{"python", "tutorial"},
+		},
+		{
+			article: &domain.Article{
+				Slug:        "rust-basics",
+				Title:       "Rust Basics",
+				Description: "Learn Rust",
+				Body:        "Rust is fast",
+				AuthorID:    author2ID,
+				Published:   true,
+			},
+			tags: []string{"rust", "systems"},
+		},
+	}
+
+	for _, a := range articles {
+		err := repo.CreateArticle(context.Background(), a.article, a.tags)
+		if err != nil {
+			t.Fatalf("failed to create test article: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name       string
+		params     *domain.ArticleListParams
+		wantCount  int
+		wantTitles []string
+	}{
+		{
+			name: "list all articles",
+			params: &domain.ArticleListParams{
+				Limit:  20,
+				Offset: 0,
+			},
+			wantCount: 3,
+		},
+		{
+			name: "filter by tag",
+			params: &domain.ArticleListParams{
+				Tag:    "go",
+				Limit:  20,
+				Offset: 0,
+			},
+			wantCount:  1,
+			wantTitles: []string{"Go Basics"},
+		},
+		{
+			name: "filter by tutorial tag",
+			params: &domain.ArticleListParams{
+				Tag:    "tutorial",
+				Limit:  20,
+				Offset: 0,
+			},
+			wantCount: 2,
+		},
+		{
+			name: "filter by author",
+			params: &domain.ArticleListParams{
+				Author: "author1",
+				Limit:  20,
+				Offset: 0,
+			},
+			wantCount: 2,
+		},
+		{
+			name: "pagination - limit",
+			params: &domain.ArticleListParams{
+				Limit:  2,
+				Offset: 0,
+			},
+			wantCount: 2,
+		},
+		{
+			name: "pagination - offset",
+			params: &domain.ArticleListParams{
+				Limit:  20,
+				Offset: 2,
+			},
+			wantCount: 1,
+		},
+		{
+			name: "search title case-insensitively",
+			params: &domain.ArticleListParams{
+				Query:  "PYTHON",
+				Limit:  20,
+				Offset: 0,
+			},
+			wantCount:  1,
+			wantTitles: []string{"Python Basics"},
+		},
+		{
+			name: "search body",
+			params: &domain.ArticleListParams{
+				Query:  "fast",
+				Limit:  20,
+				Offset: 0,
+			},
+			wantCount:  1,
+			wantTitles: []string{"Rust Basics"},
+		},
+		{
+			name: "search combined with author filter",
+			params: &domain.ArticleListParams{
+				Query:  "basics",
+				Author: "author2",
+				Limit:  20,
+				Offset: 0,
+			},
+			wantCount:  1,
+			wantTitles: []string{"Rust Basics"},
+		},
+		{
+			name: "search treats wildcards literally",
+			params: &domain.ArticleListParams{
+				Query:  "%",
+				Limit:  20,
+				Offset: 0,
+			},
+			wantCount: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, total, err := repo.ListArticles(context.Background(), tt.params, nil)
+			if err != nil {
+				t.Errorf("ListArticles() unexpected error: %v", err)
+				return
+			}
+
+			if len(result) != tt.wantCount {
+				t.Errorf("ListArticles() count = %v, want %v", len(result), tt.wantCount)
+			}
+
+			// For non-paginated queries, total should be >= count
+			if tt.params.Offset == 0 && tt.params.Limit >= 20 && total < tt.wantCount {
+				t.Errorf("ListArticles() total = %v, want >= %v", total, tt.wantCount)
+			}
+
+			if len(tt.wantTitles) > 0 {
+				for i, title := range tt.wantTitles {
+					if i < len(result) && result[i].Title != title {
+						t.Errorf("ListArticles() result[%d].Title = %v, want %v", i, result[i].Title, title)
+					}
+				}
+			}
+		})
+	}
+
+	t.Run("search count matches the filter", func(t *testing.T) {
+		params := &domain.ArticleListParams{Query: "learn", Tag: "tutorial", Limit: 1, Offset: 0}
+		result, total, err := repo.ListArticles(context.Background(), params, nil)
+		if err != nil {
+			t.Fatalf("ListArticles() unexpected error: %v", err)
+		}
+		if len(result) != 1 || total != 2 {
+			t.Errorf("ListArticles() returned %d articles with total %d, want 1 and 2", len(result), total)
+		}
+	})
+}
+
+func TestArticleRepository_ListArticlesKeyset(t *testing.T) {
+	db, cleanup := setupTestArticleDB(t)
+	defer cleanup()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	repo := NewSQLiteArticleRepository(db, logger)
+	ctx := context.Background()
+
+	authorID := createTestUser(t, db, "author", "author@example.com")
+
+	// Created oldest to newest, one hour apart; "c" and "d" share a timestamp
+	base := time.Now().Add(-24 * time.Hour)
+	offsets := map[string]int{"a": 0, "b": 1, "c": 2, "d": 2, "e": 3}
+	for _, slug := range []string{"a", "b", "c", "d", "e"} {
+		article := &domain.Article{Slug: slug, Title: slug, Description: "d", Body: "b", Published: true, AuthorID: authorID}
+		if err := repo.CreateArticle(ctx, article, nil); err != nil {
+			t.Fatalf("failed to create test article: %v", err)
+		}
+		createdAt := base.Add(time.Duration(offsets[slug]) * time.Hour)
+		if _, err := db.Exec("UPDATE articles SET created_at = ? WHERE id = ?", createdAt, article.ID); err != nil {
+			t.Fatalf("failed to set created_at: %v", err)
+		}
+	}
+
+	var got []string
+	params := &domain.ArticleListParams{Limit: 2}
+	for page := 0; page < 5; page++ {
+		articles, _, err := repo.ListArticles(ctx, params, nil)
+		if err != nil {
+			t.Fatalf("ListArticles() unexpected error: %v", err)
+		}
+		for _, article := range articles {
+			got = append(got, article.Slug)
+		}
+		if len(articles) < params.Limit {
+			break
+		}
+
+		if page == 0 {
+			// A new article published mid-walk must not shift later pages
+			article := &domain.Article{Slug: "new", Title: "new", Description: "d", Body: "b", Published: true, AuthorID: authorID}
+			if err := repo.CreateArticle(ctx, article, nil); err != nil {
+				t.Fatalf("failed to create test article: %v", err)
+			}
+		}
+		// before takes precedence over offset
+		params = &domain.ArticleListParams{Limit: 2, Offset: 100, Before: domain.CursorFor(articles[len(articles)-1])}
+	}
+
+	want := []string{"e", "d", "c", "b", "a"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("keyset pages = %v, want %v", got, want)
+	}
+}
+
+func TestArticleRepository_ListArticlesSort(t *testing.T) {
+	db, cleanup := setupTestArticleDB(t)
+	defer cleanup()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	repo := NewSQLiteArticleRepository(db, logger)
+	ctx := context.Background()
+
+	authorID := createTestUser(t, db, "author", "author@example.com")
+	fan1ID := createTestUser(t, db, "fan1", "fan1@example.com")
+	fan2ID := createTestUser(t, db, "fan2", "fan2@example.com")
+
+	// Created oldest to newest, one day apart
+	slugs := []string{"first", "second", "third"}
+	ids := make(map[string]int64)
+	for i, slug := range slugs {
+		article := &domain.Article{Slug: slug, Title: slug, Description: "d", Body: "b", Published: true, AuthorID: authorID}
+		if err := repo.CreateArticle(ctx, article, nil); err != nil {
+			t.Fatalf("failed to create test article: %v", err)
+		}
+		createdAt := time.Now().Add(time.Duration(i-len(slugs)) * 24 * time.Hour)
+		if _, err := db.Exec("UPDATE articles SET created_at = ? WHERE id = ?", createdAt, article.ID); err != nil {
+			t.Fatalf("failed to set created_at: %v", err)
+		}
+		ids[slug] = article.ID
+	}
+
+	// "first" has two favorites, "second" one, "third" none
+	for _, fav := range []struct {
+		slug   string
+		userID int64
+	}{{"first", fan1ID}, {"first", fan2ID}, {"second", fan1ID}} {
+		if err := repo.FavoriteArticle(ctx, ids[fav.slug], fav.userID); err != nil {
+			t.Fatalf("failed to favorite article: %v", err)
+		}
+	}
+
+	tests := []struct {
+		sort      domain.ArticleSort
+		wantSlugs []string
+	}{
+		{sort: domain.ArticleSortNewest, wantSlugs: []string{"third", "second", "first"}},
+		{sort: domain.ArticleSortOldest, wantSlugs: []string{"first", "second", "third"}},
+		{sort: domain.ArticleSortMostFavorited, wantSlugs: []string{"first", "second", "third"}},
+		{sort: "", wantSlugs: []string{"third", "second", "first"}},
+	}
+
+	for _, tt := range tests {
+		t.Run("sort "+string(tt.sort), func(t *testing.T) {
+			params := &domain.ArticleListParams{Sort: tt.sort, Limit: 20}
+			result, _, err := repo.ListArticles(ctx, params, nil)
+			if err != nil {
+				t.Fatalf("ListArticles() unexpected error: %v", err)
+			}
+
+			var got []string
+			for _, article := range result {
+				got = append(got, article.Slug)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.wantSlugs, ",") {
+				t.Errorf("ListArticles() order = %v, want %v", got, tt.wantSlugs)
+			}
+		})
+	}
+
+	t.Run("mostFavorited returns favorites counts", func(t *testing.T) {
+		params := &domain.ArticleListParams{Sort: domain.ArticleSortMostFavorited, Limit: 1}
+		result, _, err := repo.ListArticles(ctx, params, nil)
+		if err != nil {
+			t.Fatalf("ListArticles() unexpected error: %v", err)
+		}
+		if len(result) != 1 || result[0].FavoritesCount != 2 {
+			t.Errorf("expected the top article to have 2 favorites, got %+v", result)
+		}
+	})
+}
+
+func TestArticleRepository_ListArticlesDetails(t *testing.T) {
+	db, cleanup := setupTestArticleDB(t)
+	defer cleanup()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	repo := NewSQLiteArticleRepository(db, logger)
+	ctx := context.Background()
+
+	authorID := createTestUser(t, db, "author", "author@example.com")
+	readerID := createTestUser(t, db, "reader", "reader@example.com")
+
+	tagged := &domain.Article{Slug: "tagged", Title: "Tagged", Description: "d", Body: "b", Published: true, AuthorID: authorID}
+	if err := repo.CreateArticle(ctx, tagged, []string{"zeta", "alpha"}); err != nil {
+		t.Fatalf("failed to create test article: %v", err)
+	}
+	plain := &domain.Article{Slug: "plain", Title: "Plain", Description: "d", Body: "b", Published: true, AuthorID: authorID}
+	if err := repo.CreateArticle(ctx, plain, nil); err != nil {
+		t.Fatalf("failed to create test article: %v", err)
+	}
+	if err := repo.FavoriteArticle(ctx, tagged.ID, readerID); err != nil {
+		t.Fatalf("failed to favorite article: %v", err)
+	}
+
+	// Follow the author so the feed contains both articles
+	if _, err := db.Exec("INSERT INTO follows (follower_id, following_id) VALUES (?, ?)", readerID, authorID); err != nil {
+		t.Fatalf("failed to follow author: %v", err)
+	}
+
+	check := func(t *testing.T, articles []*domain.Article) {
+		t.Helper()
+		if len(articles) != 2 {
+			t.Fatalf("expected 2 articles, got %d", len(articles))
+		}
+		for _, article := range articles {
+			switch article.Slug {
+			case "tagged":
+				if strings.Join(article.TagList, ",") != "alpha,zeta" {
+					t.Errorf("expected sorted tags [alpha zeta], got %v", article.TagList)
+				}
+				if !article.Favorited || article.FavoritesCount != 1 {
+					t.Errorf("expected tagged to be favorited once, got favorited=%v count=%d", article.Favorited, article.FavoritesCount)
+				}
+			case "plain":
+				if article.TagList == nil || len(article.TagList) != 0 {
+					t.Errorf("expected empty non-nil tags, got %#v", article.TagList)
+				}
+				if article.Favorited || article.FavoritesCount != 0 {
+					t.Errorf("expected plain to be unfavorited, got favorited=%v count=%d", article.Favorited, article.FavoritesCount)
+				}
+			}
+		}
+	}
+
+	t.Run("ListArticles", func(t *testing.T) {
+		articles, _, err := repo.ListArticles(ctx, &domain.ArticleListParams{Limit: 20}, &readerID)
+		if err != nil {
+			t.Fatalf("ListArticles() unexpected error: %v", err)
+		}
+		check(t, articles)
+	})
+
+	t.Run("GetFeed", func(t *testing.T) {
+		articles, _, err := repo.GetFeed(ctx, readerID, &domain.ArticleFeedParams{Limit: 20})
+		if err != nil {
+			t.Fatalf("GetFeed() unexpected error: %v", err)
+		}
+		check(t, articles)
+	})
+}
+
+func TestArticleRepository_ListArticlesDrafts(t *testing.T) {
+	db, cleanup := setupTestArticleDB(t)
+	defer cleanup()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	repo := NewSQLiteArticleRepository(db, logger)
+	ctx := context.Background()
+
+	authorID := createTestUser(t, db, "author", "author@example.com")
+	otherID := createTestUser(t, db, "other", "other@example.com")
+
+	for _, article := range []*domain.Article{
+		{Slug: "published", Title: "Published", Description: "d", Body: "b", Published: true, AuthorID: authorID},
+		{Slug: "my-draft", Title: "My Draft", Description: "d", Body: "b", Published: false, AuthorID: authorID},
+		{Slug: "other-draft", Title: "Other Draft", Description: "d", Body: "b", Published: false, AuthorID: otherID},
+	} {
+		if err := repo.CreateArticle(ctx, article, nil); err != nil {
+			t.Fatalf("failed to create test article: %v", err)
+		}
+	}
+	if _, err := db.Exec("INSERT INTO follows (follower_id, following_id) VALUES (?, ?)", otherID, authorID); err != nil {
+		t.Fatalf("failed to follow author: %v", err)
+	}
+
+	slugsOf := func(articles []*domain.Article) string {
+		var slugs []string
+		for _, article := range articles {
+			slugs = append(slugs, article.Slug)
+		}
+		return strings.Join(slugs, ",")
+	}
+
+	tests := []struct {
+		name          string
+		includeDrafts bool
+		currentUserID *int64
+		want          string
+	}{
+		{name: "anonymous sees only published", want: "published"},
+		{name: "author without includeDrafts sees only published", currentUserID: &authorID, want: "published"},
+		{name: "author with includeDrafts sees own drafts", includeDrafts: true, currentUserID: &authorID, want: "my-draft,published"},
+		{name: "anonymous includeDrafts is ignored", includeDrafts: true, want: "published"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := &domain.ArticleListParams{IncludeDrafts: tt.includeDrafts, Limit: 20}
+			result, total, err := repo.ListArticles(ctx, params, tt.currentUserID)
+			if err != nil {
+				t.Fatalf("ListArticles() unexpected error: %v", err)
+			}
+			// Sort by slug for a stable comparison regardless of timestamps
+			sort.Slice(result, func(i, j int) bool { return result[i].Slug < result[j].Slug })
+			if got := slugsOf(result); got != tt.want {
+				t.Errorf("ListArticles() = %q, want %q", got, tt.want)
+			}
+			if total != len(result) {
+				t.Errorf("ListArticles() total = %d, want %d", total, len(result))
+			}
+		})
+	}
+
+	t.Run("feed excludes drafts", func(t *testing.T) {
+		result, total, err := repo.GetFeed(ctx, otherID, &domain.ArticleFeedParams{Limit: 20})
+		if err != nil {
+			t.Fatalf("GetFeed() unexpected error: %v", err)
+		}
+		if got := slugsOf(result); got != "published" || total != 1 {
+			t.Errorf("GetFeed() = %q (total %d), want %q (total 1)", got, total, "published")
+		}
+	})
+}
+
+func TestArticleRepository_ScheduledArticles(t *testing.T) {
+	db, cleanup := setupTestArticleDB(t)
+	defer cleanup()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	repo := NewSQLiteArticleRepository(db, logger)
+	ctx := context.Background()
+
+	authorID := createTestUser(t, db, "author", "author@example.com")
+	readerID := createTestUser(t, db, "reader", "reader@example.com")
+
+	past := time.Now().UTC().Add(-time.Hour)
+	future := time.Now().UTC().Add(time.Hour)
+	for _, article := range []*domain.Article{
+		{Slug: "now", Title: "Now", Description: "d", Body: "b", Published: true, AuthorID: authorID},
+		{Slug: "earlier", Title: "Earlier", Description: "d", Body: "b", Published: true, PublishAt: &past, AuthorID: authorID},
+		{Slug: "later", Title: "Later", Description: "d", Body: "b", Published: true, PublishAt: &future, AuthorID: authorID},
+	} {
+		if err := repo.CreateArticle(ctx, article, nil); err != nil {
+			t.Fatalf("failed to create test article: %v", err)
+		}
+	}
+	if _, err := db.Exec("INSERT INTO follows (follower_id, following_id) VALUES (?, ?)", readerID, authorID); err != nil {
+		t.Fatalf("failed to follow author: %v", err)
+	}
+
+	t.Run("round-trips publishAt", func(t *testing.T) {
+		article, err := repo.GetArticleBySlug(ctx, "later")
+		if err != nil {
+			t.Fatalf("GetArticleBySlug() unexpected error: %v", err)
+		}
+		if article.PublishAt == nil || !article.PublishAt.Equal(future) {
+			t.Errorf("expected publishAt %v, got %v", future, article.PublishAt)
+		}
+	})
+
+	t.Run("list hides future articles", func(t *testing.T) {
+		result, total, err := repo.ListArticles(ctx, &domain.ArticleListParams{Limit: 20}, &readerID)
+		if err != nil {
+			t.Fatalf("ListArticles() unexpected error: %v", err)
+		}
+		if len(result) != 2 || total != 2 {
+			t.Errorf("expected 2 visible articles, got %d (total %d)", len(result), total)
+		}
+	})
+
+	t.Run("author sees scheduled articles with includeDrafts", func(t *testing.T) {
+		params := &domain.ArticleListParams{IncludeDrafts: true, Limit: 20}
+		result, total, err := repo.ListArticles(ctx, params, &authorID)
+		if err != nil {
+			t.Fatalf("ListArticles() unexpected error: %v", err)
+		}
+		if len(result) != 3 || total != 3 {
+			t.Errorf("expected 3 articles, got %d (total %d)", len(result), total)
+		}
+	})
+
+	t.Run("feed count excludes future articles", func(t *testing.T) {
+		result, total, err := repo.GetFeed(ctx, readerID, &domain.ArticleFeedParams{Limit: 20})
+		if err != nil {
+			t.Fatalf("GetFeed() unexpected error: %v", err)
+		}
+		if len(result) != 2 || total != 2 {
+			t.Errorf("expected 2 feed articles, got %d (total %d)", len(result), total)
+		}
+	})
+}
+
+func TestArticleRepository_SlugExists(t *testing.T) {
+	db, cleanup := setupTestArticleDB(t)
+	defer cleanup()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	repo := NewSQLiteArticleRepository(db, logger)
+
+	authorID := createTestUser(t, db, "testuser", "test@example.com")
+
+	// Create a test article
+	article := &domain.Article{
+		Slug:        "existing-slug",
+		Title:       "Existing Title",
+		Description: "Existing description",
+		Body:        "Existing body",
+		AuthorID:    authorID,
+	}
+	err := repo.CreateArticle(context.Background(), article, nil)
+	if err != nil {
+		t.Fatalf("failed to create test article: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		slug   string
+		exists bool
+	}{
+		{
+			name:   "existing slug",
+			slug:   "existing-slug",
+			exists: true,
+		},
+		{
+			name:   "non-existing slug",
+			slug:   "non-existing-slug",
+			exists: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := repo.SlugExists(context.Background(), tt.slug)
+			if result != tt.exists {
+				t.Errorf("SlugExists(%q) = %v, want %v", tt.slug, result, tt.exists)
+			}
+		})
+	}
+}
+
+func TestArticleRepository_AuthorHasTitle(t *testing.T) {
+	db, cleanup := setupTestArticleDB(t)
+	defer cleanup()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	repo := NewSQLiteArticleRepository(db, logger)
+
+	authorID := createTestUser(t, db, "testuser", "test@example.com")
+	otherID := createTestUser(t, db, "otheruser", "other@example.com")
+
+	article := &domain.Article{
+		Slug:        "existing-slug",
+		Title:       "Existing Title",
+		Description: "Existing description",
+		Body:        "Existing body",
+		AuthorID:    authorID,
+	}
+	if err := repo.CreateArticle(context.Background(), article, nil); err != nil {
+		t.Fatalf("failed to create test article: %v", err)
+	}
+	accented := &domain.Article{
+		Slug:        "eclair-recipe",
+		Title:       "Éclair Recipe",
+		Description: "Existing description",
+		Body:        "Existing body",
+		AuthorID:    authorID,
+	}
+	if err := repo.CreateArticle(context.Background(), accented, nil); err != nil {
+		t.Fatalf("failed to create test article: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		authorID  int64
+		title     string
+		excludeID int64
+		exists    bool
+	}{
+		{name: "same title", authorID: authorID, title: "Existing Title", exists: true},
+		{name: "same title on the excluded article", authorID: authorID, title: "Existing Title", excludeID: article.ID, exists: false},
+		{name: "same non-ASCII title", authorID: authorID, title: "Éclair Recipe", exists: true},
+		{name: "non-ASCII title in a different case", authorID: authorID, title: "éCLAIR recipe", exists: true},
+		{name: "different case and padding", authorID: authorID, title: "  existing TITLE ", exists: true},
+		{name: "different title", authorID: authorID, title: "Another Title", exists: false},
+		{name: "different author", authorID: otherID, title: "Existing Title", exists: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := repo.AuthorHasTitle(context.Background(), tt.authorID, tt.title, tt.excludeID)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if result != tt.exists {
+				t.Errorf("AuthorHasTitle(%d, %q) = %v, want %v", tt.authorID, tt.title, result, tt.exists)
+			}
+		})
+	}
+}
+
+func TestArticleRepository_GetArticleTags(t *testing.T) {
+	db, cleanup := setupTestArticleDB(t)
+	defer cleanup()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	repo := NewSQLiteArticleRepository(db, logger)
+
+	authorID := createTestUser(t, db, "testuser", "test@example.com")
+
+	// Create articles with tags
+	article1 := &domain.Article{
+		Slug:        "article-1",
+		Title:       "Article 1",
+		Description: "Desc 1",
+		Body:        "Body 1",
+		AuthorID:    authorID,
+	}
+	err := repo.CreateArticle(context.Background(), article1, []string{"go", "tutorial"})
+	if err != nil {
+		t.Fatalf("failed to create test article 1: %v", err)
+	}
+
+	article2 := &domain.Article{
+		Slug:        "article-2",
+		Title:       "Article 2",
 		Description: "Desc 2",
 		Body:        "Body 2",
 		AuthorID:    authorID,
@@ -598,3 +1659,95 @@ func TestArticleRepository_GetArticleTags(t *testing.T) {
 		t.Errorf("GetAllTags() count = %v, want 3 (go, tutorial, programming)", len(tags))
 	}
 }
+
+func TestArticleRepository_GetTagCounts(t *testing.T) {
+	db, cleanup := setupTestArticleDB(t)
+	defer cleanup()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	repo := NewSQLiteArticleRepository(db, logger)
+	ctx := context.Background()
+
+	authorID := createTestUser(t, db, "testuser", "test@example.com")
+
+	for _, tc := range []struct {
+		article *domain.Article
+		tags    []string
+	}{
+		{&domain.Article{Slug: "a", Title: "A", Description: "d", Body: "b", Published: true, AuthorID: authorID}, []string{"go", "web"}},
+		{&domain.Article{Slug: "b", Title: "B", Description: "d", Body: "b", Published: true, AuthorID: authorID}, []string{"go", "testing"}},
+		{&domain.Article{Slug: "c", Title: "C", Description: "d", Body: "b", Published: true, AuthorID: authorID}, []string{"go", "web"}},
+		{&domain.Article{Slug: "draft", Title: "Draft", Description: "d", Body: "b", Published: false, AuthorID: authorID}, []string{"go", "drafts"}},
+	} {
+		if err := repo.CreateArticle(ctx, tc.article, tc.tags); err != nil {
+			t.Fatalf("failed to create test article: %v", err)
+		}
+	}
+
+	t.Run("counts distinct published articles per tag", func(t *testing.T) {
+		counts, err := repo.GetTagCounts(ctx, 20)
+		if err != nil {
+			t.Fatalf("GetTagCounts() unexpected error: %v", err)
+		}
+
+		want := []domain.TagCount{{Name: "go", Count: 3}, {Name: "web", Count: 2}, {Name: "testing", Count: 1}}
+		if len(counts) != len(want) {
+			t.Fatalf("GetTagCounts() = %v, want %v", counts, want)
+		}
+		for i := range want {
+			if counts[i] != want[i] {
+				t.Errorf("GetTagCounts()[%d] = %v, want %v", i, counts[i], want[i])
+			}
+		}
+	})
+
+	t.Run("applies limit", func(t *testing.T) {
+		counts, err := repo.GetTagCounts(ctx, 1)
+		if err != nil {
+			t.Fatalf("GetTagCounts() unexpected error: %v", err)
+		}
+		if len(counts) != 1 || counts[0].Name != "go" {
+			t.Errorf("GetTagCounts(1) = %v, want [go]", counts)
+		}
+	})
+}
+
+func TestArticleRepository_SearchTags(t *testing.T) {
+	db, cleanup := setupTestArticleDB(t)
+	defer cleanup()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	repo := NewSQLiteArticleRepository(db, logger)
+	ctx := context.Background()
+
+	authorID := createTestUser(t, db, "testuser", "test@example.com")
+	article := &domain.Article{Slug: "a", Title: "A", Description: "d", Body: "b", Published: true, AuthorID: authorID}
+	if err := repo.CreateArticle(ctx, article, []string{"golang", "Go", "gopher", "rust", "go_tips", "gotcha"}); err != nil {
+		t.Fatalf("failed to create test article: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		prefix string
+		limit  int
+		want   string
+	}{
+		{name: "matches prefix alphabetically", prefix: "go", limit: 10, want: "Go,go_tips,golang,gopher,gotcha"},
+		{name: "ignores case", prefix: "GOL", limit: 10, want: "golang"},
+		{name: "does not match inside names", prefix: "ust", limit: 10, want: ""},
+		{name: "treats wildcards literally", prefix: "go_", limit: 10, want: "go_tips"},
+		{name: "applies limit", prefix: "go", limit: 2, want: "Go,go_tips"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tags, err := repo.SearchTags(ctx, tt.prefix, tt.limit)
+			if err != nil {
+				t.Fatalf("SearchTags() unexpected error: %v", err)
+			}
+			if got := strings.Join(tags, ","); got != tt.want {
+				t.Errorf("SearchTags(%q) = %q, want %q", tt.prefix, got, tt.want)
+			}
+		})
+	}
+}
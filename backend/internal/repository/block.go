@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
+)
+
+// BlockRepository defines the interface for user block data access
+type BlockRepository interface {
+	Block(ctx context.Context, blockerID, blockedID int64) error
+	Unblock(ctx context.Context, blockerID, blockedID int64) error
+	IsBlocked(ctx context.Context, blockerID, blockedID int64) (bool, error)
+}
+
+// SQLiteBlockRepository implements BlockRepository for SQLite
+type SQLiteBlockRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+// NewSQLiteBlockRepository creates a new SQLite block repository
+func NewSQLiteBlockRepository(db *sql.DB, logger *slog.Logger) *SQLiteBlockRepository {
+	return &SQLiteBlockRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Block records that blockerID has blocked blockedID. Blocking the same user
+// twice is not an error.
+func (r *SQLiteBlockRepository) Block(ctx context.Context, blockerID, blockedID int64) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	_, err := r.db.ExecContext(ctx,
+		`INSERT OR IGNORE INTO blocks (blocker_id, blocked_id, created_at) VALUES (?, ?, ?)`,
+		blockerID, blockedID, time.Now())
+	if err != nil {
+		r.logger.Error("failed to block user",
+			"error", err,
+			"blocker_id", blockerID,
+			"blocked_id", blockedID,
+		)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	return nil
+}
+
+// Unblock removes a block. Unblocking a user that isn't blocked is a no-op.
+func (r *SQLiteBlockRepository) Unblock(ctx context.Context, blockerID, blockedID int64) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	_, err := r.db.ExecContext(ctx,
+		`DELETE FROM blocks WHERE blocker_id = ? AND blocked_id = ?`,
+		blockerID, blockedID)
+	if err != nil {
+		r.logger.Error("failed to unblock user",
+			"error", err,
+			"blocker_id", blockerID,
+			"blocked_id", blockedID,
+		)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	return nil
+}
+
+// IsBlocked reports whether blockerID has blocked blockedID
+func (r *SQLiteBlockRepository) IsBlocked(ctx context.Context, blockerID, blockedID int64) (bool, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var exists bool
+	err := r.db.QueryRowContext(ctx,
+		`SELECT EXISTS(SELECT 1 FROM blocks WHERE blocker_id = ? AND blocked_id = ?)`,
+		blockerID, blockedID).Scan(&exists)
+	if err != nil {
+		r.logger.Error("failed to check block status", "error", err)
+		return false, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"testing"
+)
+
+func TestBlockRepository(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	_, err := db.Exec(`
+		CREATE TABLE blocks (
+			blocker_id INTEGER NOT NULL,
+			blocked_id INTEGER NOT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (blocker_id, blocked_id),
+			FOREIGN KEY (blocker_id) REFERENCES users(id) ON DELETE CASCADE,
+			FOREIGN KEY (blocked_id) REFERENCES users(id) ON DELETE CASCADE
+		)
+	`)
+	if err != nil {
+		t.Fatalf("failed to create blocks table: %v", err)
+	}
+
+	repo := NewSQLiteBlockRepository(db, newTestLogger())
+	ctx := context.Background()
+
+	t.Run("block and unblock", func(t *testing.T) {
+		if blocked, err := repo.IsBlocked(ctx, 1, 2); err != nil || blocked {
+			t.Fatalf("IsBlocked() = %v, %v; want false, nil", blocked, err)
+		}
+
+		if err := repo.Block(ctx, 1, 2); err != nil {
+			t.Fatalf("Block() error = %v", err)
+		}
+		// Blocking twice is a no-op
+		if err := repo.Block(ctx, 1, 2); err != nil {
+			t.Fatalf("Block() second call error = %v", err)
+		}
+
+		if blocked, err := repo.IsBlocked(ctx, 1, 2); err != nil || !blocked {
+			t.Errorf("IsBlocked() = %v, %v; want true, nil", blocked, err)
+		}
+		// Blocks are one-directional
+		if blocked, err := repo.IsBlocked(ctx, 2, 1); err != nil || blocked {
+			t.Errorf("IsBlocked() reverse = %v, %v; want false, nil", blocked, err)
+		}
+
+		if err := repo.Unblock(ctx, 1, 2); err != nil {
+			t.Fatalf("Unblock() error = %v", err)
+		}
+		if blocked, err := repo.IsBlocked(ctx, 1, 2); err != nil || blocked {
+			t.Errorf("IsBlocked() after unblock = %v, %v; want false, nil", blocked, err)
+		}
+	})
+
+	t.Run("unblock without a block is a no-op", func(t *testing.T) {
+		if err := repo.Unblock(ctx, 3, 4); err != nil {
+			t.Errorf("Unblock() error = %v", err)
+		}
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
 	_ "github.com/mattn/go-sqlite3"
@@ -53,6 +54,12 @@ func setupTestCommentDB(t *testing.T) (*sql.DB, func()) {
 			title TEXT NOT NULL,
 			description TEXT NOT NULL DEFAULT '',
 			body TEXT NOT NULL DEFAULT '',
+			cover_image TEXT NOT NULL DEFAULT '',
+			published BOOLEAN NOT NULL DEFAULT 1,
+			comments_enabled BOOLEAN NOT NULL DEFAULT 1,
+			publish_at TIMESTAMP,
+			view_count INTEGER NOT NULL DEFAULT 0,
+			favorites_count INTEGER NOT NULL DEFAULT 0,
 			author_id INTEGER NOT NULL,
 			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
 			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
@@ -70,6 +77,7 @@ func setupTestCommentDB(t *testing.T) (*sql.DB, func()) {
 			body TEXT NOT NULL,
 			article_id INTEGER NOT NULL,
 			author_id INTEGER NOT NULL,
+			parent_id INTEGER REFERENCES comments(id) ON DELETE SET NULL,
 			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
 			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
 			FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE,
@@ -80,6 +88,23 @@ func setupTestCommentDB(t *testing.T) (*sql.DB, func()) {
 		t.Fatalf("failed to create comments table: %v", err)
 	}
 
+	// Create comment reports table
+	_, err = db.Exec(`
+		CREATE TABLE comment_reports (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			comment_id INTEGER NOT NULL,
+			reporter_id INTEGER NOT NULL,
+			reason TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			UNIQUE (comment_id, reporter_id),
+			FOREIGN KEY (comment_id) REFERENCES comments(id) ON DELETE CASCADE,
+			FOREIGN KEY (reporter_id) REFERENCES users(id) ON DELETE CASCADE
+		)
+	`)
+	if err != nil {
+		t.Fatalf("failed to create comment_reports table: %v", err)
+	}
+
 	return db, func() {
 		db.Close()
 	}
@@ -194,6 +219,61 @@ func TestCommentRepository_GetCommentByID(t *testing.T) {
 	})
 }
 
+func TestCommentRepository_CreateComment_Reply(t *testing.T) {
+	db, cleanup := setupTestCommentDB(t)
+	defer cleanup()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	repo := NewSQLiteCommentRepository(db, logger)
+
+	authorID := createTestUserForComment(t, db, "testuser", "test@example.com")
+	articleID := createTestArticle(t, db, "test-article", "Test Article", authorID)
+	otherArticleID := createTestArticle(t, db, "other-article", "Other Article", authorID)
+
+	parent := &domain.Comment{Body: "Parent", ArticleID: articleID, AuthorID: authorID}
+	if err := repo.CreateComment(context.Background(), parent); err != nil {
+		t.Fatalf("failed to create parent comment: %v", err)
+	}
+
+	t.Run("reply on the same article", func(t *testing.T) {
+		reply := &domain.Comment{Body: "Reply", ArticleID: articleID, AuthorID: authorID, ParentID: &parent.ID}
+		if err := repo.CreateComment(context.Background(), reply); err != nil {
+			t.Fatalf("CreateComment() error = %v", err)
+		}
+
+		comments, _, err := repo.GetCommentsByArticleID(context.Background(), articleID, &domain.CommentListParams{Sort: domain.CommentSortOldest, Limit: 20})
+		if err != nil {
+			t.Fatalf("GetCommentsByArticleID() error = %v", err)
+		}
+		if len(comments) != 2 {
+			t.Fatalf("GetCommentsByArticleID() count = %d, want 2", len(comments))
+		}
+		if comments[0].ParentID != nil {
+			t.Errorf("parent ParentID = %v, want nil", *comments[0].ParentID)
+		}
+		if comments[1].ParentID == nil || *comments[1].ParentID != parent.ID {
+			t.Errorf("reply ParentID = %v, want %d", comments[1].ParentID, parent.ID)
+		}
+	})
+
+	t.Run("reply to a comment on a different article", func(t *testing.T) {
+		reply := &domain.Comment{Body: "Reply", ArticleID: otherArticleID, AuthorID: authorID, ParentID: &parent.ID}
+		err := repo.CreateComment(context.Background(), reply)
+		if err != domain.ErrInvalidParentComment {
+			t.Errorf("CreateComment() error = %v, want ErrInvalidParentComment", err)
+		}
+	})
+
+	t.Run("reply to a missing comment", func(t *testing.T) {
+		missingID := int64(999999)
+		reply := &domain.Comment{Body: "Reply", ArticleID: articleID, AuthorID: authorID, ParentID: &missingID}
+		err := repo.CreateComment(context.Background(), reply)
+		if err != domain.ErrInvalidParentComment {
+			t.Errorf("CreateComment() error = %v, want ErrInvalidParentComment", err)
+		}
+	})
+}
+
 func TestCommentRepository_GetCommentsByArticleID(t *testing.T) {
 	db, cleanup := setupTestCommentDB(t)
 	defer cleanup()
@@ -218,7 +298,7 @@ func TestCommentRepository_GetCommentsByArticleID(t *testing.T) {
 	}
 
 	t.Run("get comments for article", func(t *testing.T) {
-		comments, err := repo.GetCommentsByArticleID(context.Background(), articleID)
+		comments, _, err := repo.GetCommentsByArticleID(context.Background(), articleID, &domain.CommentListParams{Sort: domain.CommentSortOldest, Limit: 20})
 		if err != nil {
 			t.Errorf("GetCommentsByArticleID() error = %v", err)
 			return
@@ -230,7 +310,7 @@ func TestCommentRepository_GetCommentsByArticleID(t *testing.T) {
 	})
 
 	t.Run("get comments for non-existing article", func(t *testing.T) {
-		comments, err := repo.GetCommentsByArticleID(context.Background(), 999999)
+		comments, _, err := repo.GetCommentsByArticleID(context.Background(), 999999, &domain.CommentListParams{Sort: domain.CommentSortOldest, Limit: 20})
 		if err != nil {
 			t.Errorf("GetCommentsByArticleID() error = %v", err)
 			return
@@ -242,6 +322,58 @@ func TestCommentRepository_GetCommentsByArticleID(t *testing.T) {
 	})
 }
 
+func TestCommentRepository_GetCommentsByArticleID_Sort(t *testing.T) {
+	db, cleanup := setupTestCommentDB(t)
+	defer cleanup()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	repo := NewSQLiteCommentRepository(db, logger)
+
+	authorID := createTestUserForComment(t, db, "testuser", "test@example.com")
+	articleID := createTestArticle(t, db, "test-article", "Test Article", authorID)
+
+	// All comments share a timestamp so only the id tiebreaker orders them
+	createdAt := time.Now().UTC().Truncate(time.Second)
+	var ids []int64
+	for i := 1; i <= 4; i++ {
+		result, err := db.Exec(`
+			INSERT INTO comments (body, article_id, author_id, created_at, updated_at)
+			VALUES (?, ?, ?, ?, ?)
+		`, "Comment", articleID, authorID, createdAt, createdAt)
+		if err != nil {
+			t.Fatalf("failed to create test comment %d: %v", i, err)
+		}
+		id, _ := result.LastInsertId()
+		ids = append(ids, id)
+	}
+
+	tests := []struct {
+		name string
+		sort domain.CommentSort
+		want []int64
+	}{
+		{name: "oldest first", sort: domain.CommentSortOldest, want: ids},
+		{name: "newest first", sort: domain.CommentSortNewest, want: []int64{ids[3], ids[2], ids[1], ids[0]}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comments, _, err := repo.GetCommentsByArticleID(context.Background(), articleID, &domain.CommentListParams{Sort: tt.sort, Limit: 20})
+			if err != nil {
+				t.Fatalf("GetCommentsByArticleID() error = %v", err)
+			}
+			if len(comments) != len(tt.want) {
+				t.Fatalf("GetCommentsByArticleID() count = %v, want %v", len(comments), len(tt.want))
+			}
+			for i, comment := range comments {
+				if comment.ID != tt.want[i] {
+					t.Errorf("comments[%d].ID = %d, want %d", i, comment.ID, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
 func TestCommentRepository_DeleteComment(t *testing.T) {
 	db, cleanup := setupTestCommentDB(t)
 	defer cleanup()
@@ -287,3 +419,278 @@ func TestCommentRepository_DeleteComment(t *testing.T) {
 		}
 	})
 }
+
+func TestCommentRepository_UpdateComment(t *testing.T) {
+	db, cleanup := setupTestCommentDB(t)
+	defer cleanup()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	repo := NewSQLiteCommentRepository(db, logger)
+
+	authorID := createTestUserForComment(t, db, "testuser", "test@example.com")
+	articleID := createTestArticle(t, db, "test-article", "Test Article", authorID)
+
+	comment := &domain.Comment{
+		Body:      "Original",
+		ArticleID: articleID,
+		AuthorID:  authorID,
+	}
+	if err := repo.CreateComment(context.Background(), comment); err != nil {
+		t.Fatalf("failed to create test comment: %v", err)
+	}
+	createdAt := comment.CreatedAt
+
+	t.Run("update existing comment", func(t *testing.T) {
+		comment.Body = "Edited"
+		if err := repo.UpdateComment(context.Background(), comment); err != nil {
+			t.Fatalf("UpdateComment() error = %v", err)
+		}
+
+		got, err := repo.GetCommentByID(context.Background(), comment.ID)
+		if err != nil {
+			t.Fatalf("GetCommentByID() error = %v", err)
+		}
+		if got.Body != "Edited" {
+			t.Errorf("Body = %q, want %q", got.Body, "Edited")
+		}
+		if got.UpdatedAt.Before(createdAt) {
+			t.Errorf("UpdatedAt = %v, want at or after %v", got.UpdatedAt, createdAt)
+		}
+	})
+
+	t.Run("update non-existing comment", func(t *testing.T) {
+		err := repo.UpdateComment(context.Background(), &domain.Comment{ID: 999999, Body: "Edited"})
+		if err != domain.ErrCommentNotFound {
+			t.Errorf("UpdateComment() error = %v, want ErrCommentNotFound", err)
+		}
+	})
+}
+
+func TestCommentRepository_ListCommentsByAuthor(t *testing.T) {
+	db, cleanup := setupTestCommentDB(t)
+	defer cleanup()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	repo := NewSQLiteCommentRepository(db, logger)
+
+	authorID := createTestUserForComment(t, db, "commenter", "commenter@example.com")
+	otherID := createTestUserForComment(t, db, "other", "other@example.com")
+	firstArticleID := createTestArticle(t, db, "first-article", "First Article", otherID)
+	secondArticleID := createTestArticle(t, db, "second-article", "Second Article", otherID)
+
+	// Two comments by the author on different articles, one by someone else
+	for _, c := range []*domain.Comment{
+		{Body: "On first", ArticleID: firstArticleID, AuthorID: authorID},
+		{Body: "Not mine", ArticleID: firstArticleID, AuthorID: otherID},
+		{Body: "On second", ArticleID: secondArticleID, AuthorID: authorID},
+	} {
+		if err := repo.CreateComment(context.Background(), c); err != nil {
+			t.Fatalf("failed to create test comment: %v", err)
+		}
+	}
+
+	t.Run("returns only the author's comments with article references", func(t *testing.T) {
+		comments, total, err := repo.ListCommentsByAuthor(context.Background(), authorID, 20, 0)
+		if err != nil {
+			t.Fatalf("ListCommentsByAuthor() error = %v", err)
+		}
+		if total != 2 {
+			t.Errorf("ListCommentsByAuthor() total = %v, want 2", total)
+		}
+		if len(comments) != 2 {
+			t.Fatalf("ListCommentsByAuthor() count = %v, want 2", len(comments))
+		}
+
+		// Newest first
+		if comments[0].Body != "On second" || comments[0].ArticleSlug != "second-article" || comments[0].ArticleTitle != "Second Article" {
+			t.Errorf("unexpected first comment: %+v", comments[0])
+		}
+		if comments[1].Body != "On first" || comments[1].ArticleSlug != "first-article" || comments[1].ArticleTitle != "First Article" {
+			t.Errorf("unexpected second comment: %+v", comments[1])
+		}
+	})
+
+	t.Run("paginates with limit and offset", func(t *testing.T) {
+		comments, total, err := repo.ListCommentsByAuthor(context.Background(), authorID, 1, 1)
+		if err != nil {
+			t.Fatalf("ListCommentsByAuthor() error = %v", err)
+		}
+		if total != 2 {
+			t.Errorf("ListCommentsByAuthor() total = %v, want 2", total)
+		}
+		if len(comments) != 1 || comments[0].Body != "On first" {
+			t.Errorf("ListCommentsByAuthor() page = %+v, want only 'On first'", comments)
+		}
+	})
+
+	t.Run("returns empty list for user without comments", func(t *testing.T) {
+		lurkerID := createTestUserForComment(t, db, "lurker", "lurker@example.com")
+		comments, total, err := repo.ListCommentsByAuthor(context.Background(), lurkerID, 20, 0)
+		if err != nil {
+			t.Fatalf("ListCommentsByAuthor() error = %v", err)
+		}
+		if total != 0 || len(comments) != 0 {
+			t.Errorf("ListCommentsByAuthor() = %d comments (total %d), want none", len(comments), total)
+		}
+	})
+
+	t.Run("skips comments on drafts and scheduled articles", func(t *testing.T) {
+		draftID := createTestArticle(t, db, "secret-draft", "Secret Draft", otherID)
+		scheduledID := createTestArticle(t, db, "secret-launch", "Secret Launch", otherID)
+		if _, err := db.Exec(`UPDATE articles SET published = 0 WHERE id = ?`, draftID); err != nil {
+			t.Fatalf("failed to unpublish article: %v", err)
+		}
+		if _, err := db.Exec(`UPDATE articles SET publish_at = ? WHERE id = ?`, time.Now().UTC().Add(time.Hour), scheduledID); err != nil {
+			t.Fatalf("failed to schedule article: %v", err)
+		}
+		for _, articleID := range []int64{draftID, scheduledID} {
+			if err := repo.CreateComment(context.Background(), &domain.Comment{Body: "Early", ArticleID: articleID, AuthorID: authorID}); err != nil {
+				t.Fatalf("failed to create test comment: %v", err)
+			}
+		}
+
+		comments, total, err := repo.ListCommentsByAuthor(context.Background(), authorID, 20, 0)
+		if err != nil {
+			t.Fatalf("ListCommentsByAuthor() error = %v", err)
+		}
+		if total != 2 || len(comments) != 2 {
+			t.Fatalf("ListCommentsByAuthor() = %d comments (total %d), want 2", len(comments), total)
+		}
+		for _, c := range comments {
+			if c.ArticleSlug == "secret-draft" || c.ArticleSlug == "secret-launch" {
+				t.Errorf("ListCommentsByAuthor() leaked hidden article %q", c.ArticleSlug)
+			}
+		}
+	})
+}
+
+func TestCommentRepository_CountByArticleIDs(t *testing.T) {
+	db, cleanup := setupTestCommentDB(t)
+	defer cleanup()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	repo := NewSQLiteCommentRepository(db, logger)
+
+	authorID := createTestUserForComment(t, db, "testuser", "test@example.com")
+	busyID := createTestArticle(t, db, "busy-article", "Busy Article", authorID)
+	quietID := createTestArticle(t, db, "quiet-article", "Quiet Article", authorID)
+	for i := 0; i < 3; i++ {
+		comment := &domain.Comment{Body: "Comment", ArticleID: busyID, AuthorID: authorID}
+		if err := repo.CreateComment(context.Background(), comment); err != nil {
+			t.Fatalf("failed to create test comment: %v", err)
+		}
+	}
+
+	counts, err := repo.CountByArticleIDs(context.Background(), []int64{busyID, quietID})
+	if err != nil {
+		t.Fatalf("CountByArticleIDs() error = %v", err)
+	}
+	if counts[busyID] != 3 {
+		t.Errorf("CountByArticleIDs()[busy] = %d, want 3", counts[busyID])
+	}
+	if counts[quietID] != 0 {
+		t.Errorf("CountByArticleIDs()[quiet] = %d, want 0", counts[quietID])
+	}
+
+	empty, err := repo.CountByArticleIDs(context.Background(), nil)
+	if err != nil || len(empty) != 0 {
+		t.Errorf("CountByArticleIDs(nil) = %v, %v, want empty map", empty, err)
+	}
+}
+
+func TestCommentRepository_GetLatestCommentTimeByAuthor(t *testing.T) {
+	db, cleanup := setupTestCommentDB(t)
+	defer cleanup()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	repo := NewSQLiteCommentRepository(db, logger)
+
+	authorID := createTestUserForComment(t, db, "commenter", "commenter@example.com")
+	articleID := createTestArticle(t, db, "test-article", "Test Article", authorID)
+
+	t.Run("returns zero time when author has no comments", func(t *testing.T) {
+		latest, err := repo.GetLatestCommentTimeByAuthor(context.Background(), authorID)
+		if err != nil {
+			t.Fatalf("GetLatestCommentTimeByAuthor() error = %v", err)
+		}
+		if !latest.IsZero() {
+			t.Errorf("GetLatestCommentTimeByAuthor() = %v, want zero time", latest)
+		}
+	})
+
+	t.Run("returns the most recent comment time", func(t *testing.T) {
+		older := time.Now().Add(-time.Hour).Truncate(time.Second)
+		if _, err := db.Exec(`
+			INSERT INTO comments (body, article_id, author_id, created_at, updated_at)
+			VALUES ('Older', ?, ?, ?, ?)
+		`, articleID, authorID, older, older); err != nil {
+			t.Fatalf("failed to insert comment: %v", err)
+		}
+
+		newest := &domain.Comment{Body: "Newest", ArticleID: articleID, AuthorID: authorID}
+		if err := repo.CreateComment(context.Background(), newest); err != nil {
+			t.Fatalf("failed to create comment: %v", err)
+		}
+
+		latest, err := repo.GetLatestCommentTimeByAuthor(context.Background(), authorID)
+		if err != nil {
+			t.Fatalf("GetLatestCommentTimeByAuthor() error = %v", err)
+		}
+		if !latest.After(older) {
+			t.Errorf("GetLatestCommentTimeByAuthor() = %v, want after %v", latest, older)
+		}
+	})
+}
+
+func TestCommentRepository_Reports(t *testing.T) {
+	db, cleanup := setupTestCommentDB(t)
+	defer cleanup()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	repo := NewSQLiteCommentRepository(db, logger)
+	ctx := context.Background()
+
+	authorID := createTestUserForComment(t, db, "author", "author@example.com")
+	reporterID := createTestUserForComment(t, db, "reporter", "reporter@example.com")
+	articleID := createTestArticle(t, db, "test-article", "Test Article", authorID)
+
+	comment := &domain.Comment{Body: "Offensive", ArticleID: articleID, AuthorID: authorID}
+	if err := repo.CreateComment(ctx, comment); err != nil {
+		t.Fatalf("failed to create test comment: %v", err)
+	}
+
+	first := &domain.CommentReport{CommentID: comment.ID, ReporterID: reporterID, Reason: "spam"}
+	if err := repo.CreateReport(ctx, first); err != nil {
+		t.Fatalf("CreateReport() error = %v", err)
+	}
+	if first.ID == 0 {
+		t.Error("CreateReport() did not set ID")
+	}
+
+	t.Run("duplicate report returns the existing row", func(t *testing.T) {
+		again := &domain.CommentReport{CommentID: comment.ID, ReporterID: reporterID, Reason: "changed my mind"}
+		if err := repo.CreateReport(ctx, again); err != nil {
+			t.Fatalf("CreateReport() error = %v", err)
+		}
+		if again.ID != first.ID {
+			t.Errorf("ID = %d, want existing %d", again.ID, first.ID)
+		}
+		if again.Reason != "spam" {
+			t.Errorf("Reason = %q, want original %q", again.Reason, "spam")
+		}
+	})
+
+	t.Run("list reports with related data", func(t *testing.T) {
+		reports, total, err := repo.ListReports(ctx, 20, 0)
+		if err != nil {
+			t.Fatalf("ListReports() error = %v", err)
+		}
+		if total != 1 || len(reports) != 1 {
+			t.Fatalf("ListReports() = %d reports (total %d), want 1", len(reports), total)
+		}
+		got := reports[0]
+		if got.CommentBody != "Offensive" || got.ArticleSlug != "test-article" || got.ReporterUsername != "reporter" {
+			t.Errorf("ListReports() related data = %q, %q, %q", got.CommentBody, got.ArticleSlug, got.ReporterUsername)
+		}
+	})
+}
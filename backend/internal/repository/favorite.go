@@ -22,6 +22,9 @@ type FavoriteRepository interface {
 	GetFavoritesCount(ctx context.Context, articleID int64) (int, error)
 	// IsFavoritedBulk checks favorite status for multiple articles at once
 	IsFavoritedBulk(ctx context.Context, userID int64, articleIDs []int64) (map[int64]bool, error)
+	// GetFavoritingUsers returns a page of the IDs of users who favorited an
+	// article, most recent first, and the total number of them
+	GetFavoritingUsers(ctx context.Context, articleID int64, limit, offset int) ([]int64, int, error)
 }
 
 // SQLiteFavoriteRepository implements FavoriteRepository for SQLite
@@ -38,25 +41,28 @@ func NewSQLiteFavoriteRepository(db *sql.DB, logger *slog.Logger) *SQLiteFavorit
 	}
 }
 
-// Favorite adds an article to user's favorites
+// Favorite adds an article to user's favorites and increments the article's
+// favorites count in the same transaction
 func (r *SQLiteFavoriteRepository) Favorite(ctx context.Context, userID, articleID int64) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error("failed to begin transaction", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+	defer tx.Rollback()
+
 	query := `
 		INSERT INTO favorites (user_id, article_id, created_at)
 		VALUES (?, ?, ?)
+		ON CONFLICT (user_id, article_id) DO NOTHING
 	`
 
 	now := time.Now()
-	_, err := r.db.ExecContext(ctx, query, userID, articleID, now)
+	result, err := tx.ExecContext(ctx, query, userID, articleID, now)
 	if err != nil {
-		// Check if already favorited (unique constraint violation)
-		if isUniqueConstraintError(err) {
-			// Already favorited is not an error, just a no-op
-			r.logger.Debug("article already favorited",
-				"user_id", userID,
-				"article_id", articleID,
-			)
-			return nil
-		}
 		r.logger.Error("failed to favorite article",
 			"error", err,
 			"user_id", userID,
@@ -65,6 +71,34 @@ func (r *SQLiteFavoriteRepository) Favorite(ctx context.Context, userID, article
 		return errors.Join(domain.ErrDatabase, err)
 	}
 
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	if inserted == 0 {
+		// Already favorited is not an error, just a no-op
+		r.logger.Debug("article already favorited",
+			"user_id", userID,
+			"article_id", articleID,
+		)
+		return nil
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE articles SET favorites_count = favorites_count + 1 WHERE id = ?
+	`, articleID)
+	if err != nil {
+		r.logger.Error("failed to increment favorites count", "error", err, "article_id", articleID)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.Error("failed to commit transaction", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
 	r.logger.Info("article favorited",
 		"user_id", userID,
 		"article_id", articleID,
@@ -73,14 +107,25 @@ func (r *SQLiteFavoriteRepository) Favorite(ctx context.Context, userID, article
 	return nil
 }
 
-// Unfavorite removes an article from user's favorites
+// Unfavorite removes an article from user's favorites and decrements the
+// article's favorites count in the same transaction
 func (r *SQLiteFavoriteRepository) Unfavorite(ctx context.Context, userID, articleID int64) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error("failed to begin transaction", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+	defer tx.Rollback()
+
 	query := `
 		DELETE FROM favorites
 		WHERE user_id = ? AND article_id = ?
 	`
 
-	result, err := r.db.ExecContext(ctx, query, userID, articleID)
+	result, err := tx.ExecContext(ctx, query, userID, articleID)
 	if err != nil {
 		r.logger.Error("failed to unfavorite article",
 			"error", err,
@@ -105,6 +150,19 @@ func (r *SQLiteFavoriteRepository) Unfavorite(ctx context.Context, userID, artic
 		return nil
 	}
 
+	_, err = tx.ExecContext(ctx, `
+		UPDATE articles SET favorites_count = MAX(favorites_count - 1, 0) WHERE id = ?
+	`, articleID)
+	if err != nil {
+		r.logger.Error("failed to decrement favorites count", "error", err, "article_id", articleID)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.Error("failed to commit transaction", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
 	r.logger.Info("article unfavorited",
 		"user_id", userID,
 		"article_id", articleID,
@@ -115,6 +173,9 @@ func (r *SQLiteFavoriteRepository) Unfavorite(ctx context.Context, userID, artic
 
 // IsFavorited checks if a user has favorited an article
 func (r *SQLiteFavoriteRepository) IsFavorited(ctx context.Context, userID, articleID int64) (bool, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	if userID == 0 || articleID == 0 {
 		return false, nil
 	}
@@ -142,6 +203,9 @@ func (r *SQLiteFavoriteRepository) IsFavorited(ctx context.Context, userID, arti
 
 // GetFavoritesCount returns the number of favorites for an article
 func (r *SQLiteFavoriteRepository) GetFavoritesCount(ctx context.Context, articleID int64) (int, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	query := `SELECT COUNT(*) FROM favorites WHERE article_id = ?`
 
 	var count int
@@ -160,6 +224,9 @@ func (r *SQLiteFavoriteRepository) GetFavoritesCount(ctx context.Context, articl
 // IsFavoritedBulk checks favorite status for multiple articles at once
 // Returns a map of articleID -> isFavorited
 func (r *SQLiteFavoriteRepository) IsFavoritedBulk(ctx context.Context, userID int64, articleIDs []int64) (map[int64]bool, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	result := make(map[int64]bool)
 
 	if userID == 0 || len(articleIDs) == 0 {
@@ -219,3 +286,55 @@ func (r *SQLiteFavoriteRepository) IsFavoritedBulk(ctx context.Context, userID i
 
 	return result, nil
 }
+
+// GetFavoritingUsers returns a page of the IDs of users who favorited an
+// article, most recent first, and the total number of them
+func (r *SQLiteFavoriteRepository) GetFavoritingUsers(ctx context.Context, articleID int64, limit, offset int) ([]int64, int, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var total int
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM favorites WHERE article_id = ?`, articleID).Scan(&total)
+	if err != nil {
+		r.logger.Error("failed to count favoriting users",
+			"error", err,
+			"article_id", articleID,
+		)
+		return nil, 0, errors.Join(domain.ErrDatabase, err)
+	}
+
+	query := `
+		SELECT user_id
+		FROM favorites
+		WHERE article_id = ?
+		ORDER BY created_at DESC, user_id DESC
+		LIMIT ? OFFSET ?
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, articleID, limit, offset)
+	if err != nil {
+		r.logger.Error("failed to get favoriting users",
+			"error", err,
+			"article_id", articleID,
+		)
+		return nil, 0, errors.Join(domain.ErrDatabase, err)
+	}
+	defer rows.Close()
+
+	userIDs := []int64{}
+	for rows.Next() {
+		var userID int64
+		if err := rows.Scan(&userID); err != nil {
+			r.logger.Error("failed to scan user id", "error", err)
+			return nil, 0, errors.Join(domain.ErrDatabase, err)
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error iterating favoriting users", "error", err)
+		return nil, 0, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return userIDs, total, nil
+}
@@ -41,6 +41,12 @@ func setupFavoriteTestDB(t *testing.T) *sql.DB {
 			title TEXT NOT NULL,
 			description TEXT NOT NULL,
 			body TEXT NOT NULL,
+			cover_image TEXT NOT NULL DEFAULT '',
+			published BOOLEAN NOT NULL DEFAULT 1,
+			comments_enabled BOOLEAN NOT NULL DEFAULT 1,
+			publish_at TIMESTAMP,
+			view_count INTEGER NOT NULL DEFAULT 0,
+			favorites_count INTEGER NOT NULL DEFAULT 0,
 			author_id INTEGER NOT NULL,
 			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
 			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
@@ -190,6 +196,46 @@ func TestUnfavorite(t *testing.T) {
 	})
 }
 
+func TestFavoriteMaintainsFavoritesCount(t *testing.T) {
+	db := setupFavoriteTestDB(t)
+	defer db.Close()
+
+	repo := NewSQLiteFavoriteRepository(db, newTestLogger())
+	ctx := context.Background()
+
+	userID := createFavoriteTestUser(t, db, "user@example.com", "user")
+	authorID := createFavoriteTestUser(t, db, "author@example.com", "author")
+	articleID := createFavoriteTestArticle(t, db, authorID, "test-article", "Test Article")
+
+	storedCount := func() int {
+		t.Helper()
+		var count int
+		if err := db.QueryRow(`SELECT favorites_count FROM articles WHERE id = ?`, articleID).Scan(&count); err != nil {
+			t.Fatalf("failed to read favorites_count: %v", err)
+		}
+		return count
+	}
+
+	steps := []struct {
+		name string
+		op   func() error
+		want int
+	}{
+		{"favorite", func() error { return repo.Favorite(ctx, userID, articleID) }, 1},
+		{"duplicate favorite", func() error { return repo.Favorite(ctx, userID, articleID) }, 1},
+		{"unfavorite", func() error { return repo.Unfavorite(ctx, userID, articleID) }, 0},
+		{"repeat unfavorite", func() error { return repo.Unfavorite(ctx, userID, articleID) }, 0},
+	}
+	for _, step := range steps {
+		if err := step.op(); err != nil {
+			t.Fatalf("%s: unexpected error: %v", step.name, err)
+		}
+		if got := storedCount(); got != step.want {
+			t.Errorf("%s: favorites_count = %d, want %d", step.name, got, step.want)
+		}
+	}
+}
+
 func TestIsFavorited(t *testing.T) {
 	db := setupFavoriteTestDB(t)
 	defer db.Close()
@@ -378,3 +424,67 @@ func TestIsFavoritedBulk(t *testing.T) {
 		}
 	})
 }
+
+func TestGetFavoritingUsers(t *testing.T) {
+	db := setupFavoriteTestDB(t)
+	defer db.Close()
+
+	repo := NewSQLiteFavoriteRepository(db, newTestLogger())
+	ctx := context.Background()
+
+	user1ID := createFavoriteTestUser(t, db, "user1@example.com", "user1")
+	user2ID := createFavoriteTestUser(t, db, "user2@example.com", "user2")
+	user3ID := createFavoriteTestUser(t, db, "user3@example.com", "user3")
+	authorID := createFavoriteTestUser(t, db, "author@example.com", "author")
+	articleID := createFavoriteTestArticle(t, db, authorID, "test-article", "Test Article")
+	otherArticleID := createFavoriteTestArticle(t, db, authorID, "other-article", "Other Article")
+
+	t.Run("returns empty list when no favorites", func(t *testing.T) {
+		userIDs, total, err := repo.GetFavoritingUsers(ctx, articleID, 20, 0)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if total != 0 || len(userIDs) != 0 {
+			t.Errorf("expected no users, got %v (total %d)", userIDs, total)
+		}
+	})
+
+	for _, userID := range []int64{user1ID, user2ID, user3ID} {
+		if err := repo.Favorite(ctx, userID, articleID); err != nil {
+			t.Fatalf("failed to favorite: %v", err)
+		}
+	}
+	if err := repo.Favorite(ctx, user1ID, otherArticleID); err != nil {
+		t.Fatalf("failed to favorite: %v", err)
+	}
+
+	t.Run("returns most recent first", func(t *testing.T) {
+		userIDs, total, err := repo.GetFavoritingUsers(ctx, articleID, 20, 0)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if total != 3 {
+			t.Errorf("expected total 3, got %d", total)
+		}
+		want := []int64{user3ID, user2ID, user1ID}
+		if len(userIDs) != len(want) {
+			t.Fatalf("expected %v, got %v", want, userIDs)
+		}
+		for i := range want {
+			if userIDs[i] != want[i] {
+				t.Errorf("expected %v, got %v", want, userIDs)
+				break
+			}
+		}
+	})
+
+	t.Run("paginates", func(t *testing.T) {
+		userIDs, total, err := repo.GetFavoritingUsers(ctx, articleID, 2, 2)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if total != 3 || len(userIDs) != 1 || userIDs[0] != user1ID {
+			t.Errorf("expected [%d] (total 3), got %v (total %d)", user1ID, userIDs, total)
+		}
+	})
+}
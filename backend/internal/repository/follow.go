@@ -24,6 +24,10 @@ type FollowRepository interface {
 	GetFollowing(ctx context.Context, userID int64) ([]int64, error)
 	// IsFollowingBulk checks follow status for multiple users at once
 	IsFollowingBulk(ctx context.Context, followerID int64, followingIDs []int64) (map[int64]bool, error)
+	// CountFollowers returns how many users follow the given userID
+	CountFollowers(ctx context.Context, userID int64) (int, error)
+	// CountFollowing returns how many users the given userID is following
+	CountFollowing(ctx context.Context, userID int64) (int, error)
 }
 
 // SQLiteFollowRepository implements FollowRepository for SQLite
@@ -42,6 +46,9 @@ func NewSQLiteFollowRepository(db *sql.DB, logger *slog.Logger) *SQLiteFollowRep
 
 // FollowUser creates a follow relationship (followerID follows followingID)
 func (r *SQLiteFollowRepository) FollowUser(ctx context.Context, followerID, followingID int64) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	// Prevent self-follow
 	if followerID == followingID {
 		return domain.ErrValidation
@@ -82,6 +89,9 @@ func (r *SQLiteFollowRepository) FollowUser(ctx context.Context, followerID, fol
 
 // UnfollowUser removes a follow relationship
 func (r *SQLiteFollowRepository) UnfollowUser(ctx context.Context, followerID, followingID int64) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	query := `
 		DELETE FROM follows
 		WHERE follower_id = ? AND following_id = ?
@@ -122,6 +132,9 @@ func (r *SQLiteFollowRepository) UnfollowUser(ctx context.Context, followerID, f
 
 // IsFollowing checks if followerID is following followingID
 func (r *SQLiteFollowRepository) IsFollowing(ctx context.Context, followerID, followingID int64) (bool, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	if followerID == 0 || followingID == 0 {
 		return false, nil
 	}
@@ -149,6 +162,9 @@ func (r *SQLiteFollowRepository) IsFollowing(ctx context.Context, followerID, fo
 
 // GetFollowers returns all user IDs who follow the given userID
 func (r *SQLiteFollowRepository) GetFollowers(ctx context.Context, userID int64) ([]int64, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	query := `
 		SELECT follower_id
 		FROM follows
@@ -186,6 +202,9 @@ func (r *SQLiteFollowRepository) GetFollowers(ctx context.Context, userID int64)
 
 // GetFollowing returns all user IDs that the given userID is following
 func (r *SQLiteFollowRepository) GetFollowing(ctx context.Context, userID int64) ([]int64, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	query := `
 		SELECT following_id
 		FROM follows
@@ -221,9 +240,42 @@ func (r *SQLiteFollowRepository) GetFollowing(ctx context.Context, userID int64)
 	return followingIDs, nil
 }
 
+// CountFollowers returns how many users follow the given userID
+func (r *SQLiteFollowRepository) CountFollowers(ctx context.Context, userID int64) (int, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var count int
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM follows WHERE following_id = ?`, userID).Scan(&count)
+	if err != nil {
+		r.logger.Error("failed to count followers", "error", err, "user_id", userID)
+		return 0, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return count, nil
+}
+
+// CountFollowing returns how many users the given userID is following
+func (r *SQLiteFollowRepository) CountFollowing(ctx context.Context, userID int64) (int, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var count int
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM follows WHERE follower_id = ?`, userID).Scan(&count)
+	if err != nil {
+		r.logger.Error("failed to count following", "error", err, "user_id", userID)
+		return 0, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return count, nil
+}
+
 // IsFollowingBulk checks follow status for multiple users at once
 // Returns a map of followingID -> isFollowing
 func (r *SQLiteFollowRepository) IsFollowingBulk(ctx context.Context, followerID int64, followingIDs []int64) (map[int64]bool, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	result := make(map[int64]bool)
 
 	if followerID == 0 || len(followingIDs) == 0 {
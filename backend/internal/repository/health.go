@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
+)
+
+// HealthRepository defines the interface for database health checks
+type HealthRepository interface {
+	Ping(ctx context.Context) error
+}
+
+// SQLiteHealthRepository implements HealthRepository for SQLite
+type SQLiteHealthRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+// NewSQLiteHealthRepository creates a new SQLite health repository
+func NewSQLiteHealthRepository(db *sql.DB, logger *slog.Logger) *SQLiteHealthRepository {
+	return &SQLiteHealthRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Ping runs a trivial query to confirm the database is reachable and answering
+func (r *SQLiteHealthRepository) Ping(ctx context.Context) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var one int
+	if err := r.db.QueryRowContext(ctx, `SELECT 1`).Scan(&one); err != nil {
+		r.logger.Error("database health check failed", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
+)
+
+func TestHealthRepository_Ping(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+		Level: slog.LevelError,
+	}))
+
+	t.Run("succeeds on a healthy database", func(t *testing.T) {
+		db, err := sql.Open("sqlite3", ":memory:")
+		if err != nil {
+			t.Fatalf("failed to open test database: %v", err)
+		}
+		defer db.Close()
+
+		repo := NewSQLiteHealthRepository(db, logger)
+		if err := repo.Ping(context.Background()); err != nil {
+			t.Errorf("Ping() error = %v, want nil", err)
+		}
+	})
+
+	t.Run("fails on a closed database", func(t *testing.T) {
+		db, err := sql.Open("sqlite3", ":memory:")
+		if err != nil {
+			t.Fatalf("failed to open test database: %v", err)
+		}
+		db.Close()
+
+		repo := NewSQLiteHealthRepository(db, logger)
+		err = repo.Ping(context.Background())
+		if !errors.Is(err, domain.ErrDatabase) {
+			t.Errorf("Ping() error = %v, want ErrDatabase", err)
+		}
+	})
+}
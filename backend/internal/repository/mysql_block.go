@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
+)
+
+// MySQLBlockRepository implements BlockRepository for MySQL
+type MySQLBlockRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+// NewMySQLBlockRepository creates a new MySQL block repository
+func NewMySQLBlockRepository(db *sql.DB, logger *slog.Logger) *MySQLBlockRepository {
+	return &MySQLBlockRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Block records that blockerID has blocked blockedID. Blocking the same user
+// twice is not an error.
+func (r *MySQLBlockRepository) Block(ctx context.Context, blockerID, blockedID int64) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO blocks (blocker_id, blocked_id, created_at) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE blocker_id = blocker_id`,
+		blockerID, blockedID, time.Now())
+	if err != nil {
+		r.logger.Error("failed to block user",
+			"error", err,
+			"blocker_id", blockerID,
+			"blocked_id", blockedID,
+		)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	return nil
+}
+
+// Unblock removes a block. Unblocking a user that isn't blocked is a no-op.
+func (r *MySQLBlockRepository) Unblock(ctx context.Context, blockerID, blockedID int64) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	_, err := r.db.ExecContext(ctx,
+		`DELETE FROM blocks WHERE blocker_id = ? AND blocked_id = ?`,
+		blockerID, blockedID)
+	if err != nil {
+		r.logger.Error("failed to unblock user",
+			"error", err,
+			"blocker_id", blockerID,
+			"blocked_id", blockedID,
+		)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	return nil
+}
+
+// IsBlocked reports whether blockerID has blocked blockedID
+func (r *MySQLBlockRepository) IsBlocked(ctx context.Context, blockerID, blockedID int64) (bool, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var exists bool
+	err := r.db.QueryRowContext(ctx,
+		`SELECT EXISTS(SELECT 1 FROM blocks WHERE blocker_id = ? AND blocked_id = ?)`,
+		blockerID, blockedID).Scan(&exists)
+	if err != nil {
+		r.logger.Error("failed to check block status", "error", err)
+		return false, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,517 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
+)
+
+// MySQLCommentRepository implements CommentRepository for MySQL
+type MySQLCommentRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+// NewMySQLCommentRepository creates a new MySQL comment repository
+func NewMySQLCommentRepository(db *sql.DB, logger *slog.Logger) *MySQLCommentRepository {
+	return &MySQLCommentRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// CreateComment inserts a new comment into the database
+func (r *MySQLCommentRepository) CreateComment(ctx context.Context, comment *domain.Comment) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	if comment.ParentID != nil {
+		if err := r.checkParent(ctx, comment); err != nil {
+			return err
+		}
+	}
+
+	query := `
+		INSERT INTO comments (body, article_id, author_id, parent_id, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+
+	now := time.Now()
+	comment.CreatedAt = now
+	comment.UpdatedAt = now
+
+	result, err := r.db.ExecContext(ctx, query,
+		comment.Body,
+		comment.ArticleID,
+		comment.AuthorID,
+		comment.ParentID,
+		comment.CreatedAt,
+		comment.UpdatedAt,
+	)
+
+	if err != nil {
+		r.logger.Error("failed to create comment",
+			"error", err,
+			"article_id", comment.ArticleID,
+			"author_id", comment.AuthorID,
+		)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		r.logger.Error("failed to get last insert id", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+	comment.ID = id
+
+	r.logger.Info("comment created",
+		"comment_id", comment.ID,
+		"article_id", comment.ArticleID,
+		"author_id", comment.AuthorID,
+	)
+
+	return nil
+}
+
+// checkParent verifies that a reply's parent comment exists on the same article
+func (r *MySQLCommentRepository) checkParent(ctx context.Context, comment *domain.Comment) error {
+	var parentArticleID int64
+	err := r.db.QueryRowContext(ctx, `SELECT article_id FROM comments WHERE id = ?`, *comment.ParentID).Scan(&parentArticleID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrInvalidParentComment
+		}
+		r.logger.Error("failed to get parent comment", "error", err, "parent_id", *comment.ParentID)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+	if parentArticleID != comment.ArticleID {
+		return domain.ErrInvalidParentComment
+	}
+	return nil
+}
+
+// GetCommentByID retrieves a comment by its ID
+func (r *MySQLCommentRepository) GetCommentByID(ctx context.Context, id int64) (*domain.Comment, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, body, article_id, author_id, parent_id, created_at, updated_at
+		FROM comments
+		WHERE id = ?
+	`
+
+	comment := &domain.Comment{}
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&comment.ID,
+		&comment.Body,
+		&comment.ArticleID,
+		&comment.AuthorID,
+		&comment.ParentID,
+		&comment.CreatedAt,
+		&comment.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrCommentNotFound
+		}
+		r.logger.Error("failed to get comment by id",
+			"error", err,
+			"comment_id", id,
+		)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return comment, nil
+}
+
+// GetCommentsByArticleID retrieves a page of an article's comments in the
+// given order, along with the total number of comments on the article
+// Comments created at the same instant are ordered by id
+func (r *MySQLCommentRepository) GetCommentsByArticleID(ctx context.Context, articleID int64, params *domain.CommentListParams) ([]*domain.Comment, int, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var total int
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM comments WHERE article_id = ?`, articleID).Scan(&total)
+	if err != nil {
+		r.logger.Error("failed to count comments by article id", "error", err, "article_id", articleID)
+		return nil, 0, errors.Join(domain.ErrDatabase, err)
+	}
+
+	order := "ASC"
+	if params.Sort == domain.CommentSortNewest {
+		order = "DESC"
+	}
+
+	query := `
+		SELECT id, body, article_id, author_id, parent_id, created_at, updated_at
+		FROM comments
+		WHERE article_id = ?
+		ORDER BY created_at ` + order + `, id ` + order + `
+		LIMIT ? OFFSET ?`
+
+	rows, err := r.db.QueryContext(ctx, query, articleID, params.Limit, params.Offset)
+	if err != nil {
+		r.logger.Error("failed to get comments by article id",
+			"error", err,
+			"article_id", articleID,
+		)
+		return nil, 0, errors.Join(domain.ErrDatabase, err)
+	}
+	defer rows.Close()
+
+	comments := []*domain.Comment{}
+	for rows.Next() {
+		comment := &domain.Comment{}
+		err := rows.Scan(
+			&comment.ID,
+			&comment.Body,
+			&comment.ArticleID,
+			&comment.AuthorID,
+			&comment.ParentID,
+			&comment.CreatedAt,
+			&comment.UpdatedAt,
+		)
+		if err != nil {
+			r.logger.Error("failed to scan comment", "error", err)
+			return nil, 0, errors.Join(domain.ErrDatabase, err)
+		}
+		comments = append(comments, comment)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error iterating comments", "error", err)
+		return nil, 0, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return comments, total, nil
+}
+
+// ListCommentsByAuthor retrieves a user's comments across visible articles, newest first,
+// along with the slug and title of the article each comment belongs to
+func (r *MySQLCommentRepository) ListCommentsByAuthor(ctx context.Context, authorID int64, limit, offset int) ([]*domain.Comment, int, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	// Comments on drafts and scheduled articles would reveal their titles
+	// and slugs, so only comments on published articles are listed
+	now := time.Now().UTC()
+	var total int
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(*)
+		FROM comments c
+		INNER JOIN articles a ON c.article_id = a.id
+		WHERE c.author_id = ? AND a.published = TRUE AND (a.publish_at IS NULL OR a.publish_at <= ?)
+	`, authorID, now).Scan(&total)
+	if err != nil {
+		r.logger.Error("failed to count comments by author", "error", err, "author_id", authorID)
+		return nil, 0, errors.Join(domain.ErrDatabase, err)
+	}
+
+	query := `
+		SELECT c.id, c.body, c.article_id, c.author_id, c.parent_id, c.created_at, c.updated_at, a.slug, a.title
+		FROM comments c
+		INNER JOIN articles a ON c.article_id = a.id
+		WHERE c.author_id = ? AND a.published = TRUE AND (a.publish_at IS NULL OR a.publish_at <= ?)
+		ORDER BY c.created_at DESC, c.id DESC
+		LIMIT ? OFFSET ?
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, authorID, now, limit, offset)
+	if err != nil {
+		r.logger.Error("failed to list comments by author",
+			"error", err,
+			"author_id", authorID,
+		)
+		return nil, 0, errors.Join(domain.ErrDatabase, err)
+	}
+	defer rows.Close()
+
+	comments := []*domain.Comment{}
+	for rows.Next() {
+		comment := &domain.Comment{}
+		err := rows.Scan(
+			&comment.ID,
+			&comment.Body,
+			&comment.ArticleID,
+			&comment.AuthorID,
+			&comment.ParentID,
+			&comment.CreatedAt,
+			&comment.UpdatedAt,
+			&comment.ArticleSlug,
+			&comment.ArticleTitle,
+		)
+		if err != nil {
+			r.logger.Error("failed to scan comment", "error", err)
+			return nil, 0, errors.Join(domain.ErrDatabase, err)
+		}
+		comments = append(comments, comment)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error iterating comments", "error", err)
+		return nil, 0, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return comments, total, nil
+}
+
+// CountByArticleIDs returns the number of comments on each of the given
+// articles in one query. Articles without comments map to 0.
+func (r *MySQLCommentRepository) CountByArticleIDs(ctx context.Context, articleIDs []int64) (map[int64]int, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	counts := make(map[int64]int, len(articleIDs))
+	if len(articleIDs) == 0 {
+		return counts, nil
+	}
+
+	args := make([]interface{}, len(articleIDs))
+	placeholders := make([]string, len(articleIDs))
+	for i, id := range articleIDs {
+		args[i] = id
+		placeholders[i] = "?"
+	}
+
+	query := `
+		SELECT article_id, COUNT(*)
+		FROM comments
+		WHERE article_id IN (` + strings.Join(placeholders, ", ") + `)
+		GROUP BY article_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.logger.Error("failed to count comments by article ids", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var articleID int64
+		var count int
+		if err := rows.Scan(&articleID, &count); err != nil {
+			r.logger.Error("failed to scan comment count", "error", err)
+			return nil, errors.Join(domain.ErrDatabase, err)
+		}
+		counts[articleID] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error iterating comment counts", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return counts, nil
+}
+
+// GetLatestCommentTimeByAuthor returns when the author last commented on any
+// article, or the zero time if they have never commented
+func (r *MySQLCommentRepository) GetLatestCommentTimeByAuthor(ctx context.Context, authorID int64) (time.Time, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var latest time.Time
+	err := r.db.QueryRowContext(ctx, `
+		SELECT created_at FROM comments
+		WHERE author_id = ?
+		ORDER BY created_at DESC
+		LIMIT 1
+	`, authorID).Scan(&latest)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return time.Time{}, nil
+		}
+		r.logger.Error("failed to get latest comment time", "error", err, "author_id", authorID)
+		return time.Time{}, errors.Join(domain.ErrDatabase, err)
+	}
+	return latest, nil
+}
+
+// UpdateComment saves a comment's new body and bumps its updated_at
+func (r *MySQLCommentRepository) UpdateComment(ctx context.Context, comment *domain.Comment) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	comment.UpdatedAt = time.Now()
+
+	result, err := r.db.ExecContext(ctx, `UPDATE comments SET body = ?, updated_at = ? WHERE id = ?`,
+		comment.Body, comment.UpdatedAt, comment.ID)
+	if err != nil {
+		r.logger.Error("failed to update comment", "error", err, "comment_id", comment.ID)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrCommentNotFound
+	}
+
+	r.logger.Info("comment updated", "comment_id", comment.ID)
+
+	return nil
+}
+
+// DeleteComment removes a comment from the database
+func (r *MySQLCommentRepository) DeleteComment(ctx context.Context, id int64) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	result, err := r.db.ExecContext(ctx, `DELETE FROM comments WHERE id = ?`, id)
+	if err != nil {
+		r.logger.Error("failed to delete comment", "error", err, "comment_id", id)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrCommentNotFound
+	}
+
+	r.logger.Info("comment deleted", "comment_id", id)
+
+	return nil
+}
+
+// CreateReport records a user's report on a comment. A user reporting the same
+// comment again is a no-op; report is filled in with the existing row.
+func (r *MySQLCommentRepository) CreateReport(ctx context.Context, report *domain.CommentReport) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO comment_reports (comment_id, reporter_id, reason, created_at)
+		VALUES (?, ?, ?, ?)
+	`
+
+	report.CreatedAt = time.Now()
+	result, err := r.db.ExecContext(ctx, query,
+		report.CommentID,
+		report.ReporterID,
+		report.Reason,
+		report.CreatedAt,
+	)
+	if err != nil {
+		// Reporting the same comment twice is not an error, just a no-op
+		if isMySQLUniqueConstraintError(err) {
+			r.logger.Debug("comment already reported",
+				"comment_id", report.CommentID,
+				"reporter_id", report.ReporterID,
+			)
+			return r.loadReport(ctx, report)
+		}
+		r.logger.Error("failed to create comment report",
+			"error", err,
+			"comment_id", report.CommentID,
+			"reporter_id", report.ReporterID,
+		)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		r.logger.Error("failed to get last insert id", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+	report.ID = id
+
+	r.logger.Info("comment reported",
+		"report_id", report.ID,
+		"comment_id", report.CommentID,
+		"reporter_id", report.ReporterID,
+	)
+
+	return nil
+}
+
+// loadReport fills in report with the stored row for its comment and reporter
+func (r *MySQLCommentRepository) loadReport(ctx context.Context, report *domain.CommentReport) error {
+	err := r.db.QueryRowContext(ctx, `
+		SELECT id, reason, created_at FROM comment_reports
+		WHERE comment_id = ? AND reporter_id = ?
+	`, report.CommentID, report.ReporterID).Scan(&report.ID, &report.Reason, &report.CreatedAt)
+	if err != nil {
+		r.logger.Error("failed to get comment report",
+			"error", err,
+			"comment_id", report.CommentID,
+			"reporter_id", report.ReporterID,
+		)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+	return nil
+}
+
+// ListReports retrieves a page of comment reports, newest first, along with the
+// reported comment's body and article slug and the reporter's username
+func (r *MySQLCommentRepository) ListReports(ctx context.Context, limit, offset int) ([]*domain.CommentReport, int, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM comment_reports`).Scan(&total); err != nil {
+		r.logger.Error("failed to count comment reports", "error", err)
+		return nil, 0, errors.Join(domain.ErrDatabase, err)
+	}
+
+	query := `
+		SELECT cr.id, cr.comment_id, cr.reporter_id, cr.reason, cr.created_at, c.body, a.slug, u.username
+		FROM comment_reports cr
+		INNER JOIN comments c ON cr.comment_id = c.id
+		INNER JOIN articles a ON c.article_id = a.id
+		INNER JOIN users u ON cr.reporter_id = u.id
+		ORDER BY cr.created_at DESC, cr.id DESC
+		LIMIT ? OFFSET ?
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+	if err != nil {
+		r.logger.Error("failed to list comment reports", "error", err)
+		return nil, 0, errors.Join(domain.ErrDatabase, err)
+	}
+	defer rows.Close()
+
+	reports := []*domain.CommentReport{}
+	for rows.Next() {
+		report := &domain.CommentReport{}
+		err := rows.Scan(
+			&report.ID,
+			&report.CommentID,
+			&report.ReporterID,
+			&report.Reason,
+			&report.CreatedAt,
+			&report.CommentBody,
+			&report.ArticleSlug,
+			&report.ReporterUsername,
+		)
+		if err != nil {
+			r.logger.Error("failed to scan comment report", "error", err)
+			return nil, 0, errors.Join(domain.ErrDatabase, err)
+		}
+		reports = append(reports, report)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error iterating comment reports", "error", err)
+		return nil, 0, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return reports, total, nil
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
+)
+
+// MySQLHealthRepository implements HealthRepository for MySQL
+type MySQLHealthRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+// NewMySQLHealthRepository creates a new MySQL health repository
+func NewMySQLHealthRepository(db *sql.DB, logger *slog.Logger) *MySQLHealthRepository {
+	return &MySQLHealthRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Ping runs a trivial query to confirm the database is reachable and answering
+func (r *MySQLHealthRepository) Ping(ctx context.Context) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var one int
+	if err := r.db.QueryRowContext(ctx, `SELECT 1`).Scan(&one); err != nil {
+		r.logger.Error("database health check failed", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+	return nil
+}
@@ -0,0 +1,383 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+
+	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
+)
+
+// MySQLUserRepository implements UserRepository for MySQL
+type MySQLUserRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+// NewMySQLUserRepository creates a new MySQL user repository
+func NewMySQLUserRepository(db *sql.DB, logger *slog.Logger) *MySQLUserRepository {
+	return &MySQLUserRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// CreateUser inserts a new user into the database
+func (r *MySQLUserRepository) CreateUser(ctx context.Context, user *domain.User) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO users (email, username, password_hash, bio, image, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+
+	now := time.Now()
+	user.CreatedAt = now
+	user.UpdatedAt = now
+
+	result, err := r.db.ExecContext(ctx, query,
+		user.Email,
+		user.Username,
+		user.PasswordHash,
+		user.Bio,
+		user.Image,
+		user.CreatedAt,
+		user.UpdatedAt,
+	)
+	if err != nil {
+		if isMySQLUniqueConstraintError(err) {
+			return userConflictError(mysqlUniqueColumn(err))
+		}
+		r.logger.Error("failed to create user",
+			"error", err,
+			"email", user.Email,
+			"username", user.Username,
+		)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		r.logger.Error("failed to get last insert id", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+	user.ID = id
+
+	r.logger.Info("user created",
+		"user_id", user.ID,
+		"username", user.Username,
+	)
+
+	return nil
+}
+
+// GetUserByID retrieves a user by their ID
+func (r *MySQLUserRepository) GetUserByID(ctx context.Context, id int64) (*domain.User, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, email, username, password_hash, bio, image, created_at, updated_at
+		FROM users
+		WHERE id = ?
+	`
+
+	user := &domain.User{}
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&user.ID,
+		&user.Email,
+		&user.Username,
+		&user.PasswordHash,
+		&user.Bio,
+		&user.Image,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrUserNotFound
+		}
+		r.logger.Error("failed to get user by id",
+			"error", err,
+			"user_id", id,
+		)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return user, nil
+}
+
+// GetUserByEmail retrieves a user by their email
+func (r *MySQLUserRepository) GetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, email, username, password_hash, bio, image, created_at, updated_at
+		FROM users
+		WHERE email = ?
+	`
+
+	user := &domain.User{}
+	err := r.db.QueryRowContext(ctx, query, email).Scan(
+		&user.ID,
+		&user.Email,
+		&user.Username,
+		&user.PasswordHash,
+		&user.Bio,
+		&user.Image,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrUserNotFound
+		}
+		r.logger.Error("failed to get user by email",
+			"error", err,
+			"email", email,
+		)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return user, nil
+}
+
+// GetUserByUsername retrieves a user by their username
+func (r *MySQLUserRepository) GetUserByUsername(ctx context.Context, username string) (*domain.User, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, email, username, password_hash, bio, image, created_at, updated_at
+		FROM users
+		WHERE username = ?
+	`
+
+	user := &domain.User{}
+	err := r.db.QueryRowContext(ctx, query, username).Scan(
+		&user.ID,
+		&user.Email,
+		&user.Username,
+		&user.PasswordHash,
+		&user.Bio,
+		&user.Image,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrUserNotFound
+		}
+		r.logger.Error("failed to get user by username",
+			"error", err,
+			"username", username,
+		)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return user, nil
+}
+
+// GetUserIDsByUsernames resolves usernames to user IDs in a single query
+// Usernames that don't exist are omitted from the result
+func (r *MySQLUserRepository) GetUserIDsByUsernames(ctx context.Context, usernames []string) (map[string]int64, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	ids := make(map[string]int64, len(usernames))
+	if len(usernames) == 0 {
+		return ids, nil
+	}
+
+	placeholders := make([]string, len(usernames))
+	args := make([]interface{}, len(usernames))
+	for i, username := range usernames {
+		placeholders[i] = "?"
+		args[i] = username
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, username FROM users WHERE username IN (`+strings.Join(placeholders, ", ")+`)
+	`, args...)
+	if err != nil {
+		r.logger.Error("failed to get user ids by usernames", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int64
+		var username string
+		if err := rows.Scan(&id, &username); err != nil {
+			r.logger.Error("failed to scan user id", "error", err)
+			return nil, errors.Join(domain.ErrDatabase, err)
+		}
+		ids[username] = id
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error iterating user ids", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return ids, nil
+}
+
+// GetProfilesByIDs loads the public profile fields (username, bio, image) for
+// several users in a single query. IDs that don't exist are omitted from the result
+func (r *MySQLUserRepository) GetProfilesByIDs(ctx context.Context, ids []int64) (map[int64]*domain.User, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	users := make(map[int64]*domain.User, len(ids))
+	if len(ids) == 0 {
+		return users, nil
+	}
+
+	placeholders := make([]string, len(ids))
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		placeholders[i] = "?"
+		args[i] = id
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, username, bio, image FROM users WHERE id IN (`+strings.Join(placeholders, ", ")+`)
+	`, args...)
+	if err != nil {
+		r.logger.Error("failed to get profiles by ids", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		user := &domain.User{}
+		if err := rows.Scan(&user.ID, &user.Username, &user.Bio, &user.Image); err != nil {
+			r.logger.Error("failed to scan profile", "error", err)
+			return nil, errors.Join(domain.ErrDatabase, err)
+		}
+		users[user.ID] = user
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error iterating profiles", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return users, nil
+}
+
+// UpdateUser updates an existing user in the database
+func (r *MySQLUserRepository) UpdateUser(ctx context.Context, user *domain.User) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE users
+		SET email = ?, username = ?, password_hash = ?, bio = ?, image = ?, updated_at = ?
+		WHERE id = ?
+	`
+
+	user.UpdatedAt = time.Now()
+
+	result, err := r.db.ExecContext(ctx, query,
+		user.Email,
+		user.Username,
+		user.PasswordHash,
+		user.Bio,
+		user.Image,
+		user.UpdatedAt,
+		user.ID,
+	)
+	if err != nil {
+		if isMySQLUniqueConstraintError(err) {
+			return userConflictError(mysqlUniqueColumn(err))
+		}
+		r.logger.Error("failed to update user",
+			"error", err,
+			"user_id", user.ID,
+		)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrUserNotFound
+	}
+
+	r.logger.Info("user updated",
+		"user_id", user.ID,
+		"username", user.Username,
+	)
+
+	return nil
+}
+
+// UpdatePasswordHash replaces a user's password hash without touching
+// updated_at, for rehashing that isn't a user-visible change
+func (r *MySQLUserRepository) UpdatePasswordHash(ctx context.Context, userID int64, passwordHash string) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE users SET password_hash = ? WHERE id = ?`,
+		passwordHash, userID)
+	if err != nil {
+		r.logger.Error("failed to update password hash", "error", err, "user_id", userID)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrUserNotFound
+	}
+
+	return nil
+}
+
+// mysqlDuplicateEntry is the MySQL error number for a unique key violation
+// (ER_DUP_ENTRY)
+const mysqlDuplicateEntry = 1062
+
+// mysqlUniqueColumn returns the column of a MySQL unique violation, taken
+// from the key named in the message ("Duplicate entry 'x' for key
+// 'users.email'"), or "" if it can't be determined. A column's UNIQUE key is
+// named after the column.
+func mysqlUniqueColumn(err error) string {
+	var myErr *mysql.MySQLError
+	if !errors.As(err, &myErr) || myErr.Number != mysqlDuplicateEntry {
+		return ""
+	}
+	_, key, ok := strings.Cut(myErr.Message, " for key '")
+	if !ok {
+		return ""
+	}
+	key = strings.TrimSuffix(key, "'")
+	if _, name, ok := strings.Cut(key, "."); ok {
+		key = name
+	}
+	return key
+}
+
+// isMySQLUniqueConstraintError checks if the error is a MySQL unique constraint violation
+func isMySQLUniqueConstraintError(err error) bool {
+	var myErr *mysql.MySQLError
+	return errors.As(err, &myErr) && myErr.Number == mysqlDuplicateEntry
+}
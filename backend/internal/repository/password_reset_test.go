@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
+)
+
+func TestPasswordResetRepository(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	_, err := db.Exec(`
+		CREATE TABLE password_resets (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			user_id INTEGER NOT NULL,
+			token_hash TEXT NOT NULL UNIQUE,
+			expires_at TIMESTAMP NOT NULL,
+			used_at TIMESTAMP,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+		)
+	`)
+	if err != nil {
+		t.Fatalf("failed to create password_resets table: %v", err)
+	}
+
+	logger := newTestLogger()
+	userRepo := NewSQLiteUserRepository(db, logger)
+	repo := NewSQLitePasswordResetRepository(db, logger)
+	ctx := context.Background()
+
+	user := &domain.User{Email: "reset@example.com", Username: "resetuser", PasswordHash: "hash"}
+	if err := userRepo.CreateUser(ctx, user); err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+
+	create := func(hash string, ttl time.Duration) {
+		reset := &domain.PasswordReset{UserID: user.ID, TokenHash: hash, ExpiresAt: time.Now().Add(ttl)}
+		if err := repo.Create(ctx, reset); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	t.Run("consume once", func(t *testing.T) {
+		create("hash-a", time.Hour)
+
+		reset, err := repo.Consume(ctx, "hash-a")
+		if err != nil {
+			t.Fatalf("Consume() error = %v", err)
+		}
+		if reset.UserID != user.ID || reset.UsedAt == nil {
+			t.Errorf("Consume() = %+v", reset)
+		}
+
+		if _, err := repo.Consume(ctx, "hash-a"); err != domain.ErrInvalidResetToken {
+			t.Errorf("second Consume() error = %v, want ErrInvalidResetToken", err)
+		}
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		create("hash-b", -time.Minute)
+
+		if _, err := repo.Consume(ctx, "hash-b"); err != domain.ErrInvalidResetToken {
+			t.Errorf("Consume() error = %v, want ErrInvalidResetToken", err)
+		}
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		if _, err := repo.Consume(ctx, "missing"); err != domain.ErrInvalidResetToken {
+			t.Errorf("Consume() error = %v, want ErrInvalidResetToken", err)
+		}
+	})
+
+	t.Run("prune expired", func(t *testing.T) {
+		create("hash-stale", -time.Minute)
+		create("hash-fresh", time.Hour)
+
+		deleted, err := repo.PruneExpired(ctx, time.Now())
+		if err != nil {
+			t.Fatalf("PruneExpired() error = %v", err)
+		}
+		// hash-b from the expired token case plus hash-stale
+		if deleted != 2 {
+			t.Errorf("PruneExpired() = %d, want 2", deleted)
+		}
+
+		var remaining []string
+		rows, err := db.Query(`SELECT token_hash FROM password_resets ORDER BY token_hash`)
+		if err != nil {
+			t.Fatalf("failed to list password resets: %v", err)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var hash string
+			rows.Scan(&hash)
+			remaining = append(remaining, hash)
+		}
+		if len(remaining) != 2 || remaining[0] != "hash-a" || remaining[1] != "hash-fresh" {
+			t.Errorf("remaining password resets = %v, want [hash-a hash-fresh]", remaining)
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
+)
+
+// PostgresBlockRepository implements BlockRepository for PostgreSQL
+type PostgresBlockRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+// NewPostgresBlockRepository creates a new PostgreSQL block repository
+func NewPostgresBlockRepository(db *sql.DB, logger *slog.Logger) *PostgresBlockRepository {
+	return &PostgresBlockRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Block records that blockerID has blocked blockedID. Blocking the same user
+// twice is not an error.
+func (r *PostgresBlockRepository) Block(ctx context.Context, blockerID, blockedID int64) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO blocks (blocker_id, blocked_id, created_at) VALUES ($1, $2, $3) ON CONFLICT (blocker_id, blocked_id) DO NOTHING`,
+		blockerID, blockedID, time.Now())
+	if err != nil {
+		r.logger.Error("failed to block user",
+			"error", err,
+			"blocker_id", blockerID,
+			"blocked_id", blockedID,
+		)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	return nil
+}
+
+// Unblock removes a block. Unblocking a user that isn't blocked is a no-op.
+func (r *PostgresBlockRepository) Unblock(ctx context.Context, blockerID, blockedID int64) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	_, err := r.db.ExecContext(ctx,
+		`DELETE FROM blocks WHERE blocker_id = $1 AND blocked_id = $2`,
+		blockerID, blockedID)
+	if err != nil {
+		r.logger.Error("failed to unblock user",
+			"error", err,
+			"blocker_id", blockerID,
+			"blocked_id", blockedID,
+		)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	return nil
+}
+
+// IsBlocked reports whether blockerID has blocked blockedID
+func (r *PostgresBlockRepository) IsBlocked(ctx context.Context, blockerID, blockedID int64) (bool, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var exists bool
+	err := r.db.QueryRowContext(ctx,
+		`SELECT EXISTS(SELECT 1 FROM blocks WHERE blocker_id = $1 AND blocked_id = $2)`,
+		blockerID, blockedID).Scan(&exists)
+	if err != nil {
+		r.logger.Error("failed to check block status", "error", err)
+		return false, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return exists, nil
+}
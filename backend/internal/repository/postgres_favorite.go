@@ -0,0 +1,322 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
+)
+
+// PostgresFavoriteRepository implements FavoriteRepository for PostgreSQL
+type PostgresFavoriteRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+// NewPostgresFavoriteRepository creates a new PostgreSQL favorite repository
+func NewPostgresFavoriteRepository(db *sql.DB, logger *slog.Logger) *PostgresFavoriteRepository {
+	return &PostgresFavoriteRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Favorite adds an article to user's favorites and increments the article's
+// favorites count in the same transaction
+func (r *PostgresFavoriteRepository) Favorite(ctx context.Context, userID, articleID int64) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error("failed to begin transaction", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO favorites (user_id, article_id, created_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, article_id) DO NOTHING
+	`
+
+	now := time.Now()
+	result, err := tx.ExecContext(ctx, query, userID, articleID, now)
+	if err != nil {
+		r.logger.Error("failed to favorite article",
+			"error", err,
+			"user_id", userID,
+			"article_id", articleID,
+		)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	if inserted == 0 {
+		// Already favorited is not an error, just a no-op
+		r.logger.Debug("article already favorited",
+			"user_id", userID,
+			"article_id", articleID,
+		)
+		return nil
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE articles SET favorites_count = favorites_count + 1 WHERE id = $1
+	`, articleID)
+	if err != nil {
+		r.logger.Error("failed to increment favorites count", "error", err, "article_id", articleID)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.Error("failed to commit transaction", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	r.logger.Info("article favorited",
+		"user_id", userID,
+		"article_id", articleID,
+	)
+
+	return nil
+}
+
+// Unfavorite removes an article from user's favorites and decrements the
+// article's favorites count in the same transaction
+func (r *PostgresFavoriteRepository) Unfavorite(ctx context.Context, userID, articleID int64) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error("failed to begin transaction", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		DELETE FROM favorites
+		WHERE user_id = $1 AND article_id = $2
+	`
+
+	result, err := tx.ExecContext(ctx, query, userID, articleID)
+	if err != nil {
+		r.logger.Error("failed to unfavorite article",
+			"error", err,
+			"user_id", userID,
+			"article_id", articleID,
+		)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	if rowsAffected == 0 {
+		// Not favorited is not an error, just a no-op
+		r.logger.Debug("article was not favorited",
+			"user_id", userID,
+			"article_id", articleID,
+		)
+		return nil
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE articles SET favorites_count = GREATEST(favorites_count - 1, 0) WHERE id = $1
+	`, articleID)
+	if err != nil {
+		r.logger.Error("failed to decrement favorites count", "error", err, "article_id", articleID)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.Error("failed to commit transaction", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	r.logger.Info("article unfavorited",
+		"user_id", userID,
+		"article_id", articleID,
+	)
+
+	return nil
+}
+
+// IsFavorited checks if a user has favorited an article
+func (r *PostgresFavoriteRepository) IsFavorited(ctx context.Context, userID, articleID int64) (bool, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	if userID == 0 || articleID == 0 {
+		return false, nil
+	}
+
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM favorites
+			WHERE user_id = $1 AND article_id = $2
+		)
+	`
+
+	var exists bool
+	err := r.db.QueryRowContext(ctx, query, userID, articleID).Scan(&exists)
+	if err != nil {
+		r.logger.Error("failed to check favorite status",
+			"error", err,
+			"user_id", userID,
+			"article_id", articleID,
+		)
+		return false, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return exists, nil
+}
+
+// GetFavoritesCount returns the number of favorites for an article
+func (r *PostgresFavoriteRepository) GetFavoritesCount(ctx context.Context, articleID int64) (int, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT COUNT(*) FROM favorites WHERE article_id = $1`
+
+	var count int
+	err := r.db.QueryRowContext(ctx, query, articleID).Scan(&count)
+	if err != nil {
+		r.logger.Error("failed to get favorites count",
+			"error", err,
+			"article_id", articleID,
+		)
+		return 0, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return count, nil
+}
+
+// IsFavoritedBulk checks favorite status for multiple articles at once
+// Returns a map of articleID -> isFavorited
+func (r *PostgresFavoriteRepository) IsFavoritedBulk(ctx context.Context, userID int64, articleIDs []int64) (map[int64]bool, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	result := make(map[int64]bool)
+
+	if userID == 0 || len(articleIDs) == 0 {
+		// Return all false for empty input
+		for _, id := range articleIDs {
+			result[id] = false
+		}
+		return result, nil
+	}
+
+	// Initialize all to false
+	for _, id := range articleIDs {
+		result[id] = false
+	}
+
+	// Build query with placeholders
+	placeholders := make([]interface{}, len(articleIDs)+1)
+	placeholders[0] = userID
+	dollarSigns := make([]string, len(articleIDs))
+	for i, id := range articleIDs {
+		placeholders[i+1] = id
+		dollarSigns[i] = fmt.Sprintf("$%d", i+2)
+	}
+
+	query := `
+		SELECT article_id
+		FROM favorites
+		WHERE user_id = $1 AND article_id IN (` + strings.Join(dollarSigns, ", ") + `)
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, placeholders...)
+	if err != nil {
+		r.logger.Error("failed to check bulk favorite status",
+			"error", err,
+			"user_id", userID,
+		)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var articleID int64
+		if err := rows.Scan(&articleID); err != nil {
+			r.logger.Error("failed to scan article id", "error", err)
+			return nil, errors.Join(domain.ErrDatabase, err)
+		}
+		result[articleID] = true
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error iterating bulk favorite status", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return result, nil
+}
+
+// GetFavoritingUsers returns a page of the IDs of users who favorited an
+// article, most recent first, and the total number of them
+func (r *PostgresFavoriteRepository) GetFavoritingUsers(ctx context.Context, articleID int64, limit, offset int) ([]int64, int, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var total int
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM favorites WHERE article_id = $1`, articleID).Scan(&total)
+	if err != nil {
+		r.logger.Error("failed to count favoriting users",
+			"error", err,
+			"article_id", articleID,
+		)
+		return nil, 0, errors.Join(domain.ErrDatabase, err)
+	}
+
+	query := `
+		SELECT user_id
+		FROM favorites
+		WHERE article_id = $1
+		ORDER BY created_at DESC, user_id DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, articleID, limit, offset)
+	if err != nil {
+		r.logger.Error("failed to get favoriting users",
+			"error", err,
+			"article_id", articleID,
+		)
+		return nil, 0, errors.Join(domain.ErrDatabase, err)
+	}
+	defer rows.Close()
+
+	userIDs := []int64{}
+	for rows.Next() {
+		var userID int64
+		if err := rows.Scan(&userID); err != nil {
+			r.logger.Error("failed to scan user id", "error", err)
+			return nil, 0, errors.Join(domain.ErrDatabase, err)
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error iterating favoriting users", "error", err)
+		return nil, 0, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return userIDs, total, nil
+}
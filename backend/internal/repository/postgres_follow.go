@@ -28,6 +28,9 @@ func NewPostgresFollowRepository(db *sql.DB, logger *slog.Logger) *PostgresFollo
 
 // FollowUser creates a follow relationship (followerID follows followingID)
 func (r *PostgresFollowRepository) FollowUser(ctx context.Context, followerID, followingID int64) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	// Prevent self-follow
 	if followerID == followingID {
 		return domain.ErrValidation
@@ -60,6 +63,9 @@ func (r *PostgresFollowRepository) FollowUser(ctx context.Context, followerID, f
 
 // UnfollowUser removes a follow relationship
 func (r *PostgresFollowRepository) UnfollowUser(ctx context.Context, followerID, followingID int64) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	query := `
 		DELETE FROM follows
 		WHERE follower_id = $1 AND following_id = $2
@@ -100,6 +106,9 @@ func (r *PostgresFollowRepository) UnfollowUser(ctx context.Context, followerID,
 
 // IsFollowing checks if followerID is following followingID
 func (r *PostgresFollowRepository) IsFollowing(ctx context.Context, followerID, followingID int64) (bool, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	if followerID == 0 || followingID == 0 {
 		return false, nil
 	}
@@ -127,6 +136,9 @@ func (r *PostgresFollowRepository) IsFollowing(ctx context.Context, followerID,
 
 // GetFollowers returns all user IDs who follow the given userID
 func (r *PostgresFollowRepository) GetFollowers(ctx context.Context, userID int64) ([]int64, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	query := `
 		SELECT follower_id
 		FROM follows
@@ -164,6 +176,9 @@ func (r *PostgresFollowRepository) GetFollowers(ctx context.Context, userID int6
 
 // GetFollowing returns all user IDs that the given userID is following
 func (r *PostgresFollowRepository) GetFollowing(ctx context.Context, userID int64) ([]int64, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	query := `
 		SELECT following_id
 		FROM follows
@@ -199,9 +214,42 @@ func (r *PostgresFollowRepository) GetFollowing(ctx context.Context, userID int6
 	return followingIDs, nil
 }
 
+// CountFollowers returns how many users follow the given userID
+func (r *PostgresFollowRepository) CountFollowers(ctx context.Context, userID int64) (int, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var count int
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM follows WHERE following_id = $1`, userID).Scan(&count)
+	if err != nil {
+		r.logger.Error("failed to count followers", "error", err, "user_id", userID)
+		return 0, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return count, nil
+}
+
+// CountFollowing returns how many users the given userID is following
+func (r *PostgresFollowRepository) CountFollowing(ctx context.Context, userID int64) (int, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var count int
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM follows WHERE follower_id = $1`, userID).Scan(&count)
+	if err != nil {
+		r.logger.Error("failed to count following", "error", err, "user_id", userID)
+		return 0, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return count, nil
+}
+
 // IsFollowingBulk checks follow status for multiple users at once
 // Returns a map of followingID -> isFollowing
 func (r *PostgresFollowRepository) IsFollowingBulk(ctx context.Context, followerID int64, followingIDs []int64) (map[int64]bool, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	result := make(map[int64]bool)
 
 	if followerID == 0 || len(followingIDs) == 0 {
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
+)
+
+// PostgresHealthRepository implements HealthRepository for PostgreSQL
+type PostgresHealthRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+// NewPostgresHealthRepository creates a new PostgreSQL health repository
+func NewPostgresHealthRepository(db *sql.DB, logger *slog.Logger) *PostgresHealthRepository {
+	return &PostgresHealthRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Ping runs a trivial query to confirm the database is reachable and answering
+func (r *PostgresHealthRepository) Ping(ctx context.Context) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var one int
+	if err := r.db.QueryRowContext(ctx, `SELECT 1`).Scan(&one); err != nil {
+		r.logger.Error("database health check failed", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
+)
+
+// PostgresPasswordResetRepository implements PasswordResetRepository for PostgreSQL
+type PostgresPasswordResetRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+// NewPostgresPasswordResetRepository creates a new PostgreSQL password reset repository
+func NewPostgresPasswordResetRepository(db *sql.DB, logger *slog.Logger) *PostgresPasswordResetRepository {
+	return &PostgresPasswordResetRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create stores a new password reset token hash
+func (r *PostgresPasswordResetRepository) Create(ctx context.Context, reset *domain.PasswordReset) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO password_resets (user_id, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`
+
+	reset.CreatedAt = time.Now()
+	err := r.db.QueryRowContext(ctx, query,
+		reset.UserID,
+		reset.TokenHash,
+		reset.ExpiresAt,
+		reset.CreatedAt,
+	).Scan(&reset.ID)
+	if err != nil {
+		r.logger.Error("failed to create password reset", "error", err, "user_id", reset.UserID)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	r.logger.Info("password reset created", "reset_id", reset.ID, "user_id", reset.UserID)
+
+	return nil
+}
+
+// Consume marks a password reset token as used and returns it. Unknown,
+// already used and expired tokens return ErrInvalidResetToken. The update is
+// conditional on the token being unused, so a token can only be consumed once
+// even under concurrent requests.
+func (r *PostgresPasswordResetRepository) Consume(ctx context.Context, tokenHash string) (*domain.PasswordReset, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, user_id, token_hash, expires_at, used_at, created_at
+		FROM password_resets
+		WHERE token_hash = $1
+	`
+
+	reset := &domain.PasswordReset{}
+	err := r.db.QueryRowContext(ctx, query, tokenHash).Scan(
+		&reset.ID,
+		&reset.UserID,
+		&reset.TokenHash,
+		&reset.ExpiresAt,
+		&reset.UsedAt,
+		&reset.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrInvalidResetToken
+		}
+		r.logger.Error("failed to get password reset", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+
+	now := time.Now()
+	if reset.UsedAt != nil || !now.Before(reset.ExpiresAt) {
+		return nil, domain.ErrInvalidResetToken
+	}
+
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE password_resets SET used_at = $1 WHERE id = $2 AND used_at IS NULL`,
+		now, reset.ID)
+	if err != nil {
+		r.logger.Error("failed to consume password reset", "error", err, "reset_id", reset.ID)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+
+	if rowsAffected == 0 {
+		return nil, domain.ErrInvalidResetToken
+	}
+
+	reset.UsedAt = &now
+	return reset, nil
+}
+
+// PruneExpired deletes password reset tokens that expired before now and returns how many
+// were deleted. Expired entries are rejected on use, so nothing needs them.
+func (r *PostgresPasswordResetRepository) PruneExpired(ctx context.Context, now time.Time) (int64, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	result, err := r.db.ExecContext(ctx, `DELETE FROM password_resets WHERE expires_at < $1`, now)
+	if err != nil {
+		r.logger.Error("failed to prune expired password reset tokens", "error", err)
+		return 0, errors.Join(domain.ErrDatabase, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", "error", err)
+		return 0, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return rowsAffected, nil
+}
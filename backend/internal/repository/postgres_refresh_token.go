@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
+)
+
+// PostgresRefreshTokenRepository implements RefreshTokenRepository for PostgreSQL
+type PostgresRefreshTokenRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+// NewPostgresRefreshTokenRepository creates a new PostgreSQL refresh token repository
+func NewPostgresRefreshTokenRepository(db *sql.DB, logger *slog.Logger) *PostgresRefreshTokenRepository {
+	return &PostgresRefreshTokenRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// CreateRefreshToken stores a new refresh token hash
+func (r *PostgresRefreshTokenRepository) CreateRefreshToken(ctx context.Context, token *domain.RefreshToken) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO refresh_tokens (user_id, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`
+
+	token.CreatedAt = time.Now()
+	err := r.db.QueryRowContext(ctx, query,
+		token.UserID,
+		token.TokenHash,
+		token.ExpiresAt,
+		token.CreatedAt,
+	).Scan(&token.ID)
+	if err != nil {
+		r.logger.Error("failed to create refresh token", "error", err, "user_id", token.UserID)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	return nil
+}
+
+// GetRefreshTokenByHash retrieves a refresh token by the hash of its value,
+// including revoked and expired tokens
+func (r *PostgresRefreshTokenRepository) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, user_id, token_hash, expires_at, revoked_at, created_at
+		FROM refresh_tokens
+		WHERE token_hash = $1
+	`
+
+	token := &domain.RefreshToken{}
+	err := r.db.QueryRowContext(ctx, query, tokenHash).Scan(
+		&token.ID,
+		&token.UserID,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&token.RevokedAt,
+		&token.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrInvalidRefreshToken
+		}
+		r.logger.Error("failed to get refresh token", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return token, nil
+}
+
+// RevokeRefreshToken marks a token as used. It returns ErrInvalidRefreshToken
+// if the token was already revoked, so two concurrent refreshes with the same
+// token cannot both succeed.
+func (r *PostgresRefreshTokenRepository) RevokeRefreshToken(ctx context.Context, id int64) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE refresh_tokens SET revoked_at = $1 WHERE id = $2 AND revoked_at IS NULL`,
+		time.Now(), id)
+	if err != nil {
+		r.logger.Error("failed to revoke refresh token", "error", err, "token_id", id)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrInvalidRefreshToken
+	}
+
+	return nil
+}
+
+// RevokeUserRefreshTokens revokes every active refresh token of a user
+func (r *PostgresRefreshTokenRepository) RevokeUserRefreshTokens(ctx context.Context, userID int64) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	_, err := r.db.ExecContext(ctx,
+		`UPDATE refresh_tokens SET revoked_at = $1 WHERE user_id = $2 AND revoked_at IS NULL`,
+		time.Now(), userID)
+	if err != nil {
+		r.logger.Error("failed to revoke user refresh tokens", "error", err, "user_id", userID)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	r.logger.Info("user refresh tokens revoked", "user_id", userID)
+
+	return nil
+}
+
+// PruneExpired deletes refresh tokens that expired before now and returns how many
+// were deleted. Expired entries are rejected on use, so nothing needs them.
+func (r *PostgresRefreshTokenRepository) PruneExpired(ctx context.Context, now time.Time) (int64, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	result, err := r.db.ExecContext(ctx, `DELETE FROM refresh_tokens WHERE expires_at < $1`, now)
+	if err != nil {
+		r.logger.Error("failed to prune expired refresh tokens", "error", err)
+		return 0, errors.Join(domain.ErrDatabase, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", "error", err)
+		return 0, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return rowsAffected, nil
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
+)
+
+// PostgresRevokedTokenRepository implements RevokedTokenRepository for PostgreSQL
+type PostgresRevokedTokenRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+// NewPostgresRevokedTokenRepository creates a new PostgreSQL revoked token repository
+func NewPostgresRevokedTokenRepository(db *sql.DB, logger *slog.Logger) *PostgresRevokedTokenRepository {
+	return &PostgresRevokedTokenRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// RevokeToken adds a token ID to the blocklist. Revoking the same ID twice is
+// not an error.
+func (r *PostgresRevokedTokenRepository) RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO revoked_tokens (jti, expires_at, created_at) VALUES ($1, $2, $3) ON CONFLICT (jti) DO NOTHING`,
+		jti, expiresAt, time.Now())
+	if err != nil {
+		r.logger.Error("failed to revoke token", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	return nil
+}
+
+// IsTokenRevoked reports whether a token ID is on the blocklist
+func (r *PostgresRevokedTokenRepository) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var exists bool
+	err := r.db.QueryRowContext(ctx,
+		`SELECT EXISTS(SELECT 1 FROM revoked_tokens WHERE jti = $1)`,
+		jti).Scan(&exists)
+	if err != nil {
+		r.logger.Error("failed to check revoked token", "error", err)
+		return false, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return exists, nil
+}
+
+// DeleteExpiredRevokedTokens removes entries for tokens that expired before
+// now and returns how many were deleted
+func (r *PostgresRevokedTokenRepository) DeleteExpiredRevokedTokens(ctx context.Context, now time.Time) (int64, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	result, err := r.db.ExecContext(ctx, `DELETE FROM revoked_tokens WHERE expires_at < $1`, now)
+	if err != nil {
+		r.logger.Error("failed to delete expired revoked tokens", "error", err)
+		return 0, errors.Join(domain.ErrDatabase, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", "error", err)
+		return 0, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return rowsAffected, nil
+}
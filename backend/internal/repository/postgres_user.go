@@ -5,9 +5,12 @@ import (
 	"database/sql"
 	"errors"
 	"log/slog"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgconn"
+
 	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
 )
 
@@ -27,6 +30,9 @@ func NewPostgresUserRepository(db *sql.DB, logger *slog.Logger) *PostgresUserRep
 
 // CreateUser inserts a new user into the database
 func (r *PostgresUserRepository) CreateUser(ctx context.Context, user *domain.User) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	query := `
 		INSERT INTO users (email, username, password_hash, bio, image, created_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5, $6, $7)
@@ -49,13 +55,7 @@ func (r *PostgresUserRepository) CreateUser(ctx context.Context, user *domain.Us
 
 	if err != nil {
 		if isPostgresUniqueConstraintError(err) {
-			if strings.Contains(err.Error(), "email") {
-				return domain.ErrEmailAlreadyTaken
-			}
-			if strings.Contains(err.Error(), "username") {
-				return domain.ErrUsernameAlreadyTaken
-			}
-			return domain.ErrUserAlreadyExists
+			return userConflictError(postgresUniqueColumn(err))
 		}
 		r.logger.Error("failed to create user",
 			"error", err,
@@ -75,6 +75,9 @@ func (r *PostgresUserRepository) CreateUser(ctx context.Context, user *domain.Us
 
 // GetUserByID retrieves a user by their ID
 func (r *PostgresUserRepository) GetUserByID(ctx context.Context, id int64) (*domain.User, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	query := `
 		SELECT id, email, username, password_hash, bio, image, created_at, updated_at
 		FROM users
@@ -108,6 +111,9 @@ func (r *PostgresUserRepository) GetUserByID(ctx context.Context, id int64) (*do
 
 // GetUserByEmail retrieves a user by their email
 func (r *PostgresUserRepository) GetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	query := `
 		SELECT id, email, username, password_hash, bio, image, created_at, updated_at
 		FROM users
@@ -141,6 +147,9 @@ func (r *PostgresUserRepository) GetUserByEmail(ctx context.Context, email strin
 
 // GetUserByUsername retrieves a user by their username
 func (r *PostgresUserRepository) GetUserByUsername(ctx context.Context, username string) (*domain.User, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	query := `
 		SELECT id, email, username, password_hash, bio, image, created_at, updated_at
 		FROM users
@@ -172,8 +181,100 @@ func (r *PostgresUserRepository) GetUserByUsername(ctx context.Context, username
 	return user, nil
 }
 
+// GetUserIDsByUsernames resolves usernames to user IDs in a single query
+// Usernames that don't exist are omitted from the result
+func (r *PostgresUserRepository) GetUserIDsByUsernames(ctx context.Context, usernames []string) (map[string]int64, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	ids := make(map[string]int64, len(usernames))
+	if len(usernames) == 0 {
+		return ids, nil
+	}
+
+	placeholders := make([]string, len(usernames))
+	args := make([]interface{}, len(usernames))
+	for i, username := range usernames {
+		placeholders[i] = "$" + strconv.Itoa(i+1)
+		args[i] = username
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, username FROM users WHERE username IN (`+strings.Join(placeholders, ", ")+`)
+	`, args...)
+	if err != nil {
+		r.logger.Error("failed to get user ids by usernames", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int64
+		var username string
+		if err := rows.Scan(&id, &username); err != nil {
+			r.logger.Error("failed to scan user id", "error", err)
+			return nil, errors.Join(domain.ErrDatabase, err)
+		}
+		ids[username] = id
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error iterating user ids", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return ids, nil
+}
+
+// GetProfilesByIDs loads the public profile fields (username, bio, image) for
+// several users in a single query. IDs that don't exist are omitted from the result
+func (r *PostgresUserRepository) GetProfilesByIDs(ctx context.Context, ids []int64) (map[int64]*domain.User, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	users := make(map[int64]*domain.User, len(ids))
+	if len(ids) == 0 {
+		return users, nil
+	}
+
+	placeholders := make([]string, len(ids))
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		placeholders[i] = "$" + strconv.Itoa(i+1)
+		args[i] = id
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, username, bio, image FROM users WHERE id IN (`+strings.Join(placeholders, ", ")+`)
+	`, args...)
+	if err != nil {
+		r.logger.Error("failed to get profiles by ids", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		user := &domain.User{}
+		if err := rows.Scan(&user.ID, &user.Username, &user.Bio, &user.Image); err != nil {
+			r.logger.Error("failed to scan profile", "error", err)
+			return nil, errors.Join(domain.ErrDatabase, err)
+		}
+		users[user.ID] = user
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error iterating profiles", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return users, nil
+}
+
 // UpdateUser updates an existing user in the database
 func (r *PostgresUserRepository) UpdateUser(ctx context.Context, user *domain.User) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	query := `
 		UPDATE users
 		SET email = $1, username = $2, password_hash = $3, bio = $4, image = $5, updated_at = $6
@@ -193,13 +294,7 @@ func (r *PostgresUserRepository) UpdateUser(ctx context.Context, user *domain.Us
 	)
 	if err != nil {
 		if isPostgresUniqueConstraintError(err) {
-			if strings.Contains(err.Error(), "email") {
-				return domain.ErrEmailAlreadyTaken
-			}
-			if strings.Contains(err.Error(), "username") {
-				return domain.ErrUsernameAlreadyTaken
-			}
-			return domain.ErrUserAlreadyExists
+			return userConflictError(postgresUniqueColumn(err))
 		}
 		r.logger.Error("failed to update user",
 			"error", err,
@@ -226,6 +321,45 @@ func (r *PostgresUserRepository) UpdateUser(ctx context.Context, user *domain.Us
 	return nil
 }
 
+// UpdatePasswordHash replaces a user's password hash without touching
+// updated_at, for rehashing that isn't a user-visible change
+func (r *PostgresUserRepository) UpdatePasswordHash(ctx context.Context, userID int64, passwordHash string) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE users SET password_hash = $1 WHERE id = $2`,
+		passwordHash, userID)
+	if err != nil {
+		r.logger.Error("failed to update password hash", "error", err, "user_id", userID)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrUserNotFound
+	}
+
+	return nil
+}
+
+// postgresUniqueColumn returns the column of a PostgreSQL unique violation,
+// derived from the default constraint name ("users_email_key"), or "" if it
+// can't be determined
+func postgresUniqueColumn(err error) string {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return ""
+	}
+	column := strings.TrimPrefix(pgErr.ConstraintName, pgErr.TableName+"_")
+	return strings.TrimSuffix(column, "_key")
+}
+
 // isPostgresUniqueConstraintError checks if the error is a PostgreSQL unique constraint violation
 func isPostgresUniqueConstraintError(err error) bool {
 	if err == nil {
@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
+)
+
+func TestRefreshTokenRepository(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	_, err := db.Exec(`
+		CREATE TABLE refresh_tokens (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			user_id INTEGER NOT NULL,
+			token_hash TEXT NOT NULL UNIQUE,
+			expires_at TIMESTAMP NOT NULL,
+			revoked_at TIMESTAMP,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+		)
+	`)
+	if err != nil {
+		t.Fatalf("failed to create refresh_tokens table: %v", err)
+	}
+
+	logger := newTestLogger()
+	userRepo := NewSQLiteUserRepository(db, logger)
+	repo := NewSQLiteRefreshTokenRepository(db, logger)
+	ctx := context.Background()
+
+	user := &domain.User{Email: "token@example.com", Username: "tokenuser", PasswordHash: "hash"}
+	if err := userRepo.CreateUser(ctx, user); err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+
+	newToken := func(hash string) *domain.RefreshToken {
+		token := &domain.RefreshToken{UserID: user.ID, TokenHash: hash, ExpiresAt: time.Now().Add(time.Hour)}
+		if err := repo.CreateRefreshToken(ctx, token); err != nil {
+			t.Fatalf("CreateRefreshToken() error = %v", err)
+		}
+		return token
+	}
+
+	t.Run("get by hash", func(t *testing.T) {
+		token := newToken("hash-a")
+
+		got, err := repo.GetRefreshTokenByHash(ctx, "hash-a")
+		if err != nil {
+			t.Fatalf("GetRefreshTokenByHash() error = %v", err)
+		}
+		if got.ID != token.ID || got.UserID != user.ID || got.RevokedAt != nil {
+			t.Errorf("GetRefreshTokenByHash() = %+v", got)
+		}
+
+		if _, err := repo.GetRefreshTokenByHash(ctx, "missing"); err != domain.ErrInvalidRefreshToken {
+			t.Errorf("GetRefreshTokenByHash() error = %v, want ErrInvalidRefreshToken", err)
+		}
+	})
+
+	t.Run("revoke only once", func(t *testing.T) {
+		token := newToken("hash-b")
+
+		if err := repo.RevokeRefreshToken(ctx, token.ID); err != nil {
+			t.Fatalf("RevokeRefreshToken() error = %v", err)
+		}
+		if err := repo.RevokeRefreshToken(ctx, token.ID); err != domain.ErrInvalidRefreshToken {
+			t.Errorf("second RevokeRefreshToken() error = %v, want ErrInvalidRefreshToken", err)
+		}
+
+		got, _ := repo.GetRefreshTokenByHash(ctx, "hash-b")
+		if got.RevokedAt == nil {
+			t.Error("expected RevokedAt to be set")
+		}
+	})
+
+	t.Run("revoke all for user", func(t *testing.T) {
+		newToken("hash-c")
+
+		if err := repo.RevokeUserRefreshTokens(ctx, user.ID); err != nil {
+			t.Fatalf("RevokeUserRefreshTokens() error = %v", err)
+		}
+
+		got, _ := repo.GetRefreshTokenByHash(ctx, "hash-c")
+		if got.RevokedAt == nil {
+			t.Error("expected RevokedAt to be set")
+		}
+	})
+
+	t.Run("prune expired", func(t *testing.T) {
+		expired := &domain.RefreshToken{UserID: user.ID, TokenHash: "hash-expired", ExpiresAt: time.Now().Add(-time.Minute)}
+		if err := repo.CreateRefreshToken(ctx, expired); err != nil {
+			t.Fatalf("CreateRefreshToken() error = %v", err)
+		}
+		newToken("hash-live")
+
+		deleted, err := repo.PruneExpired(ctx, time.Now())
+		if err != nil {
+			t.Fatalf("PruneExpired() error = %v", err)
+		}
+		if deleted != 1 {
+			t.Errorf("PruneExpired() = %d, want 1", deleted)
+		}
+
+		if _, err := repo.GetRefreshTokenByHash(ctx, "hash-expired"); err != domain.ErrInvalidRefreshToken {
+			t.Errorf("expected expired token to be deleted, got %v", err)
+		}
+		if _, err := repo.GetRefreshTokenByHash(ctx, "hash-live"); err != nil {
+			t.Errorf("expected unexpired token to be kept, got %v", err)
+		}
+	})
+}
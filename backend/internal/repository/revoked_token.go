@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
+)
+
+// RevokedTokenRepository defines the interface for the access token blocklist
+type RevokedTokenRepository interface {
+	RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error
+	IsTokenRevoked(ctx context.Context, jti string) (bool, error)
+	DeleteExpiredRevokedTokens(ctx context.Context, now time.Time) (int64, error)
+}
+
+// SQLiteRevokedTokenRepository implements RevokedTokenRepository for SQLite
+type SQLiteRevokedTokenRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+// NewSQLiteRevokedTokenRepository creates a new SQLite revoked token repository
+func NewSQLiteRevokedTokenRepository(db *sql.DB, logger *slog.Logger) *SQLiteRevokedTokenRepository {
+	return &SQLiteRevokedTokenRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// RevokeToken adds a token ID to the blocklist. Revoking the same ID twice is
+// not an error.
+func (r *SQLiteRevokedTokenRepository) RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	_, err := r.db.ExecContext(ctx,
+		`INSERT OR IGNORE INTO revoked_tokens (jti, expires_at, created_at) VALUES (?, ?, ?)`,
+		jti, expiresAt, time.Now())
+	if err != nil {
+		r.logger.Error("failed to revoke token", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	return nil
+}
+
+// IsTokenRevoked reports whether a token ID is on the blocklist
+func (r *SQLiteRevokedTokenRepository) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var exists bool
+	err := r.db.QueryRowContext(ctx,
+		`SELECT EXISTS(SELECT 1 FROM revoked_tokens WHERE jti = ?)`,
+		jti).Scan(&exists)
+	if err != nil {
+		r.logger.Error("failed to check revoked token", "error", err)
+		return false, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return exists, nil
+}
+
+// DeleteExpiredRevokedTokens removes entries for tokens that expired before
+// now and returns how many were deleted
+func (r *SQLiteRevokedTokenRepository) DeleteExpiredRevokedTokens(ctx context.Context, now time.Time) (int64, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	result, err := r.db.ExecContext(ctx, `DELETE FROM revoked_tokens WHERE expires_at < ?`, now)
+	if err != nil {
+		r.logger.Error("failed to delete expired revoked tokens", "error", err)
+		return 0, errors.Join(domain.ErrDatabase, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", "error", err)
+		return 0, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return rowsAffected, nil
+}
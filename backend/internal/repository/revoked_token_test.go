@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRevokedTokenRepository(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	_, err := db.Exec(`
+		CREATE TABLE revoked_tokens (
+			jti TEXT PRIMARY KEY,
+			expires_at TIMESTAMP NOT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)
+	`)
+	if err != nil {
+		t.Fatalf("failed to create revoked_tokens table: %v", err)
+	}
+
+	repo := NewSQLiteRevokedTokenRepository(db, newTestLogger())
+	ctx := context.Background()
+
+	t.Run("revoke and check", func(t *testing.T) {
+		if revoked, err := repo.IsTokenRevoked(ctx, "jti-a"); err != nil || revoked {
+			t.Fatalf("IsTokenRevoked() = %v, %v; want false, nil", revoked, err)
+		}
+
+		if err := repo.RevokeToken(ctx, "jti-a", time.Now().Add(time.Hour)); err != nil {
+			t.Fatalf("RevokeToken() error = %v", err)
+		}
+		// Revoking twice is a no-op
+		if err := repo.RevokeToken(ctx, "jti-a", time.Now().Add(time.Hour)); err != nil {
+			t.Fatalf("RevokeToken() second call error = %v", err)
+		}
+
+		if revoked, err := repo.IsTokenRevoked(ctx, "jti-a"); err != nil || !revoked {
+			t.Errorf("IsTokenRevoked() = %v, %v; want true, nil", revoked, err)
+		}
+	})
+
+	t.Run("delete expired", func(t *testing.T) {
+		if err := repo.RevokeToken(ctx, "jti-expired", time.Now().Add(-time.Minute)); err != nil {
+			t.Fatalf("RevokeToken() error = %v", err)
+		}
+		if err := repo.RevokeToken(ctx, "jti-live", time.Now().Add(time.Hour)); err != nil {
+			t.Fatalf("RevokeToken() error = %v", err)
+		}
+
+		deleted, err := repo.DeleteExpiredRevokedTokens(ctx, time.Now())
+		if err != nil {
+			t.Fatalf("DeleteExpiredRevokedTokens() error = %v", err)
+		}
+		if deleted != 1 {
+			t.Errorf("DeleteExpiredRevokedTokens() = %d, want 1", deleted)
+		}
+
+		if revoked, _ := repo.IsTokenRevoked(ctx, "jti-expired"); revoked {
+			t.Error("expected expired entry to be deleted")
+		}
+		if revoked, _ := repo.IsTokenRevoked(ctx, "jti-live"); !revoked {
+			t.Error("expected unexpired entry to be kept")
+		}
+	})
+}
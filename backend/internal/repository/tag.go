@@ -33,6 +33,9 @@ func NewSQLiteTagRepository(db *sql.DB, logger *slog.Logger) *SQLiteTagRepositor
 
 // GetAllTags retrieves all unique tags from the database
 func (r *SQLiteTagRepository) GetAllTags(ctx context.Context) ([]string, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	query := `SELECT name FROM tags ORDER BY name`
 
 	rows, err := r.db.QueryContext(ctx, query)
@@ -66,6 +69,9 @@ func (r *SQLiteTagRepository) GetAllTags(ctx context.Context) ([]string, error)
 
 // GetTagByID retrieves a tag by its ID
 func (r *SQLiteTagRepository) GetTagByID(ctx context.Context, id int64) (*domain.Tag, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	query := `SELECT id, name FROM tags WHERE id = ?`
 
 	tag := &domain.Tag{}
@@ -83,6 +89,9 @@ func (r *SQLiteTagRepository) GetTagByID(ctx context.Context, id int64) (*domain
 
 // GetTagByName retrieves a tag by its name
 func (r *SQLiteTagRepository) GetTagByName(ctx context.Context, name string) (*domain.Tag, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	query := `SELECT id, name FROM tags WHERE name = ?`
 
 	tag := &domain.Tag{}
@@ -100,6 +109,9 @@ func (r *SQLiteTagRepository) GetTagByName(ctx context.Context, name string) (*d
 
 // GetTagsByArticleID retrieves all tags for an article
 func (r *SQLiteTagRepository) GetTagsByArticleID(ctx context.Context, articleID int64) ([]string, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	query := `
 		SELECT t.name
 		FROM tags t
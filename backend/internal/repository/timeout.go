@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultQueryTimeout bounds each repository call unless SetQueryTimeout
+// configures otherwise
+const DefaultQueryTimeout = 5 * time.Second
+
+// queryTimeout holds the per-call deadline as nanoseconds so it can be
+// configured at startup without racing in-flight calls
+var queryTimeout atomic.Int64
+
+func init() {
+	queryTimeout.Store(int64(DefaultQueryTimeout))
+}
+
+// SetQueryTimeout sets the deadline applied to every repository call.
+// A non-positive value disables the deadline.
+func SetQueryTimeout(d time.Duration) {
+	queryTimeout.Store(int64(d))
+}
+
+// withQueryTimeout derives a context for one repository call from the caller's
+// context. A deadline the caller already set is kept when it is sooner.
+func withQueryTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	d := time.Duration(queryTimeout.Load())
+	if d <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, d)
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
+)
+
+func TestWithQueryTimeout(t *testing.T) {
+	db, cleanup := setupTestArticleDB(t)
+	defer cleanup()
+
+	t.Cleanup(func() { SetQueryTimeout(DefaultQueryTimeout) })
+
+	t.Run("interrupts a slow query", func(t *testing.T) {
+		SetQueryTimeout(50 * time.Millisecond)
+
+		ctx, cancel := withQueryTimeout(context.Background())
+		defer cancel()
+
+		// An unbounded recursive CTE never finishes on its own
+		start := time.Now()
+		var n int
+		err := db.QueryRowContext(ctx, `
+			WITH RECURSIVE c(x) AS (SELECT 1 UNION ALL SELECT x + 1 FROM c)
+			SELECT COUNT(*) FROM c
+		`).Scan(&n)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+		}
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("query took %s to be interrupted", elapsed)
+		}
+	})
+
+	t.Run("repository call returns wrapped deadline error", func(t *testing.T) {
+		SetQueryTimeout(time.Nanosecond)
+
+		logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+		repo := NewSQLiteArticleRepository(db, logger)
+
+		_, err := repo.GetArticleBySlug(context.Background(), "anything")
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected context.DeadlineExceeded, got %v", err)
+		}
+		if !errors.Is(err, domain.ErrDatabase) {
+			t.Errorf("expected domain.ErrDatabase, got %v", err)
+		}
+	})
+
+	t.Run("keeps a sooner caller deadline", func(t *testing.T) {
+		SetQueryTimeout(time.Hour)
+
+		parent, cancelParent := context.WithTimeout(context.Background(), time.Minute)
+		defer cancelParent()
+		ctx, cancel := withQueryTimeout(parent)
+		defer cancel()
+
+		want, _ := parent.Deadline()
+		if got, ok := ctx.Deadline(); !ok || !got.Equal(want) {
+			t.Errorf("Deadline() = %v, want caller's %v", got, want)
+		}
+	})
+}
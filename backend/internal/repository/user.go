@@ -17,7 +17,10 @@ type UserRepository interface {
 	GetUserByID(ctx context.Context, id int64) (*domain.User, error)
 	GetUserByEmail(ctx context.Context, email string) (*domain.User, error)
 	GetUserByUsername(ctx context.Context, username string) (*domain.User, error)
+	GetUserIDsByUsernames(ctx context.Context, usernames []string) (map[string]int64, error)
+	GetProfilesByIDs(ctx context.Context, ids []int64) (map[int64]*domain.User, error)
 	UpdateUser(ctx context.Context, user *domain.User) error
+	UpdatePasswordHash(ctx context.Context, userID int64, passwordHash string) error
 }
 
 // SQLiteUserRepository implements UserRepository for SQLite
@@ -36,6 +39,9 @@ func NewSQLiteUserRepository(db *sql.DB, logger *slog.Logger) *SQLiteUserReposit
 
 // CreateUser inserts a new user into the database
 func (r *SQLiteUserRepository) CreateUser(ctx context.Context, user *domain.User) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	query := `
 		INSERT INTO users (email, username, password_hash, bio, image, created_at, updated_at)
 		VALUES (?, ?, ?, ?, ?, ?, ?)
@@ -56,13 +62,7 @@ func (r *SQLiteUserRepository) CreateUser(ctx context.Context, user *domain.User
 	)
 	if err != nil {
 		if isUniqueConstraintError(err) {
-			if strings.Contains(err.Error(), "email") {
-				return domain.ErrEmailAlreadyTaken
-			}
-			if strings.Contains(err.Error(), "username") {
-				return domain.ErrUsernameAlreadyTaken
-			}
-			return domain.ErrUserAlreadyExists
+			return userConflictError(sqliteUniqueColumn(err))
 		}
 		r.logger.Error("failed to create user",
 			"error", err,
@@ -90,6 +90,9 @@ func (r *SQLiteUserRepository) CreateUser(ctx context.Context, user *domain.User
 
 // GetUserByID retrieves a user by their ID
 func (r *SQLiteUserRepository) GetUserByID(ctx context.Context, id int64) (*domain.User, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	query := `
 		SELECT id, email, username, password_hash, bio, image, created_at, updated_at
 		FROM users
@@ -123,6 +126,9 @@ func (r *SQLiteUserRepository) GetUserByID(ctx context.Context, id int64) (*doma
 
 // GetUserByEmail retrieves a user by their email
 func (r *SQLiteUserRepository) GetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	query := `
 		SELECT id, email, username, password_hash, bio, image, created_at, updated_at
 		FROM users
@@ -156,6 +162,9 @@ func (r *SQLiteUserRepository) GetUserByEmail(ctx context.Context, email string)
 
 // GetUserByUsername retrieves a user by their username
 func (r *SQLiteUserRepository) GetUserByUsername(ctx context.Context, username string) (*domain.User, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	query := `
 		SELECT id, email, username, password_hash, bio, image, created_at, updated_at
 		FROM users
@@ -187,8 +196,100 @@ func (r *SQLiteUserRepository) GetUserByUsername(ctx context.Context, username s
 	return user, nil
 }
 
+// GetUserIDsByUsernames resolves usernames to user IDs in a single query
+// Usernames that don't exist are omitted from the result
+func (r *SQLiteUserRepository) GetUserIDsByUsernames(ctx context.Context, usernames []string) (map[string]int64, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	ids := make(map[string]int64, len(usernames))
+	if len(usernames) == 0 {
+		return ids, nil
+	}
+
+	placeholders := make([]string, len(usernames))
+	args := make([]interface{}, len(usernames))
+	for i, username := range usernames {
+		placeholders[i] = "?"
+		args[i] = username
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, username FROM users WHERE username IN (`+strings.Join(placeholders, ", ")+`)
+	`, args...)
+	if err != nil {
+		r.logger.Error("failed to get user ids by usernames", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int64
+		var username string
+		if err := rows.Scan(&id, &username); err != nil {
+			r.logger.Error("failed to scan user id", "error", err)
+			return nil, errors.Join(domain.ErrDatabase, err)
+		}
+		ids[username] = id
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error iterating user ids", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return ids, nil
+}
+
+// GetProfilesByIDs loads the public profile fields (username, bio, image) for
+// several users in a single query. IDs that don't exist are omitted from the result
+func (r *SQLiteUserRepository) GetProfilesByIDs(ctx context.Context, ids []int64) (map[int64]*domain.User, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	users := make(map[int64]*domain.User, len(ids))
+	if len(ids) == 0 {
+		return users, nil
+	}
+
+	placeholders := make([]string, len(ids))
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		placeholders[i] = "?"
+		args[i] = id
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, username, bio, image FROM users WHERE id IN (`+strings.Join(placeholders, ", ")+`)
+	`, args...)
+	if err != nil {
+		r.logger.Error("failed to get profiles by ids", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		user := &domain.User{}
+		if err := rows.Scan(&user.ID, &user.Username, &user.Bio, &user.Image); err != nil {
+			r.logger.Error("failed to scan profile", "error", err)
+			return nil, errors.Join(domain.ErrDatabase, err)
+		}
+		users[user.ID] = user
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error iterating profiles", "error", err)
+		return nil, errors.Join(domain.ErrDatabase, err)
+	}
+
+	return users, nil
+}
+
 // UpdateUser updates an existing user in the database
 func (r *SQLiteUserRepository) UpdateUser(ctx context.Context, user *domain.User) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	query := `
 		UPDATE users
 		SET email = ?, username = ?, password_hash = ?, bio = ?, image = ?, updated_at = ?
@@ -208,13 +309,7 @@ func (r *SQLiteUserRepository) UpdateUser(ctx context.Context, user *domain.User
 	)
 	if err != nil {
 		if isUniqueConstraintError(err) {
-			if strings.Contains(err.Error(), "email") {
-				return domain.ErrEmailAlreadyTaken
-			}
-			if strings.Contains(err.Error(), "username") {
-				return domain.ErrUsernameAlreadyTaken
-			}
-			return domain.ErrUserAlreadyExists
+			return userConflictError(sqliteUniqueColumn(err))
 		}
 		r.logger.Error("failed to update user",
 			"error", err,
@@ -241,6 +336,73 @@ func (r *SQLiteUserRepository) UpdateUser(ctx context.Context, user *domain.User
 	return nil
 }
 
+// emailLowerIndex is the unique index on LOWER(email) that keeps emails
+// differing only by case from registering twice
+const emailLowerIndex = "idx_users_email_lower"
+
+// UpdatePasswordHash replaces a user's password hash without touching
+// updated_at, for rehashing that isn't a user-visible change
+func (r *SQLiteUserRepository) UpdatePasswordHash(ctx context.Context, userID int64, passwordHash string) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE users SET password_hash = ? WHERE id = ?`,
+		passwordHash, userID)
+	if err != nil {
+		r.logger.Error("failed to update password hash", "error", err, "user_id", userID)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrUserNotFound
+	}
+
+	return nil
+}
+
+// userConflictError maps the column of a users unique constraint violation to
+// its domain error. Registration relies on the constraints rather than
+// check-then-insert, so concurrent sign-ups can't both pass.
+func userConflictError(column string) error {
+	switch column {
+	case "email", emailLowerIndex:
+		return domain.ErrEmailAlreadyTaken
+	case "username":
+		return domain.ErrUsernameAlreadyTaken
+	default:
+		return domain.ErrUserAlreadyExists
+	}
+}
+
+// sqliteUniqueColumn returns the column named by a SQLite unique violation
+// ("UNIQUE constraint failed: users.email"), the index name for expression
+// indexes ("UNIQUE constraint failed: index 'idx_users_email_lower'"), or ""
+// if it can't be determined
+func sqliteUniqueColumn(err error) string {
+	_, columns, ok := strings.Cut(err.Error(), "UNIQUE constraint failed: ")
+	if !ok {
+		return ""
+	}
+	if index, ok := strings.CutPrefix(columns, "index '"); ok {
+		name, _, _ := strings.Cut(index, "'")
+		return name
+	}
+
+	// Composite constraints list several columns; the first identifies it
+	column, _, _ := strings.Cut(columns, ",")
+	if _, name, ok := strings.Cut(column, "."); ok {
+		column = name
+	}
+	return strings.TrimSpace(column)
+}
+
 // isUniqueConstraintError checks if the error is a SQLite unique constraint violation
 func isUniqueConstraintError(err error) bool {
 	if err == nil {
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"log/slog"
 	"os"
 	"testing"
@@ -34,6 +35,7 @@ func setupTestDB(t *testing.T) *sql.DB {
 		);
 		CREATE INDEX idx_users_email ON users(email);
 		CREATE INDEX idx_users_username ON users(username);
+		CREATE UNIQUE INDEX idx_users_email_lower ON users(LOWER(email));
 	`)
 	if err != nil {
 		t.Fatalf("failed to create users table: %v", err)
@@ -109,6 +111,26 @@ func TestCreateUser(t *testing.T) {
 		}
 	})
 
+	t.Run("returns error for email differing only by case", func(t *testing.T) {
+		user := &domain.User{
+			Email:        "casing@example.com",
+			Username:     "casing1",
+			PasswordHash: "hashedpassword",
+		}
+		if err := repo.CreateUser(ctx, user); err != nil {
+			t.Fatalf("expected no error on first create, got %v", err)
+		}
+
+		user2 := &domain.User{
+			Email:        "Casing@Example.com",
+			Username:     "casing2",
+			PasswordHash: "hashedpassword",
+		}
+		if err := repo.CreateUser(ctx, user2); err != domain.ErrEmailAlreadyTaken {
+			t.Errorf("expected ErrEmailAlreadyTaken, got %v", err)
+		}
+	})
+
 	t.Run("returns error for duplicate username", func(t *testing.T) {
 		user := &domain.User{
 			Email:        "unique1@example.com",
@@ -263,6 +285,99 @@ func TestGetUserByUsername(t *testing.T) {
 	})
 }
 
+func TestGetUserIDsByUsernames(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewSQLiteUserRepository(db, newTestLogger())
+	ctx := context.Background()
+
+	alice := &domain.User{Email: "alice@example.com", Username: "alice", PasswordHash: "hashedpassword"}
+	bob := &domain.User{Email: "bob@example.com", Username: "bob", PasswordHash: "hashedpassword"}
+	for _, u := range []*domain.User{alice, bob} {
+		if err := repo.CreateUser(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+
+	t.Run("returns only existing usernames", func(t *testing.T) {
+		ids, err := repo.GetUserIDsByUsernames(ctx, []string{"alice", "missing", "bob"})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if len(ids) != 2 {
+			t.Errorf("expected 2 ids, got %d", len(ids))
+		}
+		if ids["alice"] != alice.ID {
+			t.Errorf("expected alice ID %d, got %d", alice.ID, ids["alice"])
+		}
+		if ids["bob"] != bob.ID {
+			t.Errorf("expected bob ID %d, got %d", bob.ID, ids["bob"])
+		}
+		if _, ok := ids["missing"]; ok {
+			t.Error("expected missing username to be absent")
+		}
+	})
+
+	t.Run("returns empty map for empty input", func(t *testing.T) {
+		ids, err := repo.GetUserIDsByUsernames(ctx, nil)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if ids == nil || len(ids) != 0 {
+			t.Errorf("expected empty map, got %v", ids)
+		}
+	})
+}
+
+func TestGetProfilesByIDs(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewSQLiteUserRepository(db, newTestLogger())
+	ctx := context.Background()
+
+	alice := &domain.User{Email: "alice@example.com", Username: "alice", PasswordHash: "hashedpassword", Bio: "Hi"}
+	if err := repo.CreateUser(ctx, alice); err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+
+	profiles, err := repo.GetProfilesByIDs(ctx, []int64{alice.ID, 9999})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(profiles) != 1 {
+		t.Fatalf("expected 1 profile, got %d", len(profiles))
+	}
+	profile := profiles[alice.ID]
+	if profile.Username != "alice" || profile.Bio != "Hi" {
+		t.Errorf("unexpected profile: %+v", profile)
+	}
+	if profile.PasswordHash != "" || profile.Email != "" {
+		t.Error("expected private fields to be left empty")
+	}
+}
+
+func TestSQLiteUniqueColumn(t *testing.T) {
+	tests := []struct {
+		err  string
+		want string
+	}{
+		{err: "UNIQUE constraint failed: users.email", want: "email"},
+		{err: "UNIQUE constraint failed: users.username", want: "username"},
+		{err: "UNIQUE constraint failed: favorites.user_id, favorites.article_id", want: "user_id"},
+		{err: "UNIQUE constraint failed: index 'idx_users_email_lower'", want: "idx_users_email_lower"},
+		{err: "database is locked", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := sqliteUniqueColumn(errors.New(tt.err)); got != tt.want {
+			t.Errorf("sqliteUniqueColumn(%q) = %q, want %q", tt.err, got, tt.want)
+		}
+	}
+}
+
 func TestUpdateUser(t *testing.T) {
 	db := setupTestDB(t)
 	defer db.Close()
@@ -354,3 +469,43 @@ func TestUpdateUser(t *testing.T) {
 		}
 	})
 }
+
+func TestUpdatePasswordHash(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewSQLiteUserRepository(db, newTestLogger())
+	ctx := context.Background()
+
+	t.Run("replaces the hash and keeps updated_at", func(t *testing.T) {
+		user := &domain.User{Email: "rehash@example.com", Username: "rehashuser", PasswordHash: "old-hash"}
+		if err := repo.CreateUser(ctx, user); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+		before, err := repo.GetUserByID(ctx, user.ID)
+		if err != nil {
+			t.Fatalf("failed to get user: %v", err)
+		}
+
+		if err := repo.UpdatePasswordHash(ctx, user.ID, "new-hash"); err != nil {
+			t.Fatalf("UpdatePasswordHash() error = %v", err)
+		}
+
+		after, err := repo.GetUserByID(ctx, user.ID)
+		if err != nil {
+			t.Fatalf("failed to get user: %v", err)
+		}
+		if after.PasswordHash != "new-hash" {
+			t.Errorf("PasswordHash = %q, want new-hash", after.PasswordHash)
+		}
+		if !after.UpdatedAt.Equal(before.UpdatedAt) {
+			t.Errorf("UpdatedAt changed from %v to %v", before.UpdatedAt, after.UpdatedAt)
+		}
+	})
+
+	t.Run("returns ErrUserNotFound for unknown user", func(t *testing.T) {
+		if err := repo.UpdatePasswordHash(ctx, 99999, "hash"); err != domain.ErrUserNotFound {
+			t.Errorf("expected ErrUserNotFound, got %v", err)
+		}
+	})
+}
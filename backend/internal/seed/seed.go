@@ -0,0 +1,196 @@
+// Package seed fills a database with sample users, articles and activity for
+// local development. Everything goes through the service layer, so the data
+// obeys the same validation and side effects as data created over the API.
+package seed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
+	"github.com/alexlee0213/realworld-conduit/backend/internal/service"
+)
+
+// SentinelUsername is the first user the seeder creates. Its presence means
+// the database has already been seeded.
+const SentinelUsername = "demo"
+
+// Password is shared by every seeded account
+const Password = "Conduit-demo-1"
+
+// Usernames of the seeded accounts, sentinel first
+var users = []string{SentinelUsername, "alice", "bob", "carol"}
+
+// follows maps a follower to the users they follow
+var follows = map[string][]string{
+	"demo":  {"alice", "bob"},
+	"alice": {"bob"},
+	"bob":   {"carol"},
+	"carol": {"alice"},
+}
+
+type article struct {
+	author      string
+	title       string
+	description string
+	body        string
+	tags        []string
+}
+
+var articles = []article{
+	{
+		author:      "alice",
+		title:       "Getting Started with Go",
+		description: "A short tour of the toolchain",
+		body:        "Install Go, run `go mod init`, and write your first `main` package.",
+		tags:        []string{"go", "beginners"},
+	},
+	{
+		author:      "alice",
+		title:       "Table-Driven Tests",
+		description: "Why Go tests love slices of structs",
+		body:        "Describe each case as data and loop over them with `t.Run`.",
+		tags:        []string{"go", "testing"},
+	},
+	{
+		author:      "bob",
+		title:       "Designing REST APIs",
+		description: "Resources, verbs and status codes",
+		body:        "Model nouns as resources and let HTTP methods carry the verbs.",
+		tags:        []string{"api", "http"},
+	},
+	{
+		author:      "bob",
+		title:       "SQLite in Production",
+		description: "When a single file is enough",
+		body:        "WAL mode and a busy timeout go a long way for small services.",
+		tags:        []string{"databases", "sqlite"},
+	},
+	{
+		author:      "carol",
+		title:       "Writing Clear Commit Messages",
+		description: "Say what changed and why",
+		body:        "Lead with a short summary line, then explain the motivation.",
+		tags:        []string{"git", "beginners"},
+	},
+}
+
+// favorites maps a user to the titles of the articles they favorite
+var favorites = map[string][]string{
+	"demo":  {"Getting Started with Go", "Designing REST APIs", "Writing Clear Commit Messages"},
+	"bob":   {"Getting Started with Go", "Table-Driven Tests"},
+	"carol": {"Getting Started with Go", "SQLite in Production"},
+}
+
+type comment struct {
+	author string
+	title  string
+	body   string
+}
+
+var comments = []comment{
+	{author: "bob", title: "Getting Started with Go", body: "Great intro, thanks!"},
+	{author: "carol", title: "Getting Started with Go", body: "Worth mentioning `go vet` too."},
+	{author: "alice", title: "Designing REST APIs", body: "How do you version these?"},
+	{author: "demo", title: "SQLite in Production", body: "This matches my experience."},
+}
+
+// Seeder creates the development sample data
+type Seeder struct {
+	auth     *service.AuthService
+	articles *service.ArticleService
+	comments *service.CommentService
+	profiles *service.ProfileService
+	logger   *slog.Logger
+}
+
+// New creates a seeder over the configured services
+func New(
+	auth *service.AuthService,
+	articles *service.ArticleService,
+	comments *service.CommentService,
+	profiles *service.ProfileService,
+	logger *slog.Logger,
+) *Seeder {
+	return &Seeder{
+		auth:     auth,
+		articles: articles,
+		comments: comments,
+		profiles: profiles,
+		logger:   logger,
+	}
+}
+
+// Run seeds the database unless SentinelUsername already exists, and reports
+// whether it created anything
+func (s *Seeder) Run(ctx context.Context) (bool, error) {
+	_, err := s.profiles.GetProfileByUsername(ctx, SentinelUsername, nil)
+	if err == nil {
+		s.logger.Info("database already seeded, skipping", "sentinel", SentinelUsername)
+		return false, nil
+	}
+	if !errors.Is(err, domain.ErrUserNotFound) {
+		return false, fmt.Errorf("check for seed sentinel: %w", err)
+	}
+
+	userIDs := make(map[string]int64, len(users))
+	for _, username := range users {
+		user, _, err := s.auth.Register(ctx, &domain.CreateUserInput{
+			Email:    username + "@example.com",
+			Username: username,
+			Password: Password,
+		})
+		if err != nil {
+			return false, fmt.Errorf("create user %s: %w", username, err)
+		}
+		userIDs[username] = user.ID
+	}
+
+	for follower, followees := range follows {
+		for _, followee := range followees {
+			if _, err := s.profiles.FollowUser(ctx, userIDs[follower], followee); err != nil {
+				return false, fmt.Errorf("follow %s as %s: %w", followee, follower, err)
+			}
+		}
+	}
+
+	slugs := make(map[string]string, len(articles))
+	for _, a := range articles {
+		created, err := s.articles.CreateArticle(ctx, userIDs[a.author], &domain.CreateArticleInput{
+			Title:       a.title,
+			Description: a.description,
+			Body:        a.body,
+			TagList:     a.tags,
+		})
+		if err != nil {
+			return false, fmt.Errorf("create article %q: %w", a.title, err)
+		}
+		slugs[a.title] = created.Slug
+	}
+
+	for username, titles := range favorites {
+		for _, title := range titles {
+			if _, err := s.articles.FavoriteArticle(ctx, slugs[title], userIDs[username]); err != nil {
+				return false, fmt.Errorf("favorite %q as %s: %w", title, username, err)
+			}
+		}
+	}
+
+	for _, c := range comments {
+		if _, err := s.comments.CreateComment(ctx, slugs[c.title], userIDs[c.author], &domain.CreateCommentInput{
+			Body: c.body,
+		}); err != nil {
+			return false, fmt.Errorf("comment on %q as %s: %w", c.title, c.author, err)
+		}
+	}
+
+	s.logger.Info("database seeded",
+		"users", len(users),
+		"articles", len(articles),
+		"comments", len(comments),
+		"password", Password,
+	)
+	return true, nil
+}
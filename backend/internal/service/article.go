@@ -2,82 +2,329 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
+	"github.com/alexlee0213/realworld-conduit/backend/internal/events"
 	"github.com/alexlee0213/realworld-conduit/backend/internal/repository"
 	"github.com/alexlee0213/realworld-conduit/backend/internal/util"
 )
 
+// DefaultMaxTags is the default cap on tags per article
+const DefaultMaxTags = 10
+
+// ArticleServiceConfig holds tunable article behavior
+type ArticleServiceConfig struct {
+	// StatsAuthorOnly restricts article stats to the article's author
+	StatsAuthorOnly bool
+	// RejectHTML rejects HTML tags in the title and description
+	RejectHTML bool
+	// UniqueTitlePerAuthor rejects a new article, draft or rename whose title
+	// matches one of the author's other articles
+	UniqueTitlePerAuthor bool
+	// CuratedTags rejects tags that don't already exist instead of creating them
+	CuratedTags bool
+	// MaxTags is the most tags an article may have (0 disables the cap)
+	MaxTags int
+	// MaxOffset is the largest pagination offset accepted by list endpoints
+	// (0 disables the cap)
+	MaxOffset int
+	// LintMarkdown rejects bodies with structural markdown problems such as
+	// unterminated code fences
+	LintMarkdown bool
+	// MinAccountAge is how old an account must be before it can create
+	// articles (0 disables the check)
+	MinAccountAge time.Duration
+	// CountViews increments the view count when a non-author fetches an article
+	CountViews bool
+	// TagsCacheTTL is how long GetAllTags results are cached (0 disables the
+	// cache)
+	TagsCacheTTL time.Duration
+	// MaintainerIDs are the users allowed to run maintenance such as
+	// RecomputeCounts
+	MaintainerIDs []int64
+}
+
+// DefaultArticleServiceConfig returns the default article configuration
+func DefaultArticleServiceConfig() ArticleServiceConfig {
+	return ArticleServiceConfig{
+		StatsAuthorOnly:      false,
+		RejectHTML:           false,
+		UniqueTitlePerAuthor: false,
+		CuratedTags:          false,
+		MaxTags:              DefaultMaxTags,
+		MaxOffset:            DefaultMaxOffset,
+		LintMarkdown:         false,
+		MinAccountAge:        0,
+		CountViews:           false,
+		TagsCacheTTL:         DefaultTagsCacheTTL,
+	}
+}
+
 // ArticleService handles article business logic
 type ArticleService struct {
-	articleRepo repository.ArticleRepository
-	userRepo    repository.UserRepository
-	logger      *slog.Logger
+	articleRepo  repository.ArticleRepository
+	userRepo     repository.UserRepository
+	followRepo   repository.FollowRepository
+	commentRepo  repository.CommentRepository
+	favoriteRepo repository.FavoriteRepository
+	config       ArticleServiceConfig
+	eventBus     *events.Bus
+	tags         *tagCache
+	logger       *slog.Logger
 }
 
 // NewArticleService creates a new ArticleService instance
 func NewArticleService(
 	articleRepo repository.ArticleRepository,
 	userRepo repository.UserRepository,
+	followRepo repository.FollowRepository,
+	commentRepo repository.CommentRepository,
+	favoriteRepo repository.FavoriteRepository,
 	logger *slog.Logger,
 ) *ArticleService {
 	return &ArticleService{
-		articleRepo: articleRepo,
-		userRepo:    userRepo,
-		logger:      logger,
+		articleRepo:  articleRepo,
+		userRepo:     userRepo,
+		followRepo:   followRepo,
+		commentRepo:  commentRepo,
+		favoriteRepo: favoriteRepo,
+		config:       DefaultArticleServiceConfig(),
+		tags:         newTagCache(),
+		logger:       logger,
 	}
 }
 
+// SetConfig replaces the service configuration
+func (s *ArticleService) SetConfig(config ArticleServiceConfig) {
+	s.config = config
+}
+
+// SetEventBus sets the bus that article changes are published to
+func (s *ArticleService) SetEventBus(bus *events.Bus) {
+	s.eventBus = bus
+}
+
 // CreateArticle creates a new article
 func (s *ArticleService) CreateArticle(ctx context.Context, authorID int64, input *domain.CreateArticleInput) (*domain.Article, error) {
-	// Validate input
-	if err := s.validateCreateArticleInput(input); err != nil {
+	// Checked before the draft branch so a draft can't later be published
+	// under a title the author already has
+	if err := s.checkTitleAvailable(ctx, authorID, input.Title, 0); err != nil {
 		return nil, err
 	}
 
-	// Generate unique slug
-	baseSlug := util.GenerateSlug(input.Title)
-	slug := util.GenerateUniqueSlug(input.Title, func(slug string) bool {
-		return s.articleRepo.SlugExists(ctx, slug)
-	})
+	// An explicit published=false saves a draft instead
+	if input.Published != nil && !*input.Published {
+		return s.saveDraft(ctx, authorID, input)
+	}
+
+	// Validate input, reporting every field problem together
+	tags, tagsErr := s.normalizeTags(input.TagList)
+	if err := collectValidationErrors(
+		s.validateCreateArticleInput(input),
+		s.validatePlainText(input.Title, input.Description),
+		tagsErr,
+		s.validateMarkdown(input.Body),
+		validateCoverImage(input.CoverImage),
+	); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateCuratedTags(ctx, tags); err != nil {
+		return nil, err
+	}
+	if err := checkAccountAge(ctx, s.userRepo, authorID, s.config.MinAccountAge); err != nil {
+		return nil, err
+	}
+
+	slug, err := s.newArticleSlug(ctx, input)
+	if err != nil {
+		return nil, err
+	}
 
 	article := &domain.Article{
 		Slug:        slug,
 		Title:       strings.TrimSpace(input.Title),
 		Description: strings.TrimSpace(input.Description),
 		Body:        input.Body,
+		CoverImage:  domain.ResolveCoverImage(input.CoverImage, input.Body),
+		Published:   true,
+		PublishAt:   utcTime(input.PublishAt),
 		AuthorID:    authorID,
 	}
 
-	if err := s.articleRepo.CreateArticle(ctx, article, input.TagList); err != nil {
+	if err := s.articleRepo.CreateArticle(ctx, article, tags); err != nil {
 		return nil, err
 	}
 
-	// Load tags
-	article.TagList = input.TagList
-	if article.TagList == nil {
-		article.TagList = []string{}
-	}
+	article.TagList = tags
+	s.invalidateTagsIfNew(tags)
 
 	s.logger.Info("article created",
 		"article_id", article.ID,
 		"slug", article.Slug,
 		"author_id", authorID,
-		"base_slug", baseSlug,
 	)
 
+	s.eventBus.Publish(ctx, events.ArticleCreated{ArticleID: article.ID, Slug: article.Slug, AuthorID: authorID})
+
+	return article, nil
+}
+
+// SaveDraft creates an unpublished article
+// Only the title is required; description and body may be filled in later
+func (s *ArticleService) SaveDraft(ctx context.Context, authorID int64, input *domain.CreateArticleInput) (*domain.Article, error) {
+	if err := s.checkTitleAvailable(ctx, authorID, input.Title, 0); err != nil {
+		return nil, err
+	}
+	return s.saveDraft(ctx, authorID, input)
+}
+
+// saveDraft creates an unpublished article once the title has been checked
+func (s *ArticleService) saveDraft(ctx context.Context, authorID int64, input *domain.CreateArticleInput) (*domain.Article, error) {
+	titleErrors := domain.NewValidationErrors()
+	if strings.TrimSpace(input.Title) == "" {
+		titleErrors.Add("title", "can't be blank")
+	}
+	tags, tagsErr := s.normalizeTags(input.TagList)
+	if err := collectValidationErrors(
+		titleErrors,
+		s.validatePlainText(input.Title, input.Description),
+		tagsErr,
+		validateCoverImage(input.CoverImage),
+	); err != nil {
+		return nil, err
+	}
+	if err := s.validateCuratedTags(ctx, tags); err != nil {
+		return nil, err
+	}
+	if err := checkAccountAge(ctx, s.userRepo, authorID, s.config.MinAccountAge); err != nil {
+		return nil, err
+	}
+
+	slug, err := s.newArticleSlug(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	article := &domain.Article{
+		Slug:        slug,
+		Title:       strings.TrimSpace(input.Title),
+		Description: strings.TrimSpace(input.Description),
+		Body:        input.Body,
+		CoverImage:  domain.ResolveCoverImage(input.CoverImage, input.Body),
+		Published:   false,
+		PublishAt:   utcTime(input.PublishAt),
+		AuthorID:    authorID,
+	}
+
+	if err := s.articleRepo.CreateArticle(ctx, article, tags); err != nil {
+		return nil, err
+	}
+
+	article.TagList = tags
+	s.invalidateTagsIfNew(tags)
+
+	s.logger.Info("article draft saved",
+		"article_id", article.ID,
+		"slug", article.Slug,
+		"author_id", authorID,
+	)
+
+	s.eventBus.Publish(ctx, events.ArticleCreated{ArticleID: article.ID, Slug: article.Slug, AuthorID: authorID})
+
+	return article, nil
+}
+
+// Publish marks a draft as published after it passes full validation
+// Only the author can publish the article (explicit authorization check)
+func (s *ArticleService) Publish(ctx context.Context, slug string, authorID int64) (*domain.Article, error) {
+	article, err := loadVisibleArticle(ctx, s.articleRepo, slug, &authorID)
+	if err != nil {
+		return nil, err
+	}
+
+	// EXPLICIT AUTHORIZATION CHECK: Only the author can publish
+	if article.AuthorID != authorID {
+		s.logger.Warn("unauthorized article publish attempt",
+			"article_id", article.ID,
+			"author_id", article.AuthorID,
+			"attempted_by", authorID,
+		)
+		return nil, domain.ErrForbidden
+	}
+
+	if !article.Published {
+		if err := collectValidationErrors(
+			validateArticleFields(article.Title, article.Description, article.Body),
+			s.validateMarkdown(article.Body),
+		); err != nil {
+			return nil, err
+		}
+
+		article.Published = true
+		if err := s.articleRepo.UpdateArticle(ctx, article); err != nil {
+			return nil, err
+		}
+
+		s.logger.Info("article published",
+			"article_id", article.ID,
+			"slug", article.Slug,
+			"published_by", authorID,
+		)
+
+		s.eventBus.Publish(ctx, events.ArticleUpdated{ArticleID: article.ID, Slug: article.Slug, OldSlug: article.Slug, AuthorID: authorID})
+	}
+
+	// Load author information
+	author, err := s.userRepo.GetUserByID(ctx, article.AuthorID)
+	if err != nil {
+		s.logger.Error("failed to get article author", "error", err, "author_id", article.AuthorID)
+		return nil, err
+	}
+	article.Author = author
+	s.loadCommentsCounts(ctx, []*domain.Article{article})
+
+	return article, nil
+}
+
+// loadVisibleArticle fetches the article at slug for viewerID (nil for
+// anonymous). Drafts and articles scheduled for later are only visible to
+// their author; anyone else gets ErrArticleNotFound, so every slug-based
+// operation hides them the same way.
+func loadVisibleArticle(ctx context.Context, articleRepo repository.ArticleRepository, slug string, viewerID *int64) (*domain.Article, error) {
+	article, err := articleRepo.GetArticleBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	if !article.IsVisibleTo(viewerID, time.Now()) {
+		return nil, domain.ErrArticleNotFound
+	}
 	return article, nil
 }
 
 // GetArticleBySlug retrieves an article by its slug
+// Drafts and articles scheduled for later are only visible to their author;
+// anyone else gets ErrArticleNotFound
 func (s *ArticleService) GetArticleBySlug(ctx context.Context, slug string, currentUserID *int64) (*domain.Article, error) {
-	article, err := s.articleRepo.GetArticleBySlug(ctx, slug)
+	article, err := loadVisibleArticle(ctx, s.articleRepo, slug, currentUserID)
 	if err != nil {
 		return nil, err
 	}
 
+	// Count the view for non-authors; a failed increment never fails the read
+	if s.config.CountViews && (currentUserID == nil || *currentUserID != article.AuthorID) {
+		if err := s.articleRepo.IncrementViewCount(ctx, article.ID); err != nil {
+			s.logger.Warn("failed to increment view count", "error", err, "article_id", article.ID)
+		} else {
+			article.ViewCount++
+		}
+	}
+
 	// Load author information
 	author, err := s.userRepo.GetUserByID(ctx, article.AuthorID)
 	if err != nil {
@@ -85,15 +332,25 @@ func (s *ArticleService) GetArticleBySlug(ctx context.Context, slug string, curr
 		return nil, err
 	}
 	article.Author = author
+	if currentUserID != nil {
+		article.AuthorFollowing = s.isFollowingAuthor(ctx, *currentUserID, article)
+	}
+	s.loadCommentsCounts(ctx, []*domain.Article{article})
 
 	return article, nil
 }
 
+// ResolveSlugRedirect returns the current slug of an article that was renamed
+// away from oldSlug, or ErrArticleNotFound if the slug was never used
+func (s *ArticleService) ResolveSlugRedirect(ctx context.Context, oldSlug string) (string, error) {
+	return s.articleRepo.ResolveSlugRedirect(ctx, oldSlug)
+}
+
 // UpdateArticle updates an existing article
 // Only the author can update the article (explicit authorization check)
 func (s *ArticleService) UpdateArticle(ctx context.Context, slug string, authorID int64, input *domain.UpdateArticleInput) (*domain.Article, error) {
 	// Get the article
-	article, err := s.articleRepo.GetArticleBySlug(ctx, slug)
+	article, err := loadVisibleArticle(ctx, s.articleRepo, slug, &authorID)
 	if err != nil {
 		return nil, err
 	}
@@ -111,21 +368,62 @@ func (s *ArticleService) UpdateArticle(ctx context.Context, slug string, authorI
 	// Apply updates
 	if input.Title != nil {
 		newTitle := strings.TrimSpace(*input.Title)
-		article.Title = newTitle
-		// Regenerate slug if title changed
-		article.Slug = util.GenerateUniqueSlug(newTitle, func(candidateSlug string) bool {
-			// Allow the same slug if it's the article's current slug
-			if candidateSlug == slug {
-				return false
+		if newTitle != article.Title {
+			if err := s.checkTitleAvailable(ctx, authorID, newTitle, article.ID); err != nil {
+				return nil, err
 			}
-			return s.articleRepo.SlugExists(ctx, candidateSlug)
-		})
+		}
+		// Regenerate slug if title changed, unless the caller opts out.
+		// The old slug keeps resolving through a redirect.
+		regenerate := input.RegenerateSlug == nil || *input.RegenerateSlug
+		if regenerate && newTitle != article.Title {
+			article.Slug = util.GenerateUniqueSlug(newTitle, func(candidateSlug string) bool {
+				// Allow the same slug if it's the article's current slug
+				if candidateSlug == slug {
+					return false
+				}
+				return s.articleRepo.SlugExists(ctx, candidateSlug)
+			})
+		}
+		article.Title = newTitle
 	}
 	if input.Description != nil {
 		article.Description = strings.TrimSpace(*input.Description)
 	}
 	if input.Body != nil {
+		// Keep an auto-extracted cover in sync with the new body
+		autoCover := article.CoverImage == domain.ExtractCoverImage(article.Body)
 		article.Body = *input.Body
+		if autoCover {
+			article.CoverImage = domain.ExtractCoverImage(article.Body)
+		}
+	}
+	var coverErr error
+	if input.CoverImage != nil {
+		coverErr = validateCoverImage(*input.CoverImage)
+		article.CoverImage = domain.ResolveCoverImage(*input.CoverImage, article.Body)
+	}
+	if input.PublishAt != nil {
+		article.PublishAt = utcTime(input.PublishAt)
+	}
+
+	// Validate the updated article, reporting every field problem together
+	checks := []error{coverErr, s.validatePlainText(article.Title, article.Description)}
+	if input.Body != nil && article.Published {
+		checks = append(checks, s.validateMarkdown(article.Body))
+	}
+	if input.Published != nil && *input.Published && !article.Published {
+		// Publishing requires the same validation as Publish
+		checks = append(checks,
+			validateArticleFields(article.Title, article.Description, article.Body),
+			s.validateMarkdown(article.Body),
+		)
+	}
+	if err := collectValidationErrors(checks...); err != nil {
+		return nil, err
+	}
+	if input.Published != nil {
+		article.Published = *input.Published
 	}
 
 	if err := s.articleRepo.UpdateArticle(ctx, article); err != nil {
@@ -139,6 +437,7 @@ func (s *ArticleService) UpdateArticle(ctx context.Context, slug string, authorI
 		return nil, err
 	}
 	article.Author = author
+	s.loadCommentsCounts(ctx, []*domain.Article{article})
 
 	s.logger.Info("article updated",
 		"article_id", article.ID,
@@ -146,14 +445,95 @@ func (s *ArticleService) UpdateArticle(ctx context.Context, slug string, authorI
 		"updated_by", authorID,
 	)
 
+	s.eventBus.Publish(ctx, events.ArticleUpdated{ArticleID: article.ID, Slug: article.Slug, OldSlug: slug, AuthorID: authorID})
+
+	return article, nil
+}
+
+// GetArticleStats returns engagement counts for an article
+// When stats are restricted to authors, anyone else gets ErrForbidden
+func (s *ArticleService) GetArticleStats(ctx context.Context, slug string, currentUserID *int64) (*domain.ArticleStats, error) {
+	article, err := loadVisibleArticle(ctx, s.articleRepo, slug, currentUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.config.StatsAuthorOnly {
+		if currentUserID == nil {
+			return nil, domain.ErrUnauthorized
+		}
+		if *currentUserID != article.AuthorID {
+			return nil, domain.ErrForbidden
+		}
+	}
+
+	return s.articleRepo.GetArticleStats(ctx, article.ID)
+}
+
+// ListRevisions returns the previous versions of an article, newest first
+// Only the author can view revisions (explicit authorization check)
+func (s *ArticleService) ListRevisions(ctx context.Context, slug string, userID int64) ([]*domain.ArticleRevision, error) {
+	article, err := loadVisibleArticle(ctx, s.articleRepo, slug, &userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// EXPLICIT AUTHORIZATION CHECK: Only the author can view revisions
+	if article.AuthorID != userID {
+		return nil, domain.ErrForbidden
+	}
+
+	return s.articleRepo.ListRevisions(ctx, article.ID)
+}
+
+// SetCommentsEnabled enables or disables new comments on an article
+// Only the author can change the setting (explicit authorization check)
+func (s *ArticleService) SetCommentsEnabled(ctx context.Context, slug string, authorID int64, enabled bool) (*domain.Article, error) {
+	article, err := loadVisibleArticle(ctx, s.articleRepo, slug, &authorID)
+	if err != nil {
+		return nil, err
+	}
+
+	// EXPLICIT AUTHORIZATION CHECK: Only the author can toggle comments
+	if article.AuthorID != authorID {
+		s.logger.Warn("unauthorized comments setting attempt",
+			"article_id", article.ID,
+			"author_id", article.AuthorID,
+			"attempted_by", authorID,
+		)
+		return nil, domain.ErrForbidden
+	}
+
+	if err := s.articleRepo.SetCommentsEnabled(ctx, article.ID, enabled); err != nil {
+		return nil, err
+	}
+	article.CommentsEnabled = enabled
+
+	// Load author information
+	author, err := s.userRepo.GetUserByID(ctx, article.AuthorID)
+	if err != nil {
+		s.logger.Error("failed to get article author", "error", err, "author_id", article.AuthorID)
+		return nil, err
+	}
+	article.Author = author
+	s.loadCommentsCounts(ctx, []*domain.Article{article})
+
+	s.logger.Info("article comments setting updated",
+		"article_id", article.ID,
+		"slug", slug,
+		"comments_enabled", enabled,
+	)
+
 	return article, nil
 }
 
 // DeleteArticle deletes an article
 // Only the author can delete the article (explicit authorization check)
+// Deletion is permanent (there is no soft-delete), so the slug is freed
+// immediately and may be reused by a new article.
 func (s *ArticleService) DeleteArticle(ctx context.Context, slug string, authorID int64) error {
 	// Get the article
-	article, err := s.articleRepo.GetArticleBySlug(ctx, slug)
+	article, err := loadVisibleArticle(ctx, s.articleRepo, slug, &authorID)
 	if err != nil {
 		return err
 	}
@@ -171,6 +551,10 @@ func (s *ArticleService) DeleteArticle(ctx context.Context, slug string, authorI
 	if err := s.articleRepo.DeleteArticle(ctx, article.ID); err != nil {
 		return err
 	}
+	// Deleting the last article using a tag removes the tag
+	if len(article.TagList) > 0 {
+		s.tags.invalidate()
+	}
 
 	s.logger.Info("article deleted",
 		"article_id", article.ID,
@@ -178,6 +562,8 @@ func (s *ArticleService) DeleteArticle(ctx context.Context, slug string, authorI
 		"deleted_by", authorID,
 	)
 
+	s.eventBus.Publish(ctx, events.ArticleDeleted{ArticleID: article.ID, Slug: slug, AuthorID: authorID})
+
 	return nil
 }
 
@@ -194,6 +580,18 @@ func (s *ArticleService) ListArticles(ctx context.Context, params *domain.Articl
 	if params.Limit > 100 {
 		params.Limit = 100
 	}
+	if params.Before != nil {
+		// Keyset pagination walks the newest-first order and replaces the offset
+		if params.Sort != "" && params.Sort != domain.ArticleSortNewest {
+			validationErrors := domain.NewValidationErrors()
+			validationErrors.Add("before", "is only supported with sort=newest")
+			return nil, 0, validationErrors
+		}
+		params.Offset = 0
+	}
+	if err := validateOffset(params.Offset, s.config.MaxOffset); err != nil {
+		return nil, 0, err
+	}
 
 	articles, total, err := s.articleRepo.ListArticles(ctx, params, currentUserID)
 	if err != nil {
@@ -209,10 +607,50 @@ func (s *ArticleService) ListArticles(ctx context.Context, params *domain.Articl
 		}
 		article.Author = author
 	}
+	if currentUserID != nil {
+		s.loadAuthorFollowing(ctx, *currentUserID, articles)
+	}
+	s.loadCommentsCounts(ctx, articles)
 
 	return articles, total, nil
 }
 
+// ListAuthorArticles retrieves an author's latest published articles as seen
+// by an anonymous reader, newest first
+func (s *ArticleService) ListAuthorArticles(ctx context.Context, username string, limit int) ([]*domain.Article, *domain.User, error) {
+	author, err := s.userRepo.GetUserByUsername(ctx, username)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	articles, _, err := s.ListArticles(ctx, &domain.ArticleListParams{
+		Author: author.Username,
+		Sort:   domain.ArticleSortNewest,
+		Limit:  limit,
+	}, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return articles, author, nil
+}
+
+// ListFavoritedArticles retrieves the published articles username has
+// favorited, newest first. currentUserID is optional - if provided, the
+// favorited and following flags are set for that user.
+func (s *ArticleService) ListFavoritedArticles(ctx context.Context, username string, currentUserID *int64, limit, offset int) ([]*domain.Article, int, error) {
+	user, err := s.userRepo.GetUserByUsername(ctx, username)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return s.ListArticles(ctx, &domain.ArticleListParams{
+		Favorited: user.Username,
+		Limit:     limit,
+		Offset:    offset,
+	}, currentUserID)
+}
+
 // GetFeed retrieves articles from followed users
 func (s *ArticleService) GetFeed(ctx context.Context, userID int64, params *domain.ArticleFeedParams) ([]*domain.Article, int, error) {
 	if params == nil {
@@ -226,6 +664,9 @@ func (s *ArticleService) GetFeed(ctx context.Context, userID int64, params *doma
 	if params.Limit > 100 {
 		params.Limit = 100
 	}
+	if err := validateOffset(params.Offset, s.config.MaxOffset); err != nil {
+		return nil, 0, err
+	}
 
 	articles, total, err := s.articleRepo.GetFeed(ctx, userID, params)
 	if err != nil {
@@ -241,19 +682,260 @@ func (s *ArticleService) GetFeed(ctx context.Context, userID int64, params *doma
 		}
 		article.Author = author
 	}
+	s.loadAuthorFollowing(ctx, userID, articles)
+	s.loadCommentsCounts(ctx, articles)
+
+	return articles, total, nil
+}
+
+// GetFriendsFavorites retrieves articles favorited by users the caller follows,
+// most widely favorited first
+func (s *ArticleService) GetFriendsFavorites(ctx context.Context, userID int64, params *domain.ArticleFeedParams) ([]*domain.Article, int, error) {
+	if params == nil {
+		params = domain.DefaultArticleFeedParams()
+	}
+
+	// Apply defaults if not set
+	if params.Limit <= 0 {
+		params.Limit = 20
+	}
+	if params.Limit > 100 {
+		params.Limit = 100
+	}
+	if err := validateOffset(params.Offset, s.config.MaxOffset); err != nil {
+		return nil, 0, err
+	}
+
+	articles, total, err := s.articleRepo.GetFriendsFavorites(ctx, userID, params)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Load author information for each article
+	for _, article := range articles {
+		author, err := s.userRepo.GetUserByID(ctx, article.AuthorID)
+		if err != nil {
+			s.logger.Error("failed to get article author", "error", err, "author_id", article.AuthorID)
+			continue
+		}
+		article.Author = author
+	}
+	s.loadAuthorFollowing(ctx, userID, articles)
+	s.loadCommentsCounts(ctx, articles)
 
 	return articles, total, nil
 }
 
-// GetAllTags retrieves all unique tags
+// DefaultRelatedArticlesLimit is the number of related articles returned when
+// no limit is given
+const DefaultRelatedArticlesLimit = 5
+
+// maxRelatedArticlesLimit caps the number of related articles per request
+const maxRelatedArticlesLimit = 20
+
+// GetRelatedArticles retrieves other articles sharing the most tags with the
+// article identified by slug
+func (s *ArticleService) GetRelatedArticles(ctx context.Context, slug string, limit int, currentUserID *int64) ([]*domain.Article, error) {
+	article, err := loadVisibleArticle(ctx, s.articleRepo, slug, currentUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = DefaultRelatedArticlesLimit
+	}
+	if limit > maxRelatedArticlesLimit {
+		limit = maxRelatedArticlesLimit
+	}
+
+	articles, err := s.articleRepo.GetRelatedArticles(ctx, article.ID, limit, currentUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Load author information for each article
+	for _, related := range articles {
+		author, err := s.userRepo.GetUserByID(ctx, related.AuthorID)
+		if err != nil {
+			s.logger.Error("failed to get article author", "error", err, "author_id", related.AuthorID)
+			continue
+		}
+		related.Author = author
+	}
+	if currentUserID != nil {
+		s.loadAuthorFollowing(ctx, *currentUserID, articles)
+	}
+	s.loadCommentsCounts(ctx, articles)
+
+	return articles, nil
+}
+
+// recomputeCountsBatchSize is how many articles RecomputeCounts checks per query
+const recomputeCountsBatchSize = 500
+
+// RecomputeCounts recalculates every article's denormalized counters from
+// their source tables in batches, correcting and logging any that drifted
+// Only configured maintainers can run it
+func (s *ArticleService) RecomputeCounts(ctx context.Context, userID int64) (*domain.CountsRecomputation, error) {
+	if !slices.Contains(s.config.MaintainerIDs, userID) {
+		s.logger.Warn("unauthorized count recompute attempt", "attempted_by", userID)
+		return nil, domain.ErrForbidden
+	}
+
+	result := &domain.CountsRecomputation{Corrections: []domain.CountCorrection{}}
+	var afterID int64
+	for {
+		corrections, lastID, err := s.articleRepo.ReconcileFavoritesCounts(ctx, afterID, recomputeCountsBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range corrections {
+			s.logger.Info("denormalized count corrected",
+				"article_id", c.ArticleID,
+				"slug", c.Slug,
+				"counter", c.Counter,
+				"stored", c.Stored,
+				"actual", c.Actual,
+			)
+		}
+		result.Corrections = append(result.Corrections, corrections...)
+		if lastID == 0 {
+			break
+		}
+		afterID = lastID
+	}
+
+	s.logger.Info("denormalized counts recomputed",
+		"corrections", len(result.Corrections),
+		"requested_by", userID,
+	)
+
+	return result, nil
+}
+
+// GetAllTags retrieves all unique tags, served from a cache for
+// TagsCacheTTL when it is set
 func (s *ArticleService) GetAllTags(ctx context.Context) ([]string, error) {
-	return s.articleRepo.GetAllTags(ctx)
+	if s.config.TagsCacheTTL <= 0 {
+		return s.articleRepo.GetAllTags(ctx)
+	}
+
+	tags, generation, ok := s.tags.get()
+	if ok {
+		return tags, nil
+	}
+	tags, err := s.articleRepo.GetAllTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.tags.set(tags, generation, s.config.TagsCacheTTL)
+	return tags, nil
+}
+
+// invalidateTagsIfNew drops the cached tag list when tags adds to it
+func (s *ArticleService) invalidateTagsIfNew(tags []string) {
+	if !s.tags.containsAll(tags) {
+		s.tags.invalidate()
+	}
+}
+
+// GetPopularTags returns the most used tags with their article counts
+func (s *ArticleService) GetPopularTags(ctx context.Context, limit int) ([]domain.TagCount, error) {
+	// Apply defaults if not set
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	return s.articleRepo.GetTagCounts(ctx, limit)
+}
+
+// maxTagSuggestions caps the number of tags returned by SearchTags
+const maxTagSuggestions = 10
+
+// SearchTags returns tag names starting with prefix, ignoring case, for tag
+// autocompletion. An empty prefix returns the most used tags instead.
+func (s *ArticleService) SearchTags(ctx context.Context, prefix string) ([]string, error) {
+	prefix = strings.TrimSpace(prefix)
+	if prefix != "" {
+		return s.articleRepo.SearchTags(ctx, prefix, maxTagSuggestions)
+	}
+
+	counts, err := s.articleRepo.GetTagCounts(ctx, maxTagSuggestions)
+	if err != nil {
+		return nil, err
+	}
+	tags := make([]string, len(counts))
+	for i, tc := range counts {
+		tags[i] = tc.Name
+	}
+	return tags, nil
+}
+
+// ListFavoritingUsers returns a page of the profiles of users who favorited
+// the article, most recent first, and the total count. currentUserID is
+// optional - if provided, each profile's following flag is set for that user.
+func (s *ArticleService) ListFavoritingUsers(ctx context.Context, slug string, currentUserID *int64, limit, offset int) ([]*domain.Profile, int, error) {
+	article, err := loadVisibleArticle(ctx, s.articleRepo, slug, currentUserID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Apply defaults if not set
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	if err := validateOffset(offset, s.config.MaxOffset); err != nil {
+		return nil, 0, err
+	}
+
+	userIDs, total, err := s.favoriteRepo.GetFavoritingUsers(ctx, article.ID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(userIDs) == 0 {
+		return []*domain.Profile{}, total, nil
+	}
+
+	users, err := s.userRepo.GetProfilesByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	following := make(map[int64]bool)
+	if currentUserID != nil && *currentUserID != 0 {
+		following, err = s.followRepo.IsFollowingBulk(ctx, *currentUserID, userIDs)
+		if err != nil {
+			s.logger.Error("failed to check follow status",
+				"error", err,
+				"follower_id", *currentUserID,
+			)
+			following = make(map[int64]bool)
+		}
+	}
+
+	profiles := make([]*domain.Profile, 0, len(userIDs))
+	for _, id := range userIDs {
+		if user, ok := users[id]; ok {
+			profiles = append(profiles, domain.NewProfileFromUser(user, following[id]))
+		}
+	}
+
+	return profiles, total, nil
 }
 
 // FavoriteArticle adds a favorite to an article
 func (s *ArticleService) FavoriteArticle(ctx context.Context, slug string, userID int64) (*domain.Article, error) {
 	// Get article by slug
-	article, err := s.articleRepo.GetArticleBySlug(ctx, slug)
+	article, err := loadVisibleArticle(ctx, s.articleRepo, slug, &userID)
 	if err != nil {
 		return nil, err
 	}
@@ -275,6 +957,7 @@ func (s *ArticleService) FavoriteArticle(ctx context.Context, slug string, userI
 			"slug", slug,
 			"user_id", userID,
 		)
+		s.eventBus.Publish(ctx, events.Favorited{ArticleID: article.ID, Slug: slug, UserID: userID, Favorited: true})
 	}
 
 	// Reload article to get updated favorites count
@@ -293,6 +976,8 @@ func (s *ArticleService) FavoriteArticle(ctx context.Context, slug string, userI
 		return nil, err
 	}
 	article.Author = author
+	article.AuthorFollowing = s.isFollowingAuthor(ctx, userID, article)
+	s.loadCommentsCounts(ctx, []*domain.Article{article})
 
 	return article, nil
 }
@@ -300,7 +985,7 @@ func (s *ArticleService) FavoriteArticle(ctx context.Context, slug string, userI
 // UnfavoriteArticle removes a favorite from an article
 func (s *ArticleService) UnfavoriteArticle(ctx context.Context, slug string, userID int64) (*domain.Article, error) {
 	// Get article by slug
-	article, err := s.articleRepo.GetArticleBySlug(ctx, slug)
+	article, err := loadVisibleArticle(ctx, s.articleRepo, slug, &userID)
 	if err != nil {
 		return nil, err
 	}
@@ -322,6 +1007,7 @@ func (s *ArticleService) UnfavoriteArticle(ctx context.Context, slug string, use
 			"slug", slug,
 			"user_id", userID,
 		)
+		s.eventBus.Publish(ctx, events.Favorited{ArticleID: article.ID, Slug: slug, UserID: userID, Favorited: false})
 	}
 
 	// Reload article to get updated favorites count
@@ -340,21 +1026,234 @@ func (s *ArticleService) UnfavoriteArticle(ctx context.Context, slug string, use
 		return nil, err
 	}
 	article.Author = author
+	article.AuthorFollowing = s.isFollowingAuthor(ctx, userID, article)
+	s.loadCommentsCounts(ctx, []*domain.Article{article})
 
 	return article, nil
 }
 
+// isFollowingAuthor reports whether viewerID follows the article's author.
+// A failed lookup is logged and reported as not following.
+func (s *ArticleService) isFollowingAuthor(ctx context.Context, viewerID int64, article *domain.Article) bool {
+	if viewerID == 0 || viewerID == article.AuthorID {
+		return false
+	}
+	following, err := s.followRepo.IsFollowing(ctx, viewerID, article.AuthorID)
+	if err != nil {
+		s.logger.Error("failed to check follow status",
+			"error", err,
+			"follower_id", viewerID,
+		)
+		return false
+	}
+	return following
+}
+
+// loadAuthorFollowing sets AuthorFollowing on each article with one query
+// for all distinct authors. A failed lookup is logged and leaves the flags false.
+func (s *ArticleService) loadAuthorFollowing(ctx context.Context, viewerID int64, articles []*domain.Article) {
+	if viewerID == 0 || len(articles) == 0 {
+		return
+	}
+
+	seen := make(map[int64]bool)
+	authorIDs := make([]int64, 0)
+	for _, article := range articles {
+		if !seen[article.AuthorID] {
+			seen[article.AuthorID] = true
+			authorIDs = append(authorIDs, article.AuthorID)
+		}
+	}
+
+	following, err := s.followRepo.IsFollowingBulk(ctx, viewerID, authorIDs)
+	if err != nil {
+		s.logger.Error("failed to check follow status",
+			"error", err,
+			"follower_id", viewerID,
+		)
+		return
+	}
+
+	for _, article := range articles {
+		article.AuthorFollowing = following[article.AuthorID]
+	}
+}
+
+// loadCommentsCounts sets CommentsCount on each article with one query.
+// A failed count is logged and leaves the counts at 0.
+func (s *ArticleService) loadCommentsCounts(ctx context.Context, articles []*domain.Article) {
+	if len(articles) == 0 {
+		return
+	}
+
+	ids := make([]int64, 0, len(articles))
+	for _, article := range articles {
+		ids = append(ids, article.ID)
+	}
+
+	counts, err := s.commentRepo.CountByArticleIDs(ctx, ids)
+	if err != nil {
+		s.logger.Error("failed to count article comments", "error", err)
+		return
+	}
+
+	for _, article := range articles {
+		article.CommentsCount = counts[article.ID]
+	}
+}
+
 // validateCreateArticleInput validates article creation input
 func (s *ArticleService) validateCreateArticleInput(input *domain.CreateArticleInput) error {
+	return validateArticleFields(input.Title, input.Description, input.Body)
+}
+
+// checkTitleAvailable rejects a title the author already uses on another
+// article, drafts included, when UniqueTitlePerAuthor is enabled.
+// excludeArticleID skips the article being renamed (0 for new articles).
+func (s *ArticleService) checkTitleAvailable(ctx context.Context, authorID int64, title string, excludeArticleID int64) error {
+	if !s.config.UniqueTitlePerAuthor {
+		return nil
+	}
+	exists, err := s.articleRepo.AuthorHasTitle(ctx, authorID, title, excludeArticleID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		validationErrors := domain.NewValidationErrors()
+		validationErrors.Add("title", "you already have an article with this title")
+		return validationErrors
+	}
+	return nil
+}
+
+// newArticleSlug returns the author's custom slug once it is validated and
+// known to be free, or a unique slug derived from the title
+func (s *ArticleService) newArticleSlug(ctx context.Context, input *domain.CreateArticleInput) (string, error) {
+	if input.Slug == "" {
+		return util.GenerateUniqueSlug(input.Title, func(slug string) bool {
+			return s.articleRepo.SlugExists(ctx, slug)
+		}), nil
+	}
+
+	if !util.IsValidSlug(input.Slug) {
+		validationErrors := domain.NewValidationErrors()
+		validationErrors.Add("slug", "must contain only lowercase letters, numbers and hyphens")
+		return "", validationErrors
+	}
+	if s.articleRepo.SlugExists(ctx, input.Slug) {
+		return "", domain.ErrArticleAlreadyExists
+	}
+
+	return input.Slug, nil
+}
+
+// normalizeTags trims and lowercases tags, collapses internal whitespace and
+// drops duplicates, keeping the first occurrence. Blank tags and lists longer
+// than MaxTags are rejected.
+func (s *ArticleService) normalizeTags(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.Join(strings.Fields(strings.ToLower(tag)), " ")
+		if tag == "" {
+			validationErrors := domain.NewValidationErrors()
+			validationErrors.Add("tagList", "can't contain blank tags")
+			return nil, validationErrors
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			normalized = append(normalized, tag)
+		}
+	}
+
+	if s.config.MaxTags > 0 && len(normalized) > s.config.MaxTags {
+		validationErrors := domain.NewValidationErrors()
+		validationErrors.Add("tagList", fmt.Sprintf("can't have more than %d tags", s.config.MaxTags))
+		return nil, validationErrors
+	}
+
+	return normalized, nil
+}
+
+// validateCuratedTags rejects tags missing from the tags table when the
+// taxonomy is curated
+func (s *ArticleService) validateCuratedTags(ctx context.Context, tags []string) error {
+	if !s.config.CuratedTags || len(tags) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			names = append(names, tag)
+		}
+	}
+
+	existing, err := s.articleRepo.GetExistingTags(ctx, names)
+	if err != nil {
+		return err
+	}
+
 	validationErrors := domain.NewValidationErrors()
+	for _, name := range names {
+		if !existing[name] {
+			validationErrors.Add("tagList", "unknown tag: "+name)
+		}
+	}
 
-	if strings.TrimSpace(input.Title) == "" {
+	if validationErrors.HasErrors() {
+		return validationErrors
+	}
+
+	return nil
+}
+
+// validateMarkdown rejects bodies that fail the markdown lint when enabled
+func (s *ArticleService) validateMarkdown(body string) error {
+	if !s.config.LintMarkdown {
+		return nil
+	}
+
+	validationErrors := domain.NewValidationErrors()
+	for _, problem := range domain.LintMarkdown(body) {
+		validationErrors.Add("body", problem)
+	}
+
+	if validationErrors.HasErrors() {
+		return validationErrors
+	}
+
+	return nil
+}
+
+// validatePlainText rejects HTML in the title and description when enabled
+func (s *ArticleService) validatePlainText(title, description string) error {
+	if !s.config.RejectHTML {
+		return nil
+	}
+
+	validationErrors := domain.NewValidationErrors()
+	addHTMLError(validationErrors, "title", title)
+	addHTMLError(validationErrors, "description", description)
+
+	if validationErrors.HasErrors() {
+		return validationErrors
+	}
+
+	return nil
+}
+
+// validateArticleFields applies the full validation required of published articles
+func validateArticleFields(title, description, body string) error {
+	validationErrors := domain.NewValidationErrors()
+
+	if strings.TrimSpace(title) == "" {
 		validationErrors.Add("title", "can't be blank")
 	}
-	if strings.TrimSpace(input.Description) == "" {
+	if strings.TrimSpace(description) == "" {
 		validationErrors.Add("description", "can't be blank")
 	}
-	if strings.TrimSpace(input.Body) == "" {
+	if strings.TrimSpace(body) == "" {
 		validationErrors.Add("body", "can't be blank")
 	}
 
@@ -364,3 +1263,13 @@ func (s *ArticleService) validateCreateArticleInput(input *domain.CreateArticleI
 
 	return nil
 }
+
+// utcTime normalizes an optional timestamp to UTC so stored values compare
+// consistently with the query-time clock
+func utcTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
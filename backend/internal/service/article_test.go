@@ -3,13 +3,18 @@ package service
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"log/slog"
 	"os"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	_ "github.com/mattn/go-sqlite3"
 
 	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
+	"github.com/alexlee0213/realworld-conduit/backend/internal/events"
 	"github.com/alexlee0213/realworld-conduit/backend/internal/repository"
 )
 
@@ -26,6 +31,7 @@ func setupArticleTestDB(t *testing.T) *sql.DB {
 	// Drop existing tables for clean state between tests
 	db.Exec("DROP TABLE IF EXISTS article_tags")
 	db.Exec("DROP TABLE IF EXISTS favorites")
+	db.Exec("DROP TABLE IF EXISTS blocks")
 	db.Exec("DROP TABLE IF EXISTS follows")
 	db.Exec("DROP TABLE IF EXISTS tags")
 	db.Exec("DROP TABLE IF EXISTS articles")
@@ -56,6 +62,12 @@ func setupArticleTestDB(t *testing.T) *sql.DB {
 			title TEXT NOT NULL,
 			description TEXT NOT NULL DEFAULT '',
 			body TEXT NOT NULL DEFAULT '',
+			cover_image TEXT NOT NULL DEFAULT '',
+			published BOOLEAN NOT NULL DEFAULT 1,
+			comments_enabled BOOLEAN NOT NULL DEFAULT 1,
+			publish_at TIMESTAMP,
+			view_count INTEGER NOT NULL DEFAULT 0,
+			favorites_count INTEGER NOT NULL DEFAULT 0,
 			author_id INTEGER NOT NULL,
 			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
 			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
@@ -107,6 +119,35 @@ func setupArticleTestDB(t *testing.T) *sql.DB {
 		t.Fatalf("failed to create favorites table: %v", err)
 	}
 
+	// Create slug redirects table
+	_, err = db.Exec(`
+		CREATE TABLE slug_redirects (
+			old_slug TEXT PRIMARY KEY,
+			article_id INTEGER NOT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+		)
+	`)
+	if err != nil {
+		t.Fatalf("failed to create slug_redirects table: %v", err)
+	}
+
+	// Create article revisions table
+	_, err = db.Exec(`
+		CREATE TABLE article_revisions (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			article_id INTEGER NOT NULL,
+			title TEXT NOT NULL,
+			description TEXT NOT NULL,
+			body TEXT NOT NULL,
+			updated_at TIMESTAMP NOT NULL,
+			FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+		)
+	`)
+	if err != nil {
+		t.Fatalf("failed to create article_revisions table: %v", err)
+	}
+
 	// Create follows table
 	_, err = db.Exec(`
 		CREATE TABLE follows (
@@ -122,6 +163,39 @@ func setupArticleTestDB(t *testing.T) *sql.DB {
 		t.Fatalf("failed to create follows table: %v", err)
 	}
 
+	// Create blocks table
+	_, err = db.Exec(`
+		CREATE TABLE blocks (
+			blocker_id INTEGER NOT NULL,
+			blocked_id INTEGER NOT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (blocker_id, blocked_id),
+			FOREIGN KEY (blocker_id) REFERENCES users(id) ON DELETE CASCADE,
+			FOREIGN KEY (blocked_id) REFERENCES users(id) ON DELETE CASCADE
+		)
+	`)
+	if err != nil {
+		t.Fatalf("failed to create blocks table: %v", err)
+	}
+
+	// Create comments table
+	_, err = db.Exec(`
+		CREATE TABLE comments (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			body TEXT NOT NULL,
+			article_id INTEGER NOT NULL,
+			author_id INTEGER NOT NULL,
+			parent_id INTEGER REFERENCES comments(id) ON DELETE SET NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE,
+			FOREIGN KEY (author_id) REFERENCES users(id) ON DELETE CASCADE
+		)
+	`)
+	if err != nil {
+		t.Fatalf("failed to create comments table: %v", err)
+	}
+
 	return db
 }
 
@@ -137,8 +211,11 @@ func newTestArticleService(t *testing.T) (*ArticleService, *sql.DB) {
 	logger := newArticleTestLogger()
 	articleRepo := repository.NewSQLiteArticleRepository(db, logger)
 	userRepo := repository.NewSQLiteUserRepository(db, logger)
+	followRepo := repository.NewSQLiteFollowRepository(db, logger)
+	commentRepo := repository.NewSQLiteCommentRepository(db, logger)
+	favoriteRepo := repository.NewSQLiteFavoriteRepository(db, logger)
 
-	articleService := NewArticleService(articleRepo, userRepo, logger)
+	articleService := NewArticleService(articleRepo, userRepo, followRepo, commentRepo, favoriteRepo, logger)
 	return articleService, db
 }
 
@@ -254,6 +331,32 @@ func TestArticleService_CreateArticle(t *testing.T) {
 		}
 	})
 
+	t.Run("reports every invalid field together", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+		ctx := context.Background()
+
+		_, err := service.CreateArticle(ctx, userID, &domain.CreateArticleInput{
+			CoverImage: "javascript:alert(1)",
+			TagList:    []string{"go", " "},
+		})
+		validationErrors, ok := err.(*domain.ValidationErrors)
+		if !ok {
+			t.Fatalf("expected ValidationErrors, got %v", err)
+		}
+		fields := make(map[string]bool)
+		for _, e := range validationErrors.Errors {
+			fields[e.Field] = true
+		}
+		for _, field := range []string{"title", "description", "body", "tagList", "coverImage"} {
+			if !fields[field] {
+				t.Errorf("expected an error for %s, got %v", field, validationErrors.Errors)
+			}
+		}
+	})
+
 	t.Run("creates article with nil TagList", func(t *testing.T) {
 		service, db := newTestArticleService(t)
 		defer db.Close()
@@ -276,139 +379,269 @@ func TestArticleService_CreateArticle(t *testing.T) {
 			t.Error("expected empty slice, got nil")
 		}
 	})
-}
 
-// =============================================================================
-// GetArticleBySlug Tests
-// =============================================================================
+	t.Run("de-duplicates slugs for same-titled articles", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
 
-func TestArticleService_GetArticleBySlug(t *testing.T) {
-	t.Run("successfully gets article by slug", func(t *testing.T) {
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+		ctx := context.Background()
+
+		for _, want := range []string{"hello-world", "hello-world-1", "hello-world-2"} {
+			article, err := service.CreateArticle(ctx, userID, &domain.CreateArticleInput{
+				Title:       "Hello World",
+				Description: "Test description",
+				Body:        "Test body content",
+			})
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if article.Slug != want {
+				t.Errorf("expected slug %s, got %s", want, article.Slug)
+			}
+		}
+	})
+
+	t.Run("generates a slug for non-ASCII titles", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+		ctx := context.Background()
+
+		for _, title := range []string{"안녕하세요 세계", "🎉🚀", "?!..."} {
+			article, err := service.CreateArticle(ctx, userID, &domain.CreateArticleInput{
+				Title:       title,
+				Description: "Test description",
+				Body:        "Test body content",
+			})
+			if err != nil {
+				t.Fatalf("expected no error for %q, got %v", title, err)
+			}
+			if article.Slug == "" {
+				t.Errorf("expected non-empty slug for %q", title)
+			}
+		}
+	})
+}
+
+func TestArticleService_CoverImage(t *testing.T) {
+	t.Run("uses explicitly set cover image", func(t *testing.T) {
 		service, db := newTestArticleService(t)
 		defer db.Close()
 
 		userID := createTestUser(t, db, "testuser", "test@example.com")
 		ctx := context.Background()
 
-		// Create an article first
 		input := &domain.CreateArticleInput{
-			Title:       "Test Article",
+			Title:       "Explicit Cover",
 			Description: "Test description",
-			Body:        "Test body content",
+			Body:        "![inline](https://example.com/inline.png)",
+			CoverImage:  "https://example.com/cover.png",
 		}
-		created, err := service.CreateArticle(ctx, userID, input)
+
+		article, err := service.CreateArticle(ctx, userID, input)
 		if err != nil {
-			t.Fatalf("failed to create article: %v", err)
+			t.Fatalf("expected no error, got %v", err)
 		}
 
-		// Get by slug
-		article, err := service.GetArticleBySlug(ctx, created.Slug, nil)
+		stored, err := service.GetArticleBySlug(ctx, article.Slug, nil)
 		if err != nil {
 			t.Fatalf("expected no error, got %v", err)
 		}
-		if article.Title != "Test Article" {
-			t.Errorf("expected title 'Test Article', got '%s'", article.Title)
-		}
-		if article.Author == nil {
-			t.Error("expected author to be loaded")
+		if stored.CoverImage != "https://example.com/cover.png" {
+			t.Errorf("expected explicit cover image, got '%s'", stored.CoverImage)
 		}
 	})
 
-	t.Run("fails for non-existent slug", func(t *testing.T) {
+	t.Run("extracts cover image from body", func(t *testing.T) {
 		service, db := newTestArticleService(t)
 		defer db.Close()
 
+		userID := createTestUser(t, db, "testuser", "test@example.com")
 		ctx := context.Background()
 
-		_, err := service.GetArticleBySlug(ctx, "non-existent-slug", nil)
-		if err != domain.ErrArticleNotFound {
-			t.Errorf("expected ErrArticleNotFound, got %v", err)
+		input := &domain.CreateArticleInput{
+			Title:       "Auto Cover",
+			Description: "Test description",
+			Body:        "Intro\n\n![first](https://example.com/first.png)\n![second](https://example.com/second.png)",
 		}
-	})
-}
 
-// =============================================================================
-// UpdateArticle Tests
-// =============================================================================
+		article, err := service.CreateArticle(ctx, userID, input)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
 
-func TestArticleService_UpdateArticle(t *testing.T) {
-	t.Run("successfully updates article title", func(t *testing.T) {
+		stored, err := service.GetArticleBySlug(ctx, article.Slug, nil)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if stored.CoverImage != "https://example.com/first.png" {
+			t.Errorf("expected first body image as cover, got '%s'", stored.CoverImage)
+		}
+
+		// Changing the body keeps an auto-extracted cover in sync
+		newBody := "![replacement](https://example.com/replacement.png)"
+		updated, err := service.UpdateArticle(ctx, article.Slug, userID, &domain.UpdateArticleInput{Body: &newBody})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if updated.CoverImage != "https://example.com/replacement.png" {
+			t.Errorf("expected cover to follow body, got '%s'", updated.CoverImage)
+		}
+	})
+
+	t.Run("leaves cover image empty when no image present", func(t *testing.T) {
 		service, db := newTestArticleService(t)
 		defer db.Close()
 
 		userID := createTestUser(t, db, "testuser", "test@example.com")
 		ctx := context.Background()
 
-		// Create an article
 		input := &domain.CreateArticleInput{
-			Title:       "Original Title",
+			Title:       "No Cover",
 			Description: "Test description",
-			Body:        "Test body content",
+			Body:        "Plain text body",
 		}
-		created, _ := service.CreateArticle(ctx, userID, input)
 
-		// Update title
-		newTitle := "Updated Title"
-		updateInput := &domain.UpdateArticleInput{
-			Title: &newTitle,
+		article, err := service.CreateArticle(ctx, userID, input)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if article.CoverImage != "" {
+			t.Errorf("expected empty cover image, got '%s'", article.CoverImage)
 		}
+	})
 
-		updated, err := service.UpdateArticle(ctx, created.Slug, userID, updateInput)
+	t.Run("rejects a cover image that isn't an http(s) URL", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+		ctx := context.Background()
+
+		for _, coverImage := range []string{"not a url", "/relative.png", "javascript:alert(1)", "ftp://example.com/a.png", "https://"} {
+			_, err := service.CreateArticle(ctx, userID, &domain.CreateArticleInput{
+				Title:       "Bad Cover",
+				Description: "Test description",
+				Body:        "Test body",
+				CoverImage:  coverImage,
+			})
+			validationErr, ok := err.(*domain.ValidationErrors)
+			if !ok {
+				t.Fatalf("expected ValidationErrors for %q, got %T", coverImage, err)
+			}
+			if len(validationErr.Errors) != 1 || validationErr.Errors[0].Field != "coverImage" {
+				t.Errorf("expected a single coverImage error for %q, got %+v", coverImage, validationErr.Errors)
+			}
+		}
+	})
+
+	t.Run("rejects an invalid cover image on update", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+		ctx := context.Background()
+
+		created, err := service.CreateArticle(ctx, userID, &domain.CreateArticleInput{
+			Title:       "Cover",
+			Description: "Test description",
+			Body:        "Test body",
+			CoverImage:  "http://example.com/cover.png",
+		})
 		if err != nil {
 			t.Fatalf("expected no error, got %v", err)
 		}
-		if updated.Title != "Updated Title" {
-			t.Errorf("expected title 'Updated Title', got '%s'", updated.Title)
+
+		badCover := "data:image/png;base64,AAAA"
+		_, err = service.UpdateArticle(ctx, created.Slug, userID, &domain.UpdateArticleInput{CoverImage: &badCover})
+		if _, ok := err.(*domain.ValidationErrors); !ok {
+			t.Errorf("expected ValidationErrors, got %v", err)
 		}
 	})
+}
 
-	t.Run("fails when non-author tries to update", func(t *testing.T) {
+func TestArticleService_RejectHTML(t *testing.T) {
+	t.Run("rejects HTML in title when enabled", func(t *testing.T) {
 		service, db := newTestArticleService(t)
 		defer db.Close()
 
-		authorID := createTestUser(t, db, "author", "author@example.com")
-		otherUserID := createTestUser(t, db, "other", "other@example.com")
+		config := DefaultArticleServiceConfig()
+		config.RejectHTML = true
+		service.SetConfig(config)
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
 		ctx := context.Background()
 
-		// Create an article as author
 		input := &domain.CreateArticleInput{
-			Title:       "Original Title",
+			Title:       "Hello <script>alert(1)</script>",
 			Description: "Test description",
-			Body:        "Test body content",
+			Body:        "Body may contain <b>HTML</b>",
 		}
-		created, _ := service.CreateArticle(ctx, authorID, input)
 
-		// Try to update as different user
-		newTitle := "Hacked Title"
-		updateInput := &domain.UpdateArticleInput{
-			Title: &newTitle,
+		_, err := service.CreateArticle(ctx, userID, input)
+		validationErr, ok := err.(*domain.ValidationErrors)
+		if !ok {
+			t.Fatalf("expected ValidationErrors, got %T", err)
+		}
+		if len(validationErr.Errors) != 1 || validationErr.Errors[0].Field != "title" {
+			t.Errorf("expected a single title error, got %+v", validationErr.Errors)
 		}
+	})
 
-		_, err := service.UpdateArticle(ctx, created.Slug, otherUserID, updateInput)
-		if err != domain.ErrForbidden {
-			t.Errorf("expected ErrForbidden, got %v", err)
+	t.Run("accepts angle brackets that are not tags", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		config := DefaultArticleServiceConfig()
+		config.RejectHTML = true
+		service.SetConfig(config)
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+		ctx := context.Background()
+
+		input := &domain.CreateArticleInput{
+			Title:       "Why a < b matters",
+			Description: "x<3 and y>2",
+			Body:        "Test body",
+		}
+
+		if _, err := service.CreateArticle(ctx, userID, input); err != nil {
+			t.Fatalf("expected no error, got %v", err)
 		}
 	})
 
-	t.Run("fails for non-existent article", func(t *testing.T) {
+	t.Run("rejects HTML in description on update", func(t *testing.T) {
 		service, db := newTestArticleService(t)
 		defer db.Close()
 
+		config := DefaultArticleServiceConfig()
+		config.RejectHTML = true
+		service.SetConfig(config)
+
 		userID := createTestUser(t, db, "testuser", "test@example.com")
 		ctx := context.Background()
 
-		newTitle := "New Title"
-		updateInput := &domain.UpdateArticleInput{
-			Title: &newTitle,
+		article, err := service.CreateArticle(ctx, userID, &domain.CreateArticleInput{
+			Title:       "Plain Title",
+			Description: "Plain description",
+			Body:        "Test body",
+		})
+		if err != nil {
+			t.Fatalf("failed to create article: %v", err)
 		}
 
-		_, err := service.UpdateArticle(ctx, "non-existent-slug", userID, updateInput)
-		if err != domain.ErrArticleNotFound {
-			t.Errorf("expected ErrArticleNotFound, got %v", err)
+		description := "<img src=x onerror=alert(1)>"
+		_, err = service.UpdateArticle(ctx, article.Slug, userID, &domain.UpdateArticleInput{
+			Description: &description,
+		})
+		if _, ok := err.(*domain.ValidationErrors); !ok {
+			t.Fatalf("expected ValidationErrors, got %T", err)
 		}
 	})
 
-	t.Run("updates description and body", func(t *testing.T) {
+	t.Run("allows HTML by default", func(t *testing.T) {
 		service, db := newTestArticleService(t)
 		defer db.Close()
 
@@ -416,60 +649,1424 @@ func TestArticleService_UpdateArticle(t *testing.T) {
 		ctx := context.Background()
 
 		input := &domain.CreateArticleInput{
-			Title:       "Original Title",
-			Description: "Original description",
-			Body:        "Original body",
+			Title:       "Hello <em>world</em>",
+			Description: "Test description",
+			Body:        "Test body",
 		}
-		created, _ := service.CreateArticle(ctx, userID, input)
 
-		newDesc := "Updated description"
-		newBody := "Updated body"
-		updateInput := &domain.UpdateArticleInput{
-			Description: &newDesc,
-			Body:        &newBody,
+		if _, err := service.CreateArticle(ctx, userID, input); err != nil {
+			t.Fatalf("expected no error, got %v", err)
 		}
+	})
+}
 
-		updated, err := service.UpdateArticle(ctx, created.Slug, userID, updateInput)
-		if err != nil {
-			t.Fatalf("expected no error, got %v", err)
+// isTitleValidationError reports whether err is a validation error on title
+func isTitleValidationError(err error) bool {
+	validationErr, ok := err.(*domain.ValidationErrors)
+	return ok && len(validationErr.Errors) == 1 && validationErr.Errors[0].Field == "title"
+}
+
+func TestArticleService_UniqueTitlePerAuthor(t *testing.T) {
+	input := func() *domain.CreateArticleInput {
+		return &domain.CreateArticleInput{
+			Title:       "My Article",
+			Description: "Test description",
+			Body:        "Test body",
 		}
-		if updated.Description != "Updated description" {
-			t.Errorf("expected description 'Updated description', got '%s'", updated.Description)
+	}
+
+	t.Run("rejects duplicate title when enabled", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		config := DefaultArticleServiceConfig()
+		config.UniqueTitlePerAuthor = true
+		service.SetConfig(config)
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+		ctx := context.Background()
+
+		if _, err := service.CreateArticle(ctx, userID, input()); err != nil {
+			t.Fatalf("failed to create article: %v", err)
 		}
-		if updated.Body != "Updated body" {
-			t.Errorf("expected body 'Updated body', got '%s'", updated.Body)
+
+		duplicate := input()
+		duplicate.Title = " my article "
+		_, err := service.CreateArticle(ctx, userID, duplicate)
+		validationErr, ok := err.(*domain.ValidationErrors)
+		if !ok {
+			t.Fatalf("expected ValidationErrors, got %T", err)
+		}
+		if len(validationErr.Errors) != 1 || validationErr.Errors[0].Field != "title" {
+			t.Errorf("expected a single title error, got %+v", validationErr.Errors)
 		}
-	})
-}
 
-// =============================================================================
-// DeleteArticle Tests
-// =============================================================================
+		// Another author may still use the title
+		otherID := createTestUser(t, db, "otheruser", "other@example.com")
+		if _, err := service.CreateArticle(ctx, otherID, input()); err != nil {
+			t.Errorf("expected other author to succeed, got %v", err)
+		}
+	})
 
-func TestArticleService_DeleteArticle(t *testing.T) {
-	t.Run("successfully deletes article", func(t *testing.T) {
+	t.Run("rejects a duplicate title saved as a draft", func(t *testing.T) {
 		service, db := newTestArticleService(t)
 		defer db.Close()
 
+		config := DefaultArticleServiceConfig()
+		config.UniqueTitlePerAuthor = true
+		service.SetConfig(config)
+
 		userID := createTestUser(t, db, "testuser", "test@example.com")
 		ctx := context.Background()
 
-		input := &domain.CreateArticleInput{
-			Title:       "To Delete",
-			Description: "Test description",
+		if _, err := service.CreateArticle(ctx, userID, input()); err != nil {
+			t.Fatalf("failed to create article: %v", err)
+		}
+
+		// Without the check the draft could be published next to the original
+		published := false
+		draft := input()
+		draft.Published = &published
+		if _, err := service.CreateArticle(ctx, userID, draft); !isTitleValidationError(err) {
+			t.Errorf("expected a title error for a draft via CreateArticle, got %v", err)
+		}
+		if _, err := service.SaveDraft(ctx, userID, input()); !isTitleValidationError(err) {
+			t.Errorf("expected a title error for SaveDraft, got %v", err)
+		}
+
+		articles, total, err := service.ListArticles(ctx, &domain.ArticleListParams{Author: "testuser", IncludeDrafts: true, Limit: 20}, &userID)
+		if err != nil {
+			t.Fatalf("failed to list articles: %v", err)
+		}
+		if total != 1 || len(articles) != 1 {
+			t.Errorf("expected only the original article, got %d", total)
+		}
+	})
+
+	t.Run("rejects publishing under the title of an existing draft", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		config := DefaultArticleServiceConfig()
+		config.UniqueTitlePerAuthor = true
+		service.SetConfig(config)
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+		ctx := context.Background()
+
+		if _, err := service.SaveDraft(ctx, userID, input()); err != nil {
+			t.Fatalf("failed to save draft: %v", err)
+		}
+		if _, err := service.CreateArticle(ctx, userID, input()); !isTitleValidationError(err) {
+			t.Errorf("expected a title error, got %v", err)
+		}
+	})
+
+	t.Run("rejects renaming to a title the author already has", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		config := DefaultArticleServiceConfig()
+		config.UniqueTitlePerAuthor = true
+		service.SetConfig(config)
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+		ctx := context.Background()
+
+		if _, err := service.CreateArticle(ctx, userID, input()); err != nil {
+			t.Fatalf("failed to create article: %v", err)
+		}
+		other := input()
+		other.Title = "Another Article"
+		renamed, err := service.CreateArticle(ctx, userID, other)
+		if err != nil {
+			t.Fatalf("failed to create article: %v", err)
+		}
+
+		duplicate := "MY ARTICLE"
+		_, err = service.UpdateArticle(ctx, renamed.Slug, userID, &domain.UpdateArticleInput{Title: &duplicate})
+		if !isTitleValidationError(err) {
+			t.Errorf("expected a title error, got %v", err)
+		}
+
+		// Changing only the case of its own title is still allowed
+		ownTitle := "another article"
+		if _, err := service.UpdateArticle(ctx, renamed.Slug, userID, &domain.UpdateArticleInput{Title: &ownTitle}); err != nil {
+			t.Errorf("expected renaming to its own title to succeed, got %v", err)
+		}
+	})
+
+	t.Run("allows duplicate title by default", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+		ctx := context.Background()
+
+		first, err := service.CreateArticle(ctx, userID, input())
+		if err != nil {
+			t.Fatalf("failed to create article: %v", err)
+		}
+		second, err := service.CreateArticle(ctx, userID, input())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if first.Slug == second.Slug {
+			t.Error("expected distinct slugs for duplicate titles")
+		}
+	})
+}
+
+func TestArticleService_NormalizeTags(t *testing.T) {
+	t.Run("deduplicates tags that differ only in case and spacing", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+		ctx := context.Background()
+
+		article, err := service.CreateArticle(ctx, userID, &domain.CreateArticleInput{
+			Title:       "Test Article",
+			Description: "Test description",
+			Body:        "Test body",
+			TagList:     []string{"go", "Go", " go ", "Web   Dev"},
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if got := strings.Join(article.TagList, ","); got != "go,web dev" {
+			t.Errorf("expected tags [go web dev], got %v", article.TagList)
+		}
+
+		stored, err := service.GetArticleBySlug(ctx, article.Slug, nil)
+		if err != nil {
+			t.Fatalf("failed to load article: %v", err)
+		}
+		if len(stored.TagList) != 2 {
+			t.Errorf("expected 2 stored tags, got %v", stored.TagList)
+		}
+	})
+
+	t.Run("rejects blank tags", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+
+		_, err := service.CreateArticle(context.Background(), userID, &domain.CreateArticleInput{
+			Title:       "Test Article",
+			Description: "Test description",
+			Body:        "Test body",
+			TagList:     []string{"go", "   "},
+		})
+		validationErr, ok := err.(*domain.ValidationErrors)
+		if !ok {
+			t.Fatalf("expected ValidationErrors, got %v", err)
+		}
+		if validationErr.Errors[0].Field != "tagList" {
+			t.Errorf("expected tagList error, got %+v", validationErr.Errors)
+		}
+	})
+
+	t.Run("enforces the tag cap after deduplication", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		config := DefaultArticleServiceConfig()
+		config.MaxTags = 2
+		service.SetConfig(config)
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+		ctx := context.Background()
+
+		// Duplicates don't count towards the cap
+		if _, err := service.CreateArticle(ctx, userID, &domain.CreateArticleInput{
+			Title:       "At The Cap",
+			Description: "Test description",
+			Body:        "Test body",
+			TagList:     []string{"go", "GO", "web"},
+		}); err != nil {
+			t.Fatalf("expected no error at the cap, got %v", err)
+		}
+
+		_, err := service.CreateArticle(ctx, userID, &domain.CreateArticleInput{
+			Title:       "Over The Cap",
+			Description: "Test description",
+			Body:        "Test body",
+			TagList:     []string{"go", "web", "testing"},
+		})
+		validationErr, ok := err.(*domain.ValidationErrors)
+		if !ok {
+			t.Fatalf("expected ValidationErrors, got %v", err)
+		}
+		if validationErr.Errors[0].Field != "tagList" {
+			t.Errorf("expected tagList error, got %+v", validationErr.Errors)
+		}
+
+		// Drafts are held to the same cap
+		draft := false
+		_, err = service.CreateArticle(ctx, userID, &domain.CreateArticleInput{
+			Title:     "Draft Over The Cap",
+			TagList:   []string{"go", "web", "testing"},
+			Published: &draft,
+		})
+		if _, ok := err.(*domain.ValidationErrors); !ok {
+			t.Errorf("expected ValidationErrors for draft, got %v", err)
+		}
+	})
+}
+
+func TestArticleService_CuratedTags(t *testing.T) {
+	t.Run("rejects unknown tags when curated", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		if _, err := db.Exec(`INSERT INTO tags (name) VALUES ('go')`); err != nil {
+			t.Fatalf("failed to seed tag: %v", err)
+		}
+
+		config := DefaultArticleServiceConfig()
+		config.CuratedTags = true
+		service.SetConfig(config)
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+		ctx := context.Background()
+
+		_, err := service.CreateArticle(ctx, userID, &domain.CreateArticleInput{
+			Title:       "Test Article",
+			Description: "Test description",
+			Body:        "Test body",
+			TagList:     []string{"go", "brand-new", "another"},
+		})
+		validationErr, ok := err.(*domain.ValidationErrors)
+		if !ok {
+			t.Fatalf("expected ValidationErrors, got %T", err)
+		}
+		if len(validationErr.Errors) != 2 {
+			t.Fatalf("expected 2 unknown tag errors, got %+v", validationErr.Errors)
+		}
+		for _, ve := range validationErr.Errors {
+			if ve.Field != "tagList" {
+				t.Errorf("expected tagList field, got %q", ve.Field)
+			}
+		}
+
+		tags, _ := service.GetAllTags(ctx)
+		if len(tags) != 1 {
+			t.Errorf("expected no new tags to be created, got %v", tags)
+		}
+	})
+
+	t.Run("accepts existing tags when curated", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		if _, err := db.Exec(`INSERT INTO tags (name) VALUES ('go')`); err != nil {
+			t.Fatalf("failed to seed tag: %v", err)
+		}
+
+		config := DefaultArticleServiceConfig()
+		config.CuratedTags = true
+		service.SetConfig(config)
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+
+		article, err := service.CreateArticle(context.Background(), userID, &domain.CreateArticleInput{
+			Title:       "Test Article",
+			Description: "Test description",
+			Body:        "Test body",
+			TagList:     []string{"go"},
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(article.TagList) != 1 || article.TagList[0] != "go" {
+			t.Errorf("expected tagList [go], got %v", article.TagList)
+		}
+	})
+
+	t.Run("creates new tags by default", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+
+		_, err := service.CreateArticle(context.Background(), userID, &domain.CreateArticleInput{
+			Title:       "Test Article",
+			Description: "Test description",
+			Body:        "Test body",
+			TagList:     []string{"brand-new"},
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+}
+
+func TestArticleService_LintMarkdown(t *testing.T) {
+	newService := func(t *testing.T) (*ArticleService, *sql.DB) {
+		service, db := newTestArticleService(t)
+		config := DefaultArticleServiceConfig()
+		config.LintMarkdown = true
+		service.SetConfig(config)
+		return service, db
+	}
+
+	t.Run("accepts a valid body", func(t *testing.T) {
+		service, db := newService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+
+		_, err := service.CreateArticle(context.Background(), userID, &domain.CreateArticleInput{
+			Title:       "Test Article",
+			Description: "Test description",
+			Body:        "Example:\n\n```go\nfmt.Println(\"hi\")\n```\n",
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("rejects an unterminated code fence", func(t *testing.T) {
+		service, db := newService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+
+		_, err := service.CreateArticle(context.Background(), userID, &domain.CreateArticleInput{
+			Title:       "Test Article",
+			Description: "Test description",
+			Body:        "Example:\n\n```go\nfmt.Println(\"hi\")\n",
+		})
+		validationErr, ok := err.(*domain.ValidationErrors)
+		if !ok {
+			t.Fatalf("expected ValidationErrors, got %T", err)
+		}
+		if len(validationErr.Errors) != 1 || validationErr.Errors[0].Field != "body" {
+			t.Errorf("expected a single body error, got %+v", validationErr.Errors)
+		}
+	})
+
+	t.Run("skips the lint by default", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+
+		_, err := service.CreateArticle(context.Background(), userID, &domain.CreateArticleInput{
+			Title:       "Test Article",
+			Description: "Test description",
+			Body:        "```\nnever closed",
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+}
+
+func TestArticleService_CustomSlug(t *testing.T) {
+	newInput := func(slug string) *domain.CreateArticleInput {
+		return &domain.CreateArticleInput{
+			Title:       "Some Title",
+			Slug:        slug,
+			Description: "Test description",
+			Body:        "Test body",
+		}
+	}
+
+	t.Run("uses a valid custom slug", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+
+		article, err := service.CreateArticle(context.Background(), userID, newInput("my-custom-slug"))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if article.Slug != "my-custom-slug" {
+			t.Errorf("expected slug my-custom-slug, got %s", article.Slug)
+		}
+	})
+
+	t.Run("rejects invalid characters", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+
+		_, err := service.CreateArticle(context.Background(), userID, newInput("Not_A Slug!"))
+		validationErr, ok := err.(*domain.ValidationErrors)
+		if !ok {
+			t.Fatalf("expected ValidationErrors, got %T", err)
+		}
+		if len(validationErr.Errors) != 1 || validationErr.Errors[0].Field != "slug" {
+			t.Errorf("expected a single slug error, got %+v", validationErr.Errors)
+		}
+	})
+
+	t.Run("rejects a slug that is taken", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+		ctx := context.Background()
+
+		if _, err := service.CreateArticle(ctx, userID, newInput("taken")); err != nil {
+			t.Fatalf("failed to create first article: %v", err)
+		}
+
+		_, err := service.CreateArticle(ctx, userID, newInput("taken"))
+		if err != domain.ErrArticleAlreadyExists {
+			t.Errorf("expected ErrArticleAlreadyExists, got %v", err)
+		}
+	})
+
+	t.Run("falls back to the title when omitted", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+
+		article, err := service.CreateArticle(context.Background(), userID, newInput(""))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if article.Slug != "some-title" {
+			t.Errorf("expected slug some-title, got %s", article.Slug)
+		}
+	})
+}
+
+// =============================================================================
+// SaveDraft / Publish Tests
+// =============================================================================
+
+func TestArticleService_SaveDraftAndPublish(t *testing.T) {
+	t.Run("saves a draft with empty body but rejects publishing it", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+		ctx := context.Background()
+
+		draft, err := service.SaveDraft(ctx, userID, &domain.CreateArticleInput{
+			Title: "Work In Progress",
+		})
+		if err != nil {
+			t.Fatalf("expected draft to save, got %v", err)
+		}
+		if draft.Published {
+			t.Error("expected draft to be unpublished")
+		}
+
+		_, err = service.Publish(ctx, draft.Slug, userID)
+		validationErr, ok := err.(*domain.ValidationErrors)
+		if !ok {
+			t.Fatalf("expected ValidationErrors, got %T", err)
+		}
+		hasBodyError := false
+		for _, e := range validationErr.Errors {
+			if e.Field == "body" {
+				hasBodyError = true
+			}
+		}
+		if !hasBodyError {
+			t.Error("expected body validation error")
+		}
+
+		stored, err := service.GetArticleBySlug(ctx, draft.Slug, &userID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if stored.Published {
+			t.Error("expected article to remain unpublished after rejected publish")
+		}
+	})
+
+	t.Run("publishes once the draft is complete", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+		ctx := context.Background()
+
+		draft, err := service.SaveDraft(ctx, userID, &domain.CreateArticleInput{
+			Title: "Work In Progress",
+		})
+		if err != nil {
+			t.Fatalf("expected draft to save, got %v", err)
+		}
+
+		description := "Now described"
+		body := "Now with a body"
+		_, err = service.UpdateArticle(ctx, draft.Slug, userID, &domain.UpdateArticleInput{
+			Description: &description,
+			Body:        &body,
+		})
+		if err != nil {
+			t.Fatalf("expected update to succeed, got %v", err)
+		}
+
+		published, err := service.Publish(ctx, draft.Slug, userID)
+		if err != nil {
+			t.Fatalf("expected publish to succeed, got %v", err)
+		}
+		if !published.Published {
+			t.Error("expected article to be published")
+		}
+
+		stored, err := service.GetArticleBySlug(ctx, draft.Slug, nil)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !stored.Published {
+			t.Error("expected published state to be persisted")
+		}
+	})
+
+	t.Run("draft still requires a title", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+
+		_, err := service.SaveDraft(context.Background(), userID, &domain.CreateArticleInput{Body: "Body only"})
+		if _, ok := err.(*domain.ValidationErrors); !ok {
+			t.Fatalf("expected ValidationErrors, got %T", err)
+		}
+	})
+
+	t.Run("only the author can publish", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		authorID := createTestUser(t, db, "author", "author@example.com")
+		otherID := createTestUser(t, db, "other", "other@example.com")
+		ctx := context.Background()
+
+		draft, err := service.SaveDraft(ctx, authorID, &domain.CreateArticleInput{
+			Title:       "Complete Draft",
+			Description: "Description",
+			Body:        "Body",
+		})
+		if err != nil {
+			t.Fatalf("expected draft to save, got %v", err)
+		}
+
+		// Drafts are hidden from everyone but the author
+		if _, err := service.Publish(ctx, draft.Slug, otherID); err != domain.ErrArticleNotFound {
+			t.Errorf("expected ErrArticleNotFound, got %v", err)
+		}
+	})
+
+	t.Run("regular creation is published", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+
+		article, err := service.CreateArticle(context.Background(), userID, &domain.CreateArticleInput{
+			Title:       "Published Article",
+			Description: "Description",
+			Body:        "Body",
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !article.Published {
+			t.Error("expected created article to be published")
+		}
+	})
+}
+
+// =============================================================================
+// GetArticleBySlug Tests
+// =============================================================================
+
+func TestArticleService_GetArticleBySlug(t *testing.T) {
+	t.Run("successfully gets article by slug", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+		ctx := context.Background()
+
+		// Create an article first
+		input := &domain.CreateArticleInput{
+			Title:       "Test Article",
+			Description: "Test description",
+			Body:        "Test body content",
+		}
+		created, err := service.CreateArticle(ctx, userID, input)
+		if err != nil {
+			t.Fatalf("failed to create article: %v", err)
+		}
+
+		// Get by slug
+		article, err := service.GetArticleBySlug(ctx, created.Slug, nil)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if article.Title != "Test Article" {
+			t.Errorf("expected title 'Test Article', got '%s'", article.Title)
+		}
+		if article.Author == nil {
+			t.Error("expected author to be loaded")
+		}
+	})
+
+	t.Run("fails for non-existent slug", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		ctx := context.Background()
+
+		_, err := service.GetArticleBySlug(ctx, "non-existent-slug", nil)
+		if err != domain.ErrArticleNotFound {
+			t.Errorf("expected ErrArticleNotFound, got %v", err)
+		}
+	})
+}
+
+// =============================================================================
+// UpdateArticle Tests
+// =============================================================================
+
+func TestArticleService_UpdateArticle(t *testing.T) {
+	t.Run("successfully updates article title", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+		ctx := context.Background()
+
+		// Create an article
+		input := &domain.CreateArticleInput{
+			Title:       "Original Title",
+			Description: "Test description",
+			Body:        "Test body content",
+		}
+		created, _ := service.CreateArticle(ctx, userID, input)
+
+		// Update title
+		newTitle := "Updated Title"
+		updateInput := &domain.UpdateArticleInput{
+			Title: &newTitle,
+		}
+
+		updated, err := service.UpdateArticle(ctx, created.Slug, userID, updateInput)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if updated.Title != "Updated Title" {
+			t.Errorf("expected title 'Updated Title', got '%s'", updated.Title)
+		}
+	})
+
+	t.Run("fails when non-author tries to update", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		authorID := createTestUser(t, db, "author", "author@example.com")
+		otherUserID := createTestUser(t, db, "other", "other@example.com")
+		ctx := context.Background()
+
+		// Create an article as author
+		input := &domain.CreateArticleInput{
+			Title:       "Original Title",
+			Description: "Test description",
+			Body:        "Test body content",
+		}
+		created, _ := service.CreateArticle(ctx, authorID, input)
+
+		// Try to update as different user
+		newTitle := "Hacked Title"
+		updateInput := &domain.UpdateArticleInput{
+			Title: &newTitle,
+		}
+
+		_, err := service.UpdateArticle(ctx, created.Slug, otherUserID, updateInput)
+		if err != domain.ErrForbidden {
+			t.Errorf("expected ErrForbidden, got %v", err)
+		}
+	})
+
+	t.Run("fails for non-existent article", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+		ctx := context.Background()
+
+		newTitle := "New Title"
+		updateInput := &domain.UpdateArticleInput{
+			Title: &newTitle,
+		}
+
+		_, err := service.UpdateArticle(ctx, "non-existent-slug", userID, updateInput)
+		if err != domain.ErrArticleNotFound {
+			t.Errorf("expected ErrArticleNotFound, got %v", err)
+		}
+	})
+
+	t.Run("updates description and body", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+		ctx := context.Background()
+
+		input := &domain.CreateArticleInput{
+			Title:       "Original Title",
+			Description: "Original description",
+			Body:        "Original body",
+		}
+		created, _ := service.CreateArticle(ctx, userID, input)
+
+		newDesc := "Updated description"
+		newBody := "Updated body"
+		updateInput := &domain.UpdateArticleInput{
+			Description: &newDesc,
+			Body:        &newBody,
+		}
+
+		updated, err := service.UpdateArticle(ctx, created.Slug, userID, updateInput)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if updated.Description != "Updated description" {
+			t.Errorf("expected description 'Updated description', got '%s'", updated.Description)
+		}
+		if updated.Body != "Updated body" {
+			t.Errorf("expected body 'Updated body', got '%s'", updated.Body)
+		}
+	})
+}
+
+// =============================================================================
+// SetCommentsEnabled Tests
+// =============================================================================
+
+func TestArticleService_RegenerateSlug(t *testing.T) {
+	newArticle := func(t *testing.T, service *ArticleService, userID int64, title string) *domain.Article {
+		t.Helper()
+		article, err := service.CreateArticle(context.Background(), userID, &domain.CreateArticleInput{
+			Title:       title,
+			Description: "Test description",
+			Body:        "Test body content",
+		})
+		if err != nil {
+			t.Fatalf("failed to create article: %v", err)
+		}
+		return article
+	}
+
+	t.Run("returns the new slug and frees the old one", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+		ctx := context.Background()
+		created := newArticle(t, service, userID, "Original Title")
+
+		newTitle := "Renamed Title"
+		updated, err := service.UpdateArticle(ctx, created.Slug, userID, &domain.UpdateArticleInput{Title: &newTitle})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if updated.Slug != "renamed-title" {
+			t.Errorf("expected slug renamed-title, got %s", updated.Slug)
+		}
+		if !service.articleRepo.SlugExists(ctx, "renamed-title") {
+			t.Error("expected new slug to exist")
+		}
+		if service.articleRepo.SlugExists(ctx, "original-title") {
+			t.Error("expected old slug to no longer exist")
+		}
+	})
+
+	t.Run("de-duplicates against other articles", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+		newArticle(t, service, userID, "Taken Title")
+		created := newArticle(t, service, userID, "Original Title")
+
+		newTitle := "Taken Title"
+		updated, err := service.UpdateArticle(context.Background(), created.Slug, userID, &domain.UpdateArticleInput{Title: &newTitle})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if updated.Slug != "taken-title-1" {
+			t.Errorf("expected slug taken-title-1, got %s", updated.Slug)
+		}
+	})
+
+	t.Run("keeps the slug when regenerateSlug is false", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+		created := newArticle(t, service, userID, "Original Title")
+
+		newTitle := "Renamed Title"
+		regenerate := false
+		updated, err := service.UpdateArticle(context.Background(), created.Slug, userID, &domain.UpdateArticleInput{
+			Title:          &newTitle,
+			RegenerateSlug: &regenerate,
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if updated.Slug != "original-title" || updated.Title != "Renamed Title" {
+			t.Errorf("expected slug original-title with the new title, got %s / %s", updated.Slug, updated.Title)
+		}
+	})
+
+	t.Run("keeps a custom slug when the title is unchanged", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+		created, err := service.CreateArticle(context.Background(), userID, &domain.CreateArticleInput{
+			Title:       "Original Title",
+			Slug:        "my-custom-slug",
+			Description: "Test description",
+			Body:        "Test body content",
+		})
+		if err != nil {
+			t.Fatalf("failed to create article: %v", err)
+		}
+
+		sameTitle := "Original Title"
+		updated, err := service.UpdateArticle(context.Background(), created.Slug, userID, &domain.UpdateArticleInput{Title: &sameTitle})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if updated.Slug != "my-custom-slug" {
+			t.Errorf("expected slug my-custom-slug, got %s", updated.Slug)
+		}
+	})
+
+	t.Run("old slugs resolve to the current slug", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+		ctx := context.Background()
+		created := newArticle(t, service, userID, "First Title")
+
+		slug := created.Slug
+		for _, title := range []string{"Second Title", "Third Title"} {
+			newTitle := title
+			updated, err := service.UpdateArticle(ctx, slug, userID, &domain.UpdateArticleInput{Title: &newTitle})
+			if err != nil {
+				t.Fatalf("failed to rename to %q: %v", title, err)
+			}
+			slug = updated.Slug
+		}
+
+		for _, oldSlug := range []string{"first-title", "second-title"} {
+			slug, err := service.ResolveSlugRedirect(ctx, oldSlug)
+			if err != nil {
+				t.Fatalf("expected %s to resolve, got %v", oldSlug, err)
+			}
+			if slug != "third-title" {
+				t.Errorf("expected %s to resolve to third-title, got %s", oldSlug, slug)
+			}
+		}
+
+		if _, err := service.ResolveSlugRedirect(ctx, "never-used"); err != domain.ErrArticleNotFound {
+			t.Errorf("expected ErrArticleNotFound, got %v", err)
+		}
+	})
+}
+
+func TestArticleService_SetCommentsEnabled(t *testing.T) {
+	t.Run("author toggles comments", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+		ctx := context.Background()
+
+		article, err := service.CreateArticle(ctx, userID, &domain.CreateArticleInput{
+			Title:       "Test Article",
+			Description: "Description",
+			Body:        "Body",
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !article.CommentsEnabled {
+			t.Error("expected comments to be enabled by default")
+		}
+
+		updated, err := service.SetCommentsEnabled(ctx, article.Slug, userID, false)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if updated.CommentsEnabled {
+			t.Error("expected comments to be disabled")
+		}
+
+		stored, err := service.GetArticleBySlug(ctx, article.Slug, nil)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if stored.CommentsEnabled {
+			t.Error("expected disabled setting to be persisted")
+		}
+
+		updated, err = service.SetCommentsEnabled(ctx, article.Slug, userID, true)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !updated.CommentsEnabled {
+			t.Error("expected comments to be re-enabled")
+		}
+	})
+
+	t.Run("fails when non-author tries to toggle", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		authorID := createTestUser(t, db, "author", "author@example.com")
+		otherID := createTestUser(t, db, "other", "other@example.com")
+		ctx := context.Background()
+
+		article, err := service.CreateArticle(ctx, authorID, &domain.CreateArticleInput{
+			Title:       "Test Article",
+			Description: "Description",
+			Body:        "Body",
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if _, err := service.SetCommentsEnabled(ctx, article.Slug, otherID, false); err != domain.ErrForbidden {
+			t.Errorf("expected ErrForbidden, got %v", err)
+		}
+	})
+
+	t.Run("fails for non-existent article", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+
+		if _, err := service.SetCommentsEnabled(context.Background(), "missing", userID, false); err != domain.ErrArticleNotFound {
+			t.Errorf("expected ErrArticleNotFound, got %v", err)
+		}
+	})
+}
+
+func TestArticleService_CountViews(t *testing.T) {
+	setup := func(t *testing.T, countViews bool) (*ArticleService, *sql.DB, int64, *domain.Article) {
+		t.Helper()
+		service, db := newTestArticleService(t)
+
+		config := DefaultArticleServiceConfig()
+		config.CountViews = countViews
+		service.SetConfig(config)
+
+		authorID := createTestUser(t, db, "author", "author@example.com")
+		article, err := service.CreateArticle(context.Background(), authorID, &domain.CreateArticleInput{
+			Title:       "Viewed",
+			Description: "Test description",
+			Body:        "Test body",
+		})
+		if err != nil {
+			t.Fatalf("failed to create article: %v", err)
+		}
+		return service, db, authorID, article
+	}
+
+	t.Run("counts anonymous and non-author views", func(t *testing.T) {
+		service, db, _, article := setup(t, true)
+		defer db.Close()
+
+		readerID := createTestUser(t, db, "reader", "reader@example.com")
+		ctx := context.Background()
+
+		if _, err := service.GetArticleBySlug(ctx, article.Slug, nil); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		viewed, err := service.GetArticleBySlug(ctx, article.Slug, &readerID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if viewed.ViewCount != 2 {
+			t.Errorf("expected view count 2, got %d", viewed.ViewCount)
+		}
+	})
+
+	t.Run("doesn't count the author's own views", func(t *testing.T) {
+		service, db, authorID, article := setup(t, true)
+		defer db.Close()
+
+		viewed, err := service.GetArticleBySlug(context.Background(), article.Slug, &authorID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if viewed.ViewCount != 0 {
+			t.Errorf("expected view count 0, got %d", viewed.ViewCount)
+		}
+	})
+
+	t.Run("doesn't count views by default", func(t *testing.T) {
+		service, db, _, article := setup(t, false)
+		defer db.Close()
+
+		service.GetArticleBySlug(context.Background(), article.Slug, nil)
+		viewed, err := service.GetArticleBySlug(context.Background(), article.Slug, nil)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if viewed.ViewCount != 0 {
+			t.Errorf("expected view count 0, got %d", viewed.ViewCount)
+		}
+	})
+
+	t.Run("a failed increment doesn't fail the read", func(t *testing.T) {
+		service, db, _, article := setup(t, true)
+		defer db.Close()
+
+		// Make the increment fail while reads keep working
+		if _, err := db.Exec(`
+			CREATE TRIGGER block_view_count BEFORE UPDATE OF view_count ON articles
+			BEGIN SELECT RAISE(FAIL, 'view counting unavailable'); END
+		`); err != nil {
+			t.Fatalf("failed to create trigger: %v", err)
+		}
+
+		viewed, err := service.GetArticleBySlug(context.Background(), article.Slug, nil)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if viewed.ViewCount != 0 {
+			t.Errorf("expected view count 0, got %d", viewed.ViewCount)
+		}
+	})
+}
+
+func TestArticleService_DraftVisibility(t *testing.T) {
+	t.Run("draft is hidden from everyone but its author", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		authorID := createTestUser(t, db, "author", "author@example.com")
+		otherID := createTestUser(t, db, "other", "other@example.com")
+		ctx := context.Background()
+
+		draft, err := service.SaveDraft(ctx, authorID, &domain.CreateArticleInput{Title: "Secret Plans"})
+		if err != nil {
+			t.Fatalf("expected draft to save, got %v", err)
+		}
+
+		if _, err := service.GetArticleBySlug(ctx, draft.Slug, &authorID); err != nil {
+			t.Errorf("expected author to see draft, got %v", err)
+		}
+		if _, err := service.GetArticleBySlug(ctx, draft.Slug, &otherID); err != domain.ErrArticleNotFound {
+			t.Errorf("expected ErrArticleNotFound for another user, got %v", err)
+		}
+		if _, err := service.GetArticleBySlug(ctx, draft.Slug, nil); err != domain.ErrArticleNotFound {
+			t.Errorf("expected ErrArticleNotFound for anonymous, got %v", err)
+		}
+	})
+
+	t.Run("scheduled article is hidden from others until publishAt", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		authorID := createTestUser(t, db, "author", "author@example.com")
+		otherID := createTestUser(t, db, "other", "other@example.com")
+		ctx := context.Background()
+
+		publishAt := time.Now().Add(time.Hour)
+		article, err := service.CreateArticle(ctx, authorID, &domain.CreateArticleInput{
+			Title:       "Coming Soon",
+			Description: "Test description",
+			Body:        "Test body",
+			PublishAt:   &publishAt,
+		})
+		if err != nil {
+			t.Fatalf("failed to create article: %v", err)
+		}
+
+		if _, err := service.GetArticleBySlug(ctx, article.Slug, &otherID); err != domain.ErrArticleNotFound {
+			t.Errorf("expected ErrArticleNotFound before publishAt, got %v", err)
+		}
+		if _, err := service.GetArticleBySlug(ctx, article.Slug, &authorID); err != nil {
+			t.Errorf("expected author to see scheduled article, got %v", err)
+		}
+
+		past := time.Now().Add(-time.Minute)
+		if _, err := service.UpdateArticle(ctx, article.Slug, authorID, &domain.UpdateArticleInput{PublishAt: &past}); err != nil {
+			t.Fatalf("failed to reschedule article: %v", err)
+		}
+		if _, err := service.GetArticleBySlug(ctx, article.Slug, &otherID); err != nil {
+			t.Errorf("expected article to be visible after publishAt, got %v", err)
+		}
+	})
+
+	t.Run("published=false on create saves a draft", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		authorID := createTestUser(t, db, "author", "author@example.com")
+		published := false
+
+		article, err := service.CreateArticle(context.Background(), authorID, &domain.CreateArticleInput{
+			Title:     "Later",
+			Published: &published,
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if article.Published {
+			t.Error("expected article to be a draft")
+		}
+	})
+
+	t.Run("update can unpublish and republish", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		authorID := createTestUser(t, db, "author", "author@example.com")
+		ctx := context.Background()
+
+		article, err := service.CreateArticle(ctx, authorID, &domain.CreateArticleInput{
+			Title:       "Toggle Me",
+			Description: "Test description",
+			Body:        "Test body",
+		})
+		if err != nil {
+			t.Fatalf("failed to create article: %v", err)
+		}
+
+		unpublish := false
+		updated, err := service.UpdateArticle(ctx, article.Slug, authorID, &domain.UpdateArticleInput{Published: &unpublish})
+		if err != nil || updated.Published {
+			t.Fatalf("expected article to be unpublished, got published=%v err=%v", updated != nil && updated.Published, err)
+		}
+
+		publish := true
+		updated, err = service.UpdateArticle(ctx, article.Slug, authorID, &domain.UpdateArticleInput{Published: &publish})
+		if err != nil || !updated.Published {
+			t.Fatalf("expected article to be published, got published=%v err=%v", updated != nil && updated.Published, err)
+		}
+	})
+
+	t.Run("update rejects publishing an incomplete draft", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		authorID := createTestUser(t, db, "author", "author@example.com")
+		ctx := context.Background()
+
+		draft, err := service.SaveDraft(ctx, authorID, &domain.CreateArticleInput{Title: "Unfinished"})
+		if err != nil {
+			t.Fatalf("expected draft to save, got %v", err)
+		}
+
+		publish := true
+		_, err = service.UpdateArticle(ctx, draft.Slug, authorID, &domain.UpdateArticleInput{Published: &publish})
+		if _, ok := err.(*domain.ValidationErrors); !ok {
+			t.Errorf("expected ValidationErrors, got %T", err)
+		}
+	})
+}
+
+// =============================================================================
+// MinAccountAge Tests
+// =============================================================================
+
+func TestArticleService_HiddenArticlesBySlug(t *testing.T) {
+	draft := false
+	publishAt := time.Now().Add(time.Hour)
+	hidden := map[string]*domain.CreateArticleInput{
+		"draft": {
+			Title: "Draft", Description: "Description", Body: "Body", Published: &draft,
+		},
+		"scheduled": {
+			Title: "Scheduled", Description: "Description", Body: "Body", PublishAt: &publishAt,
+		},
+	}
+
+	for name, input := range hidden {
+		t.Run(name, func(t *testing.T) {
+			service, db := newTestArticleService(t)
+			defer db.Close()
+
+			authorID := createTestUser(t, db, "author", "author@example.com")
+			otherID := createTestUser(t, db, "other", "other@example.com")
+			ctx := context.Background()
+
+			article, err := service.CreateArticle(ctx, authorID, input)
+			if err != nil {
+				t.Fatalf("failed to create article: %v", err)
+			}
+			slug := article.Slug
+
+			checks := map[string]func() error{
+				"GetArticleStats": func() error {
+					_, err := service.GetArticleStats(ctx, slug, &otherID)
+					return err
+				},
+				"GetArticleStats anonymous": func() error {
+					_, err := service.GetArticleStats(ctx, slug, nil)
+					return err
+				},
+				"FavoriteArticle": func() error {
+					_, err := service.FavoriteArticle(ctx, slug, otherID)
+					return err
+				},
+				"UnfavoriteArticle": func() error {
+					_, err := service.UnfavoriteArticle(ctx, slug, otherID)
+					return err
+				},
+				"SetCommentsEnabled": func() error {
+					_, err := service.SetCommentsEnabled(ctx, slug, otherID, false)
+					return err
+				},
+				"ListFavoritingUsers": func() error {
+					_, _, err := service.ListFavoritingUsers(ctx, slug, &otherID, 20, 0)
+					return err
+				},
+				"GetRelatedArticles": func() error {
+					_, err := service.GetRelatedArticles(ctx, slug, 5, &otherID)
+					return err
+				},
+				"UpdateArticle": func() error {
+					title := "Taken over"
+					_, err := service.UpdateArticle(ctx, slug, otherID, &domain.UpdateArticleInput{Title: &title})
+					return err
+				},
+				"DeleteArticle": func() error {
+					return service.DeleteArticle(ctx, slug, otherID)
+				},
+			}
+			for op, check := range checks {
+				if err := check(); err != domain.ErrArticleNotFound {
+					t.Errorf("%s: expected ErrArticleNotFound, got %v", op, err)
+				}
+			}
+
+			// The author still reaches their own article
+			if _, err := service.GetArticleStats(ctx, slug, &authorID); err != nil {
+				t.Errorf("expected author to read stats, got %v", err)
+			}
+			if _, err := service.FavoriteArticle(ctx, slug, authorID); err != nil {
+				t.Errorf("expected author to favorite their article, got %v", err)
+			}
+		})
+	}
+}
+
+func TestArticleService_MinAccountAge(t *testing.T) {
+	input := &domain.CreateArticleInput{
+		Title:       "First Post",
+		Description: "Test description",
+		Body:        "Test body",
+	}
+
+	t.Run("blocks an account younger than the minimum age", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		config := DefaultArticleServiceConfig()
+		config.MinAccountAge = time.Hour
+		service.SetConfig(config)
+
+		userID := createTestUser(t, db, "newbie", "newbie@example.com")
+
+		_, err := service.CreateArticle(context.Background(), userID, input)
+		if err != domain.ErrAccountTooNew {
+			t.Errorf("expected ErrAccountTooNew, got %v", err)
+		}
+	})
+
+	t.Run("allows an account older than the minimum age", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		config := DefaultArticleServiceConfig()
+		config.MinAccountAge = time.Hour
+		service.SetConfig(config)
+
+		userID := createTestUser(t, db, "veteran", "veteran@example.com")
+		if _, err := db.Exec("UPDATE users SET created_at = ? WHERE id = ?", time.Now().Add(-2*time.Hour), userID); err != nil {
+			t.Fatalf("failed to backdate user: %v", err)
+		}
+
+		if _, err := service.CreateArticle(context.Background(), userID, input); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	})
+}
+
+// =============================================================================
+// DeleteArticle Tests
+// =============================================================================
+
+func TestArticleService_DeleteArticle(t *testing.T) {
+	t.Run("successfully deletes article", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+		ctx := context.Background()
+
+		input := &domain.CreateArticleInput{
+			Title:       "To Delete",
+			Description: "Test description",
+			Body:        "Test body content",
+		}
+		created, _ := service.CreateArticle(ctx, userID, input)
+
+		err := service.DeleteArticle(ctx, created.Slug, userID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		// Verify deletion
+		_, err = service.GetArticleBySlug(ctx, created.Slug, nil)
+		if err != domain.ErrArticleNotFound {
+			t.Errorf("expected ErrArticleNotFound after deletion, got %v", err)
+		}
+	})
+
+	t.Run("frees the slug for reuse", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+		ctx := context.Background()
+
+		input := &domain.CreateArticleInput{
+			Title:       "Reusable Title",
+			Description: "Test description",
 			Body:        "Test body content",
 		}
-		created, _ := service.CreateArticle(ctx, userID, input)
+		created, err := service.CreateArticle(ctx, userID, input)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if err := service.DeleteArticle(ctx, created.Slug, userID); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
 
-		err := service.DeleteArticle(ctx, created.Slug, userID)
+		recreated, err := service.CreateArticle(ctx, userID, input)
 		if err != nil {
 			t.Fatalf("expected no error, got %v", err)
 		}
-
-		// Verify deletion
-		_, err = service.GetArticleBySlug(ctx, created.Slug, nil)
-		if err != domain.ErrArticleNotFound {
-			t.Errorf("expected ErrArticleNotFound after deletion, got %v", err)
+		if recreated.Slug != created.Slug {
+			t.Errorf("expected slug %s to be reused, got %s", created.Slug, recreated.Slug)
 		}
 	})
 
@@ -604,6 +2201,39 @@ func TestArticleService_ListArticles(t *testing.T) {
 			t.Fatalf("expected no error, got %v", err)
 		}
 	})
+
+	t.Run("allows offset at the cap", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		config := DefaultArticleServiceConfig()
+		config.MaxOffset = 50
+		service.SetConfig(config)
+
+		params := &domain.ArticleListParams{Offset: 50}
+		if _, _, err := service.ListArticles(context.Background(), params, nil); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("rejects offset beyond the cap", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		config := DefaultArticleServiceConfig()
+		config.MaxOffset = 50
+		service.SetConfig(config)
+
+		params := &domain.ArticleListParams{Offset: 51}
+		_, _, err := service.ListArticles(context.Background(), params, nil)
+		validationErr, ok := err.(*domain.ValidationErrors)
+		if !ok {
+			t.Fatalf("expected ValidationErrors, got %T", err)
+		}
+		if len(validationErr.Errors) != 1 || validationErr.Errors[0].Field != "offset" {
+			t.Errorf("expected a single offset error, got %+v", validationErr.Errors)
+		}
+	})
 }
 
 // =============================================================================
@@ -674,6 +2304,233 @@ func TestArticleService_GetFeed(t *testing.T) {
 			t.Fatalf("expected no error, got %v", err)
 		}
 	})
+
+	t.Run("includes articles published by followed authors", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		logger := newArticleTestLogger()
+		profileService := NewProfileService(
+			repository.NewSQLiteUserRepository(db, logger),
+			repository.NewSQLiteFollowRepository(db, logger),
+			repository.NewSQLiteBlockRepository(db, logger),
+			logger,
+		)
+
+		readerID := createTestUser(t, db, "reader", "reader@example.com")
+		authorID := createTestUser(t, db, "author", "author@example.com")
+		otherID := createTestUser(t, db, "other", "other@example.com")
+		ctx := context.Background()
+
+		if _, err := profileService.FollowUser(ctx, readerID, "author"); err != nil {
+			t.Fatalf("failed to follow author: %v", err)
+		}
+
+		published, err := service.CreateArticle(ctx, authorID, &domain.CreateArticleInput{
+			Title:       "Followed Article",
+			Description: "From a followed author",
+			Body:        "Body",
+		})
+		if err != nil {
+			t.Fatalf("failed to create article: %v", err)
+		}
+		if _, err := service.CreateArticle(ctx, otherID, &domain.CreateArticleInput{
+			Title:       "Unfollowed Article",
+			Description: "From someone else",
+			Body:        "Body",
+		}); err != nil {
+			t.Fatalf("failed to create article: %v", err)
+		}
+
+		articles, total, err := service.GetFeed(ctx, readerID, nil)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if total != 1 || len(articles) != 1 {
+			t.Fatalf("expected 1 article in feed, got %d (total %d)", len(articles), total)
+		}
+		if articles[0].Slug != published.Slug {
+			t.Errorf("expected slug %q, got %q", published.Slug, articles[0].Slug)
+		}
+	})
+}
+
+// =============================================================================
+// Blocked Author Tests
+// =============================================================================
+
+func TestArticleService_BlockedAuthors(t *testing.T) {
+	service, db := newTestArticleService(t)
+	defer db.Close()
+
+	logger := newArticleTestLogger()
+	profileService := NewProfileService(
+		repository.NewSQLiteUserRepository(db, logger),
+		repository.NewSQLiteFollowRepository(db, logger),
+		repository.NewSQLiteBlockRepository(db, logger),
+		logger,
+	)
+
+	readerID := createTestUser(t, db, "reader", "reader@example.com")
+	blockedID := createTestUser(t, db, "blocked", "blocked@example.com")
+	otherID := createTestUser(t, db, "other", "other@example.com")
+	ctx := context.Background()
+
+	for _, authorID := range []int64{blockedID, otherID} {
+		if _, err := service.CreateArticle(ctx, authorID, &domain.CreateArticleInput{
+			Title:       "Article by " + strconv.FormatInt(authorID, 10),
+			Description: "Description",
+			Body:        "Body",
+		}); err != nil {
+			t.Fatalf("failed to create article: %v", err)
+		}
+	}
+
+	for _, username := range []string{"blocked", "other"} {
+		if _, err := profileService.FollowUser(ctx, readerID, username); err != nil {
+			t.Fatalf("failed to follow %s: %v", username, err)
+		}
+	}
+	if _, err := profileService.BlockUser(ctx, readerID, "blocked"); err != nil {
+		t.Fatalf("failed to block: %v", err)
+	}
+
+	assertOnlyOther := func(t *testing.T, articles []*domain.Article, total int) {
+		t.Helper()
+		if total != 1 || len(articles) != 1 {
+			t.Fatalf("expected 1 article, got %d (total %d)", len(articles), total)
+		}
+		if articles[0].AuthorID != otherID {
+			t.Errorf("expected article by author %d, got %d", otherID, articles[0].AuthorID)
+		}
+	}
+
+	t.Run("hidden from the blocker's article list", func(t *testing.T) {
+		articles, total, err := service.ListArticles(ctx, &domain.ArticleListParams{}, &readerID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		assertOnlyOther(t, articles, total)
+	})
+
+	t.Run("hidden from the blocker's feed", func(t *testing.T) {
+		articles, total, err := service.GetFeed(ctx, readerID, nil)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		assertOnlyOther(t, articles, total)
+	})
+
+	t.Run("still listed for other users", func(t *testing.T) {
+		_, total, err := service.ListArticles(ctx, &domain.ArticleListParams{}, &otherID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if total != 2 {
+			t.Errorf("expected total 2, got %d", total)
+		}
+	})
+
+	t.Run("listed again after unblocking", func(t *testing.T) {
+		if _, err := profileService.UnblockUser(ctx, readerID, "blocked"); err != nil {
+			t.Fatalf("failed to unblock: %v", err)
+		}
+
+		_, total, err := service.GetFeed(ctx, readerID, nil)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if total != 2 {
+			t.Errorf("expected total 2, got %d", total)
+		}
+	})
+}
+
+// =============================================================================
+// GetFriendsFavorites Tests
+// =============================================================================
+
+func TestArticleService_GetFriendsFavorites(t *testing.T) {
+	t.Run("ranks articles by number of followed users who favorited them", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		ctx := context.Background()
+		callerID := createTestUser(t, db, "caller", "caller@example.com")
+		friendA := createTestUser(t, db, "frienda", "frienda@example.com")
+		friendB := createTestUser(t, db, "friendb", "friendb@example.com")
+		stranger := createTestUser(t, db, "stranger", "stranger@example.com")
+		writer := createTestUser(t, db, "writer", "writer@example.com")
+
+		for _, followingID := range []int64{friendA, friendB} {
+			if _, err := db.Exec(`INSERT INTO follows (follower_id, following_id) VALUES (?, ?)`, callerID, followingID); err != nil {
+				t.Fatalf("failed to create follow: %v", err)
+			}
+		}
+
+		newArticle := func(authorID int64, title string) *domain.Article {
+			article, err := service.CreateArticle(ctx, authorID, &domain.CreateArticleInput{
+				Title: title, Description: "Description", Body: "Body",
+			})
+			if err != nil {
+				t.Fatalf("failed to create article: %v", err)
+			}
+			return article
+		}
+		popular := newArticle(writer, "Popular With Friends")
+		niche := newArticle(writer, "Niche Pick")
+		ignored := newArticle(writer, "Only Strangers")
+		own := newArticle(callerID, "Caller Own Article")
+
+		favorite := func(slug string, userID int64) {
+			if _, err := service.FavoriteArticle(ctx, slug, userID); err != nil {
+				t.Fatalf("failed to favorite article: %v", err)
+			}
+		}
+		favorite(popular.Slug, friendA)
+		favorite(popular.Slug, friendB)
+		favorite(niche.Slug, friendB)
+		favorite(ignored.Slug, stranger)
+		favorite(own.Slug, friendA)
+
+		articles, total, err := service.GetFriendsFavorites(ctx, callerID, nil)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if total != 2 {
+			t.Errorf("expected total 2, got %d", total)
+		}
+		if len(articles) != 2 {
+			t.Fatalf("expected 2 articles, got %d", len(articles))
+		}
+		if articles[0].Slug != popular.Slug || articles[1].Slug != niche.Slug {
+			t.Errorf("unexpected ranking: %s, %s", articles[0].Slug, articles[1].Slug)
+		}
+		if articles[0].Author == nil || articles[0].Author.Username != "writer" {
+			t.Error("expected author to be populated")
+		}
+		if articles[0].FavoritesCount != 2 {
+			t.Errorf("expected favoritesCount 2, got %d", articles[0].FavoritesCount)
+		}
+		if articles[0].Favorited {
+			t.Error("expected favorited to be false for the caller")
+		}
+	})
+
+	t.Run("returns empty list when not following anyone", func(t *testing.T) {
+		service, db := newTestArticleService(t)
+		defer db.Close()
+
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+
+		articles, total, err := service.GetFriendsFavorites(context.Background(), userID, nil)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if total != 0 || len(articles) != 0 {
+			t.Errorf("expected no articles, got %d (total %d)", len(articles), total)
+		}
+	})
 }
 
 // =============================================================================
@@ -721,6 +2578,241 @@ func TestArticleService_GetAllTags(t *testing.T) {
 	})
 }
 
+// countingTagsRepo counts GetAllTags calls that reach the repository
+type countingTagsRepo struct {
+	repository.ArticleRepository
+	calls int
+}
+
+func (r *countingTagsRepo) GetAllTags(ctx context.Context) ([]string, error) {
+	r.calls++
+	return r.ArticleRepository.GetAllTags(ctx)
+}
+
+func TestArticleService_GetAllTagsCache(t *testing.T) {
+	newCachedService := func(t *testing.T) (*ArticleService, *countingTagsRepo, int64) {
+		t.Helper()
+		service, db := newTestArticleService(t)
+		t.Cleanup(func() { db.Close() })
+		repo := &countingTagsRepo{ArticleRepository: service.articleRepo}
+		service.articleRepo = repo
+		userID := createTestUser(t, db, "testuser", "test@example.com")
+		return service, repo, userID
+	}
+	ctx := context.Background()
+
+	t.Run("serves repeated calls from the cache", func(t *testing.T) {
+		service, repo, userID := newCachedService(t)
+		if _, err := service.CreateArticle(ctx, userID, &domain.CreateArticleInput{
+			Title: "Cached", Description: "Description", Body: "Body", TagList: []string{"go"},
+		}); err != nil {
+			t.Fatalf("CreateArticle() error = %v", err)
+		}
+
+		for i := 0; i < 2; i++ {
+			tags, err := service.GetAllTags(ctx)
+			if err != nil {
+				t.Fatalf("GetAllTags() error = %v", err)
+			}
+			if len(tags) != 1 || tags[0] != "go" {
+				t.Errorf("GetAllTags() = %v, want [go]", tags)
+			}
+		}
+		if repo.calls != 1 {
+			t.Errorf("expected 1 repository call, got %d", repo.calls)
+		}
+	})
+
+	t.Run("creating an article with a new tag busts the cache", func(t *testing.T) {
+		service, repo, userID := newCachedService(t)
+		if _, err := service.CreateArticle(ctx, userID, &domain.CreateArticleInput{
+			Title: "First", Description: "Description", Body: "Body", TagList: []string{"go"},
+		}); err != nil {
+			t.Fatalf("CreateArticle() error = %v", err)
+		}
+		service.GetAllTags(ctx)
+
+		// Known tags leave the cache alone
+		if _, err := service.CreateArticle(ctx, userID, &domain.CreateArticleInput{
+			Title: "Second", Description: "Description", Body: "Body", TagList: []string{"go"},
+		}); err != nil {
+			t.Fatalf("CreateArticle() error = %v", err)
+		}
+		service.GetAllTags(ctx)
+		if repo.calls != 1 {
+			t.Errorf("expected 1 repository call after reusing a tag, got %d", repo.calls)
+		}
+
+		if _, err := service.CreateArticle(ctx, userID, &domain.CreateArticleInput{
+			Title: "Third", Description: "Description", Body: "Body", TagList: []string{"go", "rust"},
+		}); err != nil {
+			t.Fatalf("CreateArticle() error = %v", err)
+		}
+		tags, err := service.GetAllTags(ctx)
+		if err != nil {
+			t.Fatalf("GetAllTags() error = %v", err)
+		}
+		if repo.calls != 2 {
+			t.Errorf("expected 2 repository calls after a new tag, got %d", repo.calls)
+		}
+		if len(tags) != 2 || tags[0] != "go" || tags[1] != "rust" {
+			t.Errorf("GetAllTags() = %v, want [go rust]", tags)
+		}
+	})
+
+	t.Run("expires after the TTL", func(t *testing.T) {
+		service, repo, _ := newCachedService(t)
+		now := time.Now()
+		service.tags.now = func() time.Time { return now }
+
+		service.GetAllTags(ctx)
+		now = now.Add(DefaultTagsCacheTTL)
+		service.GetAllTags(ctx)
+		if repo.calls != 2 {
+			t.Errorf("expected 2 repository calls across expiry, got %d", repo.calls)
+		}
+	})
+
+	t.Run("a zero TTL bypasses the cache", func(t *testing.T) {
+		service, repo, _ := newCachedService(t)
+		config := DefaultArticleServiceConfig()
+		config.TagsCacheTTL = 0
+		service.SetConfig(config)
+
+		service.GetAllTags(ctx)
+		service.GetAllTags(ctx)
+		if repo.calls != 2 {
+			t.Errorf("expected 2 repository calls, got %d", repo.calls)
+		}
+	})
+}
+
+func TestArticleService_RecomputeCounts(t *testing.T) {
+	service, db := newTestArticleService(t)
+	defer db.Close()
+
+	maintainerID := createTestUser(t, db, "maintainer", "maintainer@example.com")
+	fanID := createTestUser(t, db, "fan", "fan@example.com")
+	ctx := context.Background()
+
+	config := DefaultArticleServiceConfig()
+	config.MaintainerIDs = []int64{maintainerID}
+	service.SetConfig(config)
+
+	var articles []*domain.Article
+	for _, title := range []string{"Drifted", "Consistent"} {
+		article, err := service.CreateArticle(ctx, maintainerID, &domain.CreateArticleInput{
+			Title: title, Description: "Description", Body: "Body",
+		})
+		if err != nil {
+			t.Fatalf("CreateArticle() error = %v", err)
+		}
+		if _, err := service.FavoriteArticle(ctx, article.Slug, fanID); err != nil {
+			t.Fatalf("FavoriteArticle() error = %v", err)
+		}
+		articles = append(articles, article)
+	}
+
+	// Corrupt the first article's counter
+	if _, err := db.Exec(`UPDATE articles SET favorites_count = 7 WHERE id = ?`, articles[0].ID); err != nil {
+		t.Fatalf("failed to corrupt favorites_count: %v", err)
+	}
+
+	t.Run("rejects non-maintainers", func(t *testing.T) {
+		if _, err := service.RecomputeCounts(ctx, fanID); err != domain.ErrForbidden {
+			t.Errorf("expected ErrForbidden, got %v", err)
+		}
+	})
+
+	t.Run("corrects drifted counts", func(t *testing.T) {
+		result, err := service.RecomputeCounts(ctx, maintainerID)
+		if err != nil {
+			t.Fatalf("RecomputeCounts() error = %v", err)
+		}
+		want := domain.CountCorrection{
+			ArticleID: articles[0].ID, Slug: articles[0].Slug, Counter: domain.CounterFavorites, Stored: 7, Actual: 1,
+		}
+		if len(result.Corrections) != 1 || result.Corrections[0] != want {
+			t.Errorf("RecomputeCounts() corrections = %+v, want [%+v]", result.Corrections, want)
+		}
+
+		article, err := service.GetArticleBySlug(ctx, articles[0].Slug, nil)
+		if err != nil {
+			t.Fatalf("GetArticleBySlug() error = %v", err)
+		}
+		if article.FavoritesCount != 1 {
+			t.Errorf("favoritesCount after recompute = %d, want 1", article.FavoritesCount)
+		}
+
+		result, err = service.RecomputeCounts(ctx, maintainerID)
+		if err != nil {
+			t.Fatalf("RecomputeCounts() error = %v", err)
+		}
+		if len(result.Corrections) != 0 {
+			t.Errorf("expected no corrections on consistent data, got %+v", result.Corrections)
+		}
+	})
+}
+
+func TestArticleService_SearchTags(t *testing.T) {
+	service, db := newTestArticleService(t)
+	defer db.Close()
+
+	userID := createTestUser(t, db, "testuser", "test@example.com")
+	ctx := context.Background()
+
+	// "popular" is used by every article, "common" by two
+	var tagLists [][]string
+	for i := 0; i < 12; i++ {
+		tagLists = append(tagLists, []string{"popular", fmt.Sprintf("tag%02d", i)})
+	}
+	tagLists[0] = append(tagLists[0], "common", "golang")
+	tagLists[1] = append(tagLists[1], "common", "gopher")
+	for i, tags := range tagLists {
+		if _, err := service.CreateArticle(ctx, userID, &domain.CreateArticleInput{
+			Title:       fmt.Sprintf("Article %d", i),
+			Description: "Description",
+			Body:        "Body",
+			TagList:     tags,
+		}); err != nil {
+			t.Fatalf("failed to create article: %v", err)
+		}
+	}
+
+	t.Run("matches prefix ignoring case", func(t *testing.T) {
+		tags, err := service.SearchTags(ctx, "GO")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(tags) != 2 || tags[0] != "golang" || tags[1] != "gopher" {
+			t.Errorf("expected [golang gopher], got %v", tags)
+		}
+	})
+
+	t.Run("caps results", func(t *testing.T) {
+		tags, err := service.SearchTags(ctx, "tag")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(tags) != 10 {
+			t.Errorf("expected 10 tags, got %d", len(tags))
+		}
+	})
+
+	t.Run("empty prefix returns the most used tags", func(t *testing.T) {
+		tags, err := service.SearchTags(ctx, "  ")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(tags) != 10 {
+			t.Fatalf("expected 10 tags, got %d", len(tags))
+		}
+		if tags[0] != "popular" || tags[1] != "common" {
+			t.Errorf("expected popular and common first, got %v", tags)
+		}
+	})
+}
+
 // =============================================================================
 // FavoriteArticle Tests
 // =============================================================================
@@ -867,3 +2959,64 @@ func TestArticleService_UnfavoriteArticle(t *testing.T) {
 		}
 	})
 }
+
+// =============================================================================
+// Domain Events Tests
+// =============================================================================
+
+func TestArticleService_PublishesEvents(t *testing.T) {
+	service, db := newTestArticleService(t)
+	defer db.Close()
+
+	var published []events.Event
+	bus := events.NewBus(newArticleTestLogger())
+	bus.Subscribe(func(ctx context.Context, event events.Event) {
+		published = append(published, event)
+	})
+	service.SetEventBus(bus)
+
+	authorID := createTestUser(t, db, "author", "author@example.com")
+	readerID := createTestUser(t, db, "reader", "reader@example.com")
+	ctx := context.Background()
+
+	article, err := service.CreateArticle(ctx, authorID, &domain.CreateArticleInput{
+		Title:       "Original Title",
+		Description: "Test description",
+		Body:        "Test body",
+	})
+	if err != nil {
+		t.Fatalf("failed to create article: %v", err)
+	}
+	originalSlug := article.Slug
+
+	title := "New Title"
+	article, err = service.UpdateArticle(ctx, originalSlug, authorID, &domain.UpdateArticleInput{Title: &title})
+	if err != nil {
+		t.Fatalf("failed to update article: %v", err)
+	}
+	if _, err := service.FavoriteArticle(ctx, article.Slug, readerID); err != nil {
+		t.Fatalf("failed to favorite article: %v", err)
+	}
+	// Favoriting again changes nothing and publishes nothing
+	if _, err := service.FavoriteArticle(ctx, article.Slug, readerID); err != nil {
+		t.Fatalf("failed to favorite article: %v", err)
+	}
+	if err := service.DeleteArticle(ctx, article.Slug, authorID); err != nil {
+		t.Fatalf("failed to delete article: %v", err)
+	}
+
+	want := []events.Event{
+		events.ArticleCreated{ArticleID: article.ID, Slug: originalSlug, AuthorID: authorID},
+		events.ArticleUpdated{ArticleID: article.ID, Slug: article.Slug, OldSlug: originalSlug, AuthorID: authorID},
+		events.Favorited{ArticleID: article.ID, Slug: article.Slug, UserID: readerID, Favorited: true},
+		events.ArticleDeleted{ArticleID: article.ID, Slug: article.Slug, AuthorID: authorID},
+	}
+	if len(published) != len(want) {
+		t.Fatalf("expected %d events, got %d: %+v", len(want), len(published), published)
+	}
+	for i := range want {
+		if published[i] != want[i] {
+			t.Errorf("event %d: expected %+v, got %+v", i, want[i], published[i])
+		}
+	}
+}
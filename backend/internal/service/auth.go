@@ -2,58 +2,149 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"log/slog"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"golang.org/x/crypto/bcrypt"
 
 	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
+	"github.com/alexlee0213/realworld-conduit/backend/internal/events"
 	"github.com/alexlee0213/realworld-conduit/backend/internal/repository"
 )
 
+// AuthServiceConfig holds tunable account behavior
+type AuthServiceConfig struct {
+	// RejectHTML rejects HTML tags in usernames and bios
+	RejectHTML bool
+	// RefreshTokenExpiry is how long a refresh token can be exchanged for a
+	// new token pair
+	RefreshTokenExpiry time.Duration
+	// PasswordResetTTL is how long a password reset token stays valid
+	PasswordResetTTL time.Duration
+	// PasswordPolicy is enforced whenever a password is set
+	PasswordPolicy domain.PasswordPolicy
+	// BcryptCost is the cost new password hashes use. Hashes stored with a
+	// lower cost are upgraded on the user's next successful login.
+	BcryptCost int
+}
+
+// DefaultRefreshTokenExpiry is the default refresh token lifetime (30 days)
+const DefaultRefreshTokenExpiry = 30 * 24 * time.Hour
+
+// DefaultPasswordResetTTL is the default password reset token lifetime
+const DefaultPasswordResetTTL = time.Hour
+
+// dummyPasswordHashes caches, per bcrypt cost, the hash compared against
+// when a login email has no account
+var dummyPasswordHashes sync.Map
+
+// dummyPasswordHash returns a fixed hash at the given cost, generated once,
+// so the comparison takes as long as checking a real user's password
+func dummyPasswordHash(cost int) []byte {
+	if hash, ok := dummyPasswordHashes.Load(cost); ok {
+		return hash.([]byte)
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte("conduit-dummy-password"), cost)
+	if err != nil {
+		panic(err)
+	}
+	dummyPasswordHashes.Store(cost, hash)
+	return hash
+}
+
+// opaqueTokenBytes is the amount of randomness in refresh and password reset tokens
+const opaqueTokenBytes = 32
+
+// DefaultAuthServiceConfig returns the default account configuration
+func DefaultAuthServiceConfig() AuthServiceConfig {
+	return AuthServiceConfig{
+		RejectHTML:         false,
+		RefreshTokenExpiry: DefaultRefreshTokenExpiry,
+		PasswordResetTTL:   DefaultPasswordResetTTL,
+		PasswordPolicy:     domain.DefaultPasswordPolicy(),
+		BcryptCost:         bcrypt.DefaultCost,
+	}
+}
+
 // AuthService handles authentication business logic
 type AuthService struct {
-	userRepo  repository.UserRepository
-	jwtSecret string
-	jwtExpiry time.Duration
-	logger    *slog.Logger
+	userRepo          repository.UserRepository
+	refreshTokenRepo  repository.RefreshTokenRepository
+	passwordResetRepo repository.PasswordResetRepository
+	revokedTokenRepo  repository.RevokedTokenRepository
+	jwtSecret         string
+	jwtExpiry         time.Duration
+	config            AuthServiceConfig
+	loginAttempts     LoginAttemptTracker
+	eventBus          *events.Bus
+	logger            *slog.Logger
 }
 
 // NewAuthService creates a new AuthService instance
+// jwtExpiry is the lifetime of access tokens
 func NewAuthService(
 	userRepo repository.UserRepository,
+	refreshTokenRepo repository.RefreshTokenRepository,
+	passwordResetRepo repository.PasswordResetRepository,
+	revokedTokenRepo repository.RevokedTokenRepository,
 	jwtSecret string,
 	jwtExpiry time.Duration,
 	logger *slog.Logger,
 ) *AuthService {
 	return &AuthService{
-		userRepo:  userRepo,
-		jwtSecret: jwtSecret,
-		jwtExpiry: jwtExpiry,
-		logger:    logger,
+		userRepo:          userRepo,
+		refreshTokenRepo:  refreshTokenRepo,
+		passwordResetRepo: passwordResetRepo,
+		revokedTokenRepo:  revokedTokenRepo,
+		jwtSecret:         jwtSecret,
+		jwtExpiry:         jwtExpiry,
+		config:            DefaultAuthServiceConfig(),
+		loginAttempts:     NewMemoryLoginAttemptTracker(DefaultMaxLoginFailures, DefaultLoginFailureWindow),
+		logger:            logger,
 	}
 }
 
+// SetConfig replaces the service configuration
+func (s *AuthService) SetConfig(config AuthServiceConfig) {
+	s.config = config
+}
+
+// SetLoginAttemptTracker replaces the tracker used to lock out repeated
+// failed logins. A nil tracker disables the lockout.
+func (s *AuthService) SetLoginAttemptTracker(tracker LoginAttemptTracker) {
+	s.loginAttempts = tracker
+}
+
+// SetEventBus sets the bus that account events are published to
+func (s *AuthService) SetEventBus(bus *events.Bus) {
+	s.eventBus = bus
+}
+
 // Register creates a new user account
-func (s *AuthService) Register(ctx context.Context, input *domain.CreateUserInput) (*domain.User, string, error) {
+func (s *AuthService) Register(ctx context.Context, input *domain.CreateUserInput) (*domain.User, *domain.TokenPair, error) {
 	// Validate input
 	if err := s.validateRegisterInput(input); err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 
 	// Hash password
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.DefaultCost)
+	hashedPassword, err := s.hashPassword(input.Password)
 	if err != nil {
 		s.logger.Error("failed to hash password", "error", err)
-		return nil, "", errors.Join(domain.ErrDatabase, err)
+		return nil, nil, errors.Join(domain.ErrDatabase, err)
 	}
 
 	// Create user
 	user := &domain.User{
-		Email:        strings.ToLower(strings.TrimSpace(input.Email)),
+		Email:        normalizeEmail(input.Email),
 		Username:     strings.TrimSpace(input.Username),
 		PasswordHash: string(hashedPassword),
 		Bio:          "",
@@ -61,13 +152,13 @@ func (s *AuthService) Register(ctx context.Context, input *domain.CreateUserInpu
 	}
 
 	if err := s.userRepo.CreateUser(ctx, user); err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 
-	// Generate JWT token
-	token, err := s.GenerateToken(user.ID)
+	// Generate access and refresh tokens
+	tokens, err := s.GenerateTokenPair(ctx, user.ID)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 
 	s.logger.Info("user registered",
@@ -75,29 +166,51 @@ func (s *AuthService) Register(ctx context.Context, input *domain.CreateUserInpu
 		"username", user.Username,
 	)
 
-	return user, token, nil
+	return user, tokens, nil
 }
 
-// Login authenticates a user and returns a JWT token
-func (s *AuthService) Login(ctx context.Context, email, password string) (*domain.User, string, error) {
+// Login authenticates a user and returns a new access and refresh token pair
+// After too many consecutive failures for an email, attempts are rejected
+// with a *domain.TooManyAttemptsError until the lockout ends
+func (s *AuthService) Login(ctx context.Context, email, password string) (*domain.User, *domain.TokenPair, error) {
+	email = normalizeEmail(email)
+
+	if s.loginAttempts != nil {
+		if retryAfter := s.loginAttempts.LockedFor(email); retryAfter > 0 {
+			s.logger.Warn("login locked out", "email", email, "retry_after", retryAfter)
+			return nil, nil, &domain.TooManyAttemptsError{RetryAfter: retryAfter}
+		}
+	}
+
 	// Find user by email
-	user, err := s.userRepo.GetUserByEmail(ctx, strings.ToLower(strings.TrimSpace(email)))
+	user, err := s.userRepo.GetUserByEmail(ctx, email)
 	if err != nil {
 		if errors.Is(err, domain.ErrUserNotFound) {
-			return nil, "", domain.ErrInvalidCredentials
+			// Spend the same bcrypt time as a wrong password so response
+			// timing doesn't reveal which emails have accounts
+			bcrypt.CompareHashAndPassword(dummyPasswordHash(s.config.BcryptCost), []byte(password))
+			s.recordLoginFailure(email)
+			return nil, nil, domain.ErrInvalidCredentials
 		}
-		return nil, "", err
+		return nil, nil, err
 	}
 
 	// Compare password
 	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
-		return nil, "", domain.ErrInvalidCredentials
+		s.recordLoginFailure(email)
+		return nil, nil, domain.ErrInvalidCredentials
+	}
+
+	if s.loginAttempts != nil {
+		s.loginAttempts.Reset(email)
 	}
 
-	// Generate JWT token
-	token, err := s.GenerateToken(user.ID)
+	s.upgradePasswordHash(ctx, user, password)
+
+	// Generate access and refresh tokens
+	tokens, err := s.GenerateTokenPair(ctx, user.ID)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 
 	s.logger.Info("user logged in",
@@ -105,12 +218,350 @@ func (s *AuthService) Login(ctx context.Context, email, password string) (*domai
 		"username", user.Username,
 	)
 
-	return user, token, nil
+	return user, tokens, nil
+}
+
+// hashPassword hashes a password at the configured bcrypt cost
+func (s *AuthService) hashPassword(password string) ([]byte, error) {
+	return bcrypt.GenerateFromPassword([]byte(password), s.config.BcryptCost)
+}
+
+// upgradePasswordHash rehashes a just-verified password when the stored hash
+// uses a lower cost than configured. Failures are logged, not returned: the
+// login already succeeded and the upgrade is retried next time.
+func (s *AuthService) upgradePasswordHash(ctx context.Context, user *domain.User, password string) {
+	cost, err := bcrypt.Cost([]byte(user.PasswordHash))
+	if err != nil || cost >= s.config.BcryptCost {
+		return
+	}
+
+	hashedPassword, err := s.hashPassword(password)
+	if err != nil {
+		s.logger.Error("failed to rehash password", "error", err, "user_id", user.ID)
+		return
+	}
+	if err := s.userRepo.UpdatePasswordHash(ctx, user.ID, string(hashedPassword)); err != nil {
+		s.logger.Error("failed to store rehashed password", "error", err, "user_id", user.ID)
+		return
+	}
+	user.PasswordHash = string(hashedPassword)
+
+	s.logger.Info("password rehashed", "user_id", user.ID, "old_cost", cost, "new_cost", s.config.BcryptCost)
+}
+
+// recordLoginFailure counts a failed login toward the lockout. Unknown emails
+// are counted too, so responses don't reveal which accounts exist.
+func (s *AuthService) recordLoginFailure(email string) {
+	if s.loginAttempts != nil {
+		s.loginAttempts.RecordFailure(email)
+	}
+}
+
+// GenerateTokenPair creates an access token and stores a new refresh token
+// for the given user ID
+func (s *AuthService) GenerateTokenPair(ctx context.Context, userID int64) (*domain.TokenPair, error) {
+	accessToken, err := s.GenerateToken(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := generateOpaqueToken()
+	if err != nil {
+		s.logger.Error("failed to generate refresh token", "error", err)
+		return nil, err
+	}
+
+	stored := &domain.RefreshToken{
+		UserID:    userID,
+		TokenHash: hashToken(refreshToken),
+		ExpiresAt: time.Now().Add(s.config.RefreshTokenExpiry),
+	}
+	if err := s.refreshTokenRepo.CreateRefreshToken(ctx, stored); err != nil {
+		return nil, err
+	}
+
+	return &domain.TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// RefreshTokens exchanges a refresh token for a new token pair. The old
+// refresh token is revoked, so each one can be used only once. Presenting a
+// token that was already rotated revokes all of the user's refresh tokens,
+// since either the user or an attacker holds a stolen copy.
+func (s *AuthService) RefreshTokens(ctx context.Context, refreshToken string) (*domain.User, *domain.TokenPair, error) {
+	stored, err := s.refreshTokenRepo.GetRefreshTokenByHash(ctx, hashToken(refreshToken))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if stored.RevokedAt != nil {
+		s.logger.Warn("revoked refresh token reused", "user_id", stored.UserID, "token_id", stored.ID)
+		if err := s.refreshTokenRepo.RevokeUserRefreshTokens(ctx, stored.UserID); err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, domain.ErrInvalidRefreshToken
+	}
+	if stored.IsExpired(time.Now()) {
+		return nil, nil, domain.ErrInvalidRefreshToken
+	}
+
+	// Rotate: the conditional revoke fails if a concurrent refresh won
+	if err := s.refreshTokenRepo.RevokeRefreshToken(ctx, stored.ID); err != nil {
+		return nil, nil, err
+	}
+
+	user, err := s.userRepo.GetUserByID(ctx, stored.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tokens, err := s.GenerateTokenPair(ctx, user.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.logger.Info("tokens refreshed", "user_id", user.ID)
+
+	return user, tokens, nil
+}
+
+// Logout revokes a refresh token and blocklists an access token so neither
+// can be used again. Either may be empty. Unknown, expired and already
+// revoked tokens are ignored, so logging out twice is not an error.
+func (s *AuthService) Logout(ctx context.Context, refreshToken, accessToken string) error {
+	if accessToken != "" {
+		if err := s.RevokeAccessToken(ctx, accessToken); err != nil {
+			return err
+		}
+	}
+
+	if refreshToken == "" {
+		return nil
+	}
+
+	stored, err := s.refreshTokenRepo.GetRefreshTokenByHash(ctx, hashToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidRefreshToken) {
+			return nil
+		}
+		return err
+	}
+
+	if err := s.refreshTokenRepo.RevokeRefreshToken(ctx, stored.ID); err != nil && !errors.Is(err, domain.ErrInvalidRefreshToken) {
+		return err
+	}
+
+	s.logger.Info("user logged out", "user_id", stored.UserID)
+
+	return nil
+}
+
+// RevokeAccessToken adds an access token's jti to the blocklist until the
+// token expires. Tokens that are already invalid, or that predate the jti
+// claim, are ignored.
+func (s *AuthService) RevokeAccessToken(ctx context.Context, accessToken string) error {
+	claims, err := s.parseToken(accessToken)
+	if err != nil {
+		return nil
+	}
+
+	jti, _ := claims["jti"].(string)
+	exp, err := claims.GetExpirationTime()
+	if jti == "" || err != nil || exp == nil {
+		return nil
+	}
+
+	if err := s.revokedTokenRepo.RevokeToken(ctx, jti, exp.Time); err != nil {
+		return err
+	}
+
+	if userID, ok := claims["user_id"].(float64); ok {
+		s.logger.Info("access token revoked", "user_id", int64(userID))
+	}
+
+	return nil
+}
+
+// PurgeRevokedTokens deletes blocklist entries for tokens that have expired,
+// since an expired token is rejected without consulting the blocklist
+func (s *AuthService) PurgeRevokedTokens(ctx context.Context) (int64, error) {
+	return s.revokedTokenRepo.DeleteExpiredRevokedTokens(ctx, time.Now())
+}
+
+// StartRevokedTokenCleanup purges expired blocklist entries every interval
+// in a background goroutine until ctx is cancelled
+func (s *AuthService) StartRevokedTokenCleanup(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				deleted, err := s.PurgeRevokedTokens(ctx)
+				if err != nil {
+					s.logger.Error("failed to purge revoked tokens", "error", err)
+					continue
+				}
+				if deleted > 0 {
+					s.logger.Info("purged expired revoked tokens", "count", deleted)
+				}
+			}
+		}
+	}()
+}
+
+// PruneExpiredTokens deletes refresh tokens and password reset tokens that
+// have expired and returns how many of each were deleted
+func (s *AuthService) PruneExpiredTokens(ctx context.Context) (refreshTokens, passwordResets int64, err error) {
+	now := time.Now()
+	refreshTokens, err = s.refreshTokenRepo.PruneExpired(ctx, now)
+	if err != nil {
+		return 0, 0, err
+	}
+	passwordResets, err = s.passwordResetRepo.PruneExpired(ctx, now)
+	if err != nil {
+		return refreshTokens, 0, err
+	}
+	return refreshTokens, passwordResets, nil
+}
+
+// StartExpiredTokenCleanup prunes expired refresh and password reset tokens
+// every interval in a background goroutine until ctx is cancelled
+func (s *AuthService) StartExpiredTokenCleanup(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				refreshTokens, passwordResets, err := s.PruneExpiredTokens(ctx)
+				if err != nil {
+					s.logger.Error("failed to prune expired tokens", "error", err)
+					continue
+				}
+				if refreshTokens > 0 || passwordResets > 0 {
+					s.logger.Info("pruned expired tokens",
+						"refresh_tokens", refreshTokens,
+						"password_resets", passwordResets,
+					)
+				}
+			}
+		}
+	}()
+}
+
+// RequestPasswordReset creates a single-use password reset token for the
+// account with the given email and publishes it for delivery. An unknown
+// email is not an error, so callers can't use this to probe for accounts.
+func (s *AuthService) RequestPasswordReset(ctx context.Context, email string) error {
+	user, err := s.userRepo.GetUserByEmail(ctx, normalizeEmail(email))
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			s.logger.Debug("password reset requested for unknown email")
+			return nil
+		}
+		return err
+	}
+
+	token, err := generateOpaqueToken()
+	if err != nil {
+		s.logger.Error("failed to generate password reset token", "error", err)
+		return err
+	}
+
+	reset := &domain.PasswordReset{
+		UserID:    user.ID,
+		TokenHash: hashToken(token),
+		ExpiresAt: time.Now().Add(s.config.PasswordResetTTL),
+	}
+	if err := s.passwordResetRepo.Create(ctx, reset); err != nil {
+		return err
+	}
+
+	s.eventBus.Publish(ctx, events.PasswordResetRequested{UserID: user.ID, Email: user.Email, Token: token})
+
+	return nil
 }
 
-// GenerateToken creates a new JWT token for the given user ID
+// ConfirmPasswordReset consumes a password reset token and sets the user's
+// new password. All of the user's refresh tokens are revoked, signing out
+// other sessions.
+func (s *AuthService) ConfirmPasswordReset(ctx context.Context, token, newPassword string) error {
+	validationErrors := domain.NewValidationErrors()
+	if newPassword == "" {
+		validationErrors.Add("password", "password is required")
+	} else {
+		s.config.PasswordPolicy.Check(validationErrors, "password", newPassword)
+	}
+	if validationErrors.HasErrors() {
+		return validationErrors
+	}
+
+	reset, err := s.passwordResetRepo.Consume(ctx, hashToken(token))
+	if err != nil {
+		return err
+	}
+
+	user, err := s.userRepo.GetUserByID(ctx, reset.UserID)
+	if err != nil {
+		return err
+	}
+
+	hashedPassword, err := s.hashPassword(newPassword)
+	if err != nil {
+		s.logger.Error("failed to hash password", "error", err)
+		return errors.Join(domain.ErrDatabase, err)
+	}
+	user.PasswordHash = string(hashedPassword)
+
+	if err := s.userRepo.UpdateUser(ctx, user); err != nil {
+		return err
+	}
+
+	if err := s.refreshTokenRepo.RevokeUserRefreshTokens(ctx, user.ID); err != nil {
+		return err
+	}
+
+	s.logger.Info("password reset", "user_id", user.ID)
+
+	return nil
+}
+
+// generateOpaqueToken returns a random URL-safe token
+func generateOpaqueToken() (string, error) {
+	buf := make([]byte, opaqueTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// hashToken returns the hex SHA-256 of an opaque token. Tokens carry 256 bits
+// of randomness, so a fast unsalted hash is enough.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// GenerateToken creates a new JWT token for the given user ID. Each token
+// carries a random jti so it can be revoked individually.
 func (s *AuthService) GenerateToken(userID int64) (string, error) {
+	jti, err := generateOpaqueToken()
+	if err != nil {
+		s.logger.Error("failed to generate token id", "error", err)
+		return "", err
+	}
+
 	claims := jwt.MapClaims{
+		"jti":     jti,
 		"user_id": userID,
 		"exp":     time.Now().Add(s.jwtExpiry).Unix(),
 		"iat":     time.Now().Unix(),
@@ -126,8 +577,34 @@ func (s *AuthService) GenerateToken(userID int64) (string, error) {
 	return tokenString, nil
 }
 
-// ValidateToken validates a JWT token and returns the user ID
-func (s *AuthService) ValidateToken(tokenString string) (int64, error) {
+// ValidateToken validates a JWT token and returns the user ID. Tokens whose
+// jti is on the blocklist are rejected with ErrTokenRevoked.
+func (s *AuthService) ValidateToken(ctx context.Context, tokenString string) (int64, error) {
+	claims, err := s.parseToken(tokenString)
+	if err != nil {
+		return 0, err
+	}
+
+	userIDFloat, ok := claims["user_id"].(float64)
+	if !ok {
+		return 0, errors.New("invalid user_id in token")
+	}
+
+	if jti, _ := claims["jti"].(string); jti != "" {
+		revoked, err := s.revokedTokenRepo.IsTokenRevoked(ctx, jti)
+		if err != nil {
+			return 0, err
+		}
+		if revoked {
+			return 0, domain.ErrTokenRevoked
+		}
+	}
+
+	return int64(userIDFloat), nil
+}
+
+// parseToken verifies a JWT's signature and expiry and returns its claims
+func (s *AuthService) parseToken(tokenString string) (jwt.MapClaims, error) {
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
 		// Validate signing method
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
@@ -137,24 +614,19 @@ func (s *AuthService) ValidateToken(tokenString string) (int64, error) {
 	})
 
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	if !token.Valid {
-		return 0, errors.New("invalid token")
+		return nil, errors.New("invalid token")
 	}
 
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok {
-		return 0, errors.New("invalid token claims")
-	}
-
-	userIDFloat, ok := claims["user_id"].(float64)
-	if !ok {
-		return 0, errors.New("invalid user_id in token")
+		return nil, errors.New("invalid token claims")
 	}
 
-	return int64(userIDFloat), nil
+	return claims, nil
 }
 
 // GetCurrentUser retrieves the current user by ID
@@ -164,6 +636,13 @@ func (s *AuthService) GetCurrentUser(ctx context.Context, userID int64) (*domain
 
 // UpdateUser updates user information
 func (s *AuthService) UpdateUser(ctx context.Context, userID int64, input *domain.UpdateUserInput) (*domain.User, error) {
+	// Passwords only change through ChangePassword, which checks the current one
+	if input.Password != nil {
+		validationErrors := domain.NewValidationErrors()
+		validationErrors.Add("password", "must be changed with POST /api/user/password")
+		return nil, validationErrors
+	}
+
 	// Get current user
 	user, err := s.userRepo.GetUserByID(ctx, userID)
 	if err != nil {
@@ -172,19 +651,11 @@ func (s *AuthService) UpdateUser(ctx context.Context, userID int64, input *domai
 
 	// Apply updates
 	if input.Email != nil {
-		user.Email = strings.ToLower(strings.TrimSpace(*input.Email))
+		user.Email = normalizeEmail(*input.Email)
 	}
 	if input.Username != nil {
 		user.Username = strings.TrimSpace(*input.Username)
 	}
-	if input.Password != nil {
-		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(*input.Password), bcrypt.DefaultCost)
-		if err != nil {
-			s.logger.Error("failed to hash password", "error", err)
-			return nil, errors.Join(domain.ErrDatabase, err)
-		}
-		user.PasswordHash = string(hashedPassword)
-	}
 	if input.Bio != nil {
 		user.Bio = *input.Bio
 	}
@@ -192,6 +663,15 @@ func (s *AuthService) UpdateUser(ctx context.Context, userID int64, input *domai
 		user.Image = *input.Image
 	}
 
+	if s.config.RejectHTML {
+		validationErrors := domain.NewValidationErrors()
+		addHTMLError(validationErrors, "username", user.Username)
+		addHTMLError(validationErrors, "bio", user.Bio)
+		if validationErrors.HasErrors() {
+			return nil, validationErrors
+		}
+	}
+
 	// Save updates
 	if err := s.userRepo.UpdateUser(ctx, user); err != nil {
 		return nil, err
@@ -205,6 +685,59 @@ func (s *AuthService) UpdateUser(ctx context.Context, userID int64, input *domai
 	return user, nil
 }
 
+// ChangePassword sets a new password after verifying the current one, then
+// revokes the user's refresh tokens and issues a fresh pair so other
+// sessions are signed out
+func (s *AuthService) ChangePassword(ctx context.Context, userID int64, currentPassword, newPassword string) (*domain.User, *domain.TokenPair, error) {
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(currentPassword)); err != nil {
+		s.logger.Warn("password change with wrong current password", "user_id", userID)
+		validationErrors := domain.NewValidationErrors()
+		validationErrors.Add("password", "is invalid")
+		return nil, nil, validationErrors
+	}
+
+	validationErrors := domain.NewValidationErrors()
+	s.config.PasswordPolicy.Check(validationErrors, "newPassword", newPassword)
+	if validationErrors.HasErrors() {
+		return nil, nil, validationErrors
+	}
+
+	hashedPassword, err := s.hashPassword(newPassword)
+	if err != nil {
+		s.logger.Error("failed to hash password", "error", err)
+		return nil, nil, errors.Join(domain.ErrDatabase, err)
+	}
+	user.PasswordHash = string(hashedPassword)
+
+	if err := s.userRepo.UpdateUser(ctx, user); err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.refreshTokenRepo.RevokeUserRefreshTokens(ctx, user.ID); err != nil {
+		return nil, nil, err
+	}
+
+	tokens, err := s.GenerateTokenPair(ctx, user.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.logger.Info("password changed", "user_id", user.ID)
+
+	return user, tokens, nil
+}
+
+// normalizeEmail trims and lowercases an email. Emails are stored normalized
+// and every lookup goes through this, so they compare case-insensitively.
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
 // validateRegisterInput validates registration input
 func (s *AuthService) validateRegisterInput(input *domain.CreateUserInput) error {
 	validationErrors := domain.NewValidationErrors()
@@ -217,6 +750,11 @@ func (s *AuthService) validateRegisterInput(input *domain.CreateUserInput) error
 	}
 	if input.Password == "" {
 		validationErrors.Add("password", "password is required")
+	} else {
+		s.config.PasswordPolicy.Check(validationErrors, "password", input.Password)
+	}
+	if s.config.RejectHTML {
+		addHTMLError(validationErrors, "username", input.Username)
 	}
 
 	if validationErrors.HasErrors() {
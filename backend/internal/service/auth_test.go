@@ -3,14 +3,18 @@ package service
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"log/slog"
 	"os"
+	"sync"
 	"testing"
 	"time"
 
 	_ "github.com/mattn/go-sqlite3"
+	"golang.org/x/crypto/bcrypt"
 
 	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
+	"github.com/alexlee0213/realworld-conduit/backend/internal/events"
 	"github.com/alexlee0213/realworld-conduit/backend/internal/repository"
 )
 
@@ -41,6 +45,50 @@ func setupTestDB(t *testing.T) *sql.DB {
 		t.Fatalf("failed to create users table: %v", err)
 	}
 
+	// Create refresh tokens table
+	_, err = db.Exec(`
+		CREATE TABLE refresh_tokens (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			user_id INTEGER NOT NULL,
+			token_hash TEXT NOT NULL UNIQUE,
+			expires_at TIMESTAMP NOT NULL,
+			revoked_at TIMESTAMP,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+		)
+	`)
+	if err != nil {
+		t.Fatalf("failed to create refresh_tokens table: %v", err)
+	}
+
+	// Create password resets table
+	_, err = db.Exec(`
+		CREATE TABLE password_resets (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			user_id INTEGER NOT NULL,
+			token_hash TEXT NOT NULL UNIQUE,
+			expires_at TIMESTAMP NOT NULL,
+			used_at TIMESTAMP,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+		)
+	`)
+	if err != nil {
+		t.Fatalf("failed to create password_resets table: %v", err)
+	}
+
+	// Create revoked tokens table
+	_, err = db.Exec(`
+		CREATE TABLE revoked_tokens (
+			jti TEXT PRIMARY KEY,
+			expires_at TIMESTAMP NOT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)
+	`)
+	if err != nil {
+		t.Fatalf("failed to create revoked_tokens table: %v", err)
+	}
+
 	return db
 }
 
@@ -56,7 +104,10 @@ func newTestAuthService(t *testing.T) (*AuthService, *sql.DB) {
 	logger := newTestLogger()
 	userRepo := repository.NewSQLiteUserRepository(db, logger)
 
-	authService := NewAuthService(userRepo, "test-jwt-secret", 24*time.Hour, logger)
+	refreshTokenRepo := repository.NewSQLiteRefreshTokenRepository(db, logger)
+	passwordResetRepo := repository.NewSQLitePasswordResetRepository(db, logger)
+	revokedTokenRepo := repository.NewSQLiteRevokedTokenRepository(db, logger)
+	authService := NewAuthService(userRepo, refreshTokenRepo, passwordResetRepo, revokedTokenRepo, "test-jwt-secret", 24*time.Hour, logger)
 	return authService, db
 }
 
@@ -76,7 +127,7 @@ func TestRegister(t *testing.T) {
 			Password: "password123",
 		}
 
-		user, token, err := authService.Register(ctx, input)
+		user, tokens, err := authService.Register(ctx, input)
 
 		if err != nil {
 			t.Errorf("expected no error, got %v", err)
@@ -90,8 +141,8 @@ func TestRegister(t *testing.T) {
 		if user.Username != input.Username {
 			t.Errorf("expected username %s, got %s", input.Username, user.Username)
 		}
-		if token == "" {
-			t.Error("expected token to be returned")
+		if tokens == nil || tokens.AccessToken == "" || tokens.RefreshToken == "" {
+			t.Error("expected access and refresh tokens to be returned")
 		}
 		// Password should be hashed, not stored plain
 		if user.PasswordHash == input.Password {
@@ -161,6 +212,47 @@ func TestRegister(t *testing.T) {
 		}
 	})
 
+	t.Run("exactly one of two concurrent registrations wins", func(t *testing.T) {
+		authService, db := newTestAuthService(t)
+		defer db.Close()
+		// Keep every goroutine on the single in-memory database
+		db.SetMaxOpenConns(1)
+
+		ctx := context.Background()
+		start := make(chan struct{})
+		errs := make([]error, 2)
+
+		var wg sync.WaitGroup
+		for i := range errs {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				<-start
+				_, _, errs[i] = authService.Register(ctx, &domain.CreateUserInput{
+					Email:    "racer" + string(rune('a'+i)) + "@example.com",
+					Username: "racer",
+					Password: "password123",
+				})
+			}(i)
+		}
+		close(start)
+		wg.Wait()
+
+		succeeded := 0
+		for _, err := range errs {
+			switch err {
+			case nil:
+				succeeded++
+			case domain.ErrUsernameAlreadyTaken:
+			default:
+				t.Errorf("expected nil or ErrUsernameAlreadyTaken, got %v", err)
+			}
+		}
+		if succeeded != 1 {
+			t.Errorf("expected exactly one registration to succeed, got %d", succeeded)
+		}
+	})
+
 	t.Run("returns validation error for empty email", func(t *testing.T) {
 		authService, db := newTestAuthService(t)
 		defer db.Close()
@@ -211,6 +303,148 @@ func TestRegister(t *testing.T) {
 			t.Error("expected validation error for empty password")
 		}
 	})
+
+	t.Run("reports every missing field together", func(t *testing.T) {
+		authService, db := newTestAuthService(t)
+		defer db.Close()
+
+		_, _, err := authService.Register(context.Background(), &domain.CreateUserInput{})
+		validationErrors, ok := err.(*domain.ValidationErrors)
+		if !ok {
+			t.Fatalf("expected ValidationErrors, got %v", err)
+		}
+		want := []string{"email", "username", "password"}
+		if len(validationErrors.Errors) != len(want) {
+			t.Fatalf("expected %d errors, got %v", len(want), validationErrors.Errors)
+		}
+		for i, field := range want {
+			if validationErrors.Errors[i].Field != field {
+				t.Errorf("error %d: expected field %s, got %q", i, field, validationErrors.Errors[i].Field)
+			}
+		}
+	})
+
+	t.Run("rejects weak passwords", func(t *testing.T) {
+		authService, db := newTestAuthService(t)
+		defer db.Close()
+
+		config := DefaultAuthServiceConfig()
+		config.PasswordPolicy = domain.PasswordPolicy{MinLength: 8, MinCharClasses: 2}
+		authService.SetConfig(config)
+
+		tests := []struct {
+			name     string
+			password string
+			want     int
+		}{
+			{name: "too short", password: "abc123", want: 1},
+			{name: "all numeric", password: "12345678", want: 1},
+			{name: "short and all numeric", password: "1234", want: 2},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, _, err := authService.Register(context.Background(), &domain.CreateUserInput{
+					Email:    "weak@example.com",
+					Username: "weakuser",
+					Password: tt.password,
+				})
+				validationErrors, ok := err.(*domain.ValidationErrors)
+				if !ok {
+					t.Fatalf("expected ValidationErrors, got %v", err)
+				}
+				if len(validationErrors.Errors) != tt.want {
+					t.Fatalf("expected %d errors, got %v", tt.want, validationErrors.Errors)
+				}
+				for _, e := range validationErrors.Errors {
+					if e.Field != "password" {
+						t.Errorf("expected field password, got %q", e.Field)
+					}
+				}
+			})
+		}
+
+		if _, _, err := authService.Register(context.Background(), &domain.CreateUserInput{
+			Email:    "strong@example.com",
+			Username: "stronguser",
+			Password: "password123",
+		}); err != nil {
+			t.Errorf("expected an acceptable password to register, got %v", err)
+		}
+	})
+}
+
+func TestEmailNormalization(t *testing.T) {
+	t.Run("logs in with any email case", func(t *testing.T) {
+		authService, db := newTestAuthService(t)
+		defer db.Close()
+
+		ctx := context.Background()
+		user, _, err := authService.Register(ctx, &domain.CreateUserInput{
+			Email:    "  Mixed.Case@Example.COM ",
+			Username: "mixedcase",
+			Password: "password123",
+		})
+		if err != nil {
+			t.Fatalf("failed to register user: %v", err)
+		}
+		if user.Email != "mixed.case@example.com" {
+			t.Errorf("expected stored email to be normalized, got %q", user.Email)
+		}
+
+		for _, email := range []string{"mixed.case@example.com", "MIXED.CASE@EXAMPLE.COM", "Mixed.Case@Example.com"} {
+			if _, _, err := authService.Login(ctx, email, "password123"); err != nil {
+				t.Errorf("Login(%q) error = %v", email, err)
+			}
+		}
+	})
+
+	t.Run("rejects duplicate registration with different case", func(t *testing.T) {
+		authService, db := newTestAuthService(t)
+		defer db.Close()
+
+		ctx := context.Background()
+		if _, _, err := authService.Register(ctx, &domain.CreateUserInput{
+			Email:    "test@example.com",
+			Username: "first",
+			Password: "password123",
+		}); err != nil {
+			t.Fatalf("failed to register user: %v", err)
+		}
+
+		_, _, err := authService.Register(ctx, &domain.CreateUserInput{
+			Email:    "Test@Example.com",
+			Username: "second",
+			Password: "password123",
+		})
+		if err != domain.ErrEmailAlreadyTaken {
+			t.Errorf("expected ErrEmailAlreadyTaken, got %v", err)
+		}
+	})
+
+	t.Run("normalizes email on update", func(t *testing.T) {
+		authService, db := newTestAuthService(t)
+		defer db.Close()
+
+		ctx := context.Background()
+		user, _, err := authService.Register(ctx, &domain.CreateUserInput{
+			Email:    "before@example.com",
+			Username: "updater",
+			Password: "password123",
+		})
+		if err != nil {
+			t.Fatalf("failed to register user: %v", err)
+		}
+
+		newEmail := " After@Example.com"
+		updated, err := authService.UpdateUser(ctx, user.ID, &domain.UpdateUserInput{Email: &newEmail})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if updated.Email != "after@example.com" {
+			t.Errorf("expected normalized email, got %q", updated.Email)
+		}
+	})
 }
 
 // =============================================================================
@@ -236,7 +470,7 @@ func TestLogin(t *testing.T) {
 		}
 
 		// Then try to login
-		user, token, err := authService.Login(ctx, "login@example.com", "password123")
+		user, tokens, err := authService.Login(ctx, "login@example.com", "password123")
 
 		if err != nil {
 			t.Errorf("expected no error, got %v", err)
@@ -247,8 +481,8 @@ func TestLogin(t *testing.T) {
 		if user.Email != registerInput.Email {
 			t.Errorf("expected email %s, got %s", registerInput.Email, user.Email)
 		}
-		if token == "" {
-			t.Error("expected token to be returned")
+		if tokens == nil || tokens.AccessToken == "" || tokens.RefreshToken == "" {
+			t.Error("expected access and refresh tokens to be returned")
 		}
 	})
 
@@ -295,12 +529,530 @@ func TestLogin(t *testing.T) {
 			t.Errorf("expected ErrInvalidCredentials, got %v", err)
 		}
 	})
+
+	t.Run("unknown email and wrong password are indistinguishable", func(t *testing.T) {
+		authService, db := newTestAuthService(t)
+		defer db.Close()
+
+		ctx := context.Background()
+		if _, _, err := authService.Register(ctx, &domain.CreateUserInput{
+			Email:    "known@example.com",
+			Username: "knownuser",
+			Password: "password123",
+		}); err != nil {
+			t.Fatalf("failed to register user: %v", err)
+		}
+
+		_, _, wrongPassword := authService.Login(ctx, "known@example.com", "wrongpassword")
+		_, _, unknownEmail := authService.Login(ctx, "unknown@example.com", "wrongpassword")
+		if wrongPassword != domain.ErrInvalidCredentials || unknownEmail != domain.ErrInvalidCredentials {
+			t.Errorf("expected ErrInvalidCredentials for both, got %v and %v", wrongPassword, unknownEmail)
+		}
+
+		// The unknown-email path compares against a hash of the same cost
+		cost, err := bcrypt.Cost(dummyPasswordHash(DefaultAuthServiceConfig().BcryptCost))
+		if err != nil || cost != bcrypt.DefaultCost {
+			t.Errorf("expected dummy hash at cost %d, got %d (%v)", bcrypt.DefaultCost, cost, err)
+		}
+	})
+}
+
+// =============================================================================
+// Login Lockout Tests
+// =============================================================================
+
+func TestLoginLockout(t *testing.T) {
+	setup := func(t *testing.T, now func() time.Time) (*AuthService, *sql.DB) {
+		t.Helper()
+		authService, db := newTestAuthService(t)
+		authService.SetLoginAttemptTracker(newMemoryLoginAttemptTrackerWithClock(3, 10*time.Minute, now))
+
+		_, _, err := authService.Register(context.Background(), &domain.CreateUserInput{
+			Email:    "locked@example.com",
+			Username: "lockeduser",
+			Password: "password123",
+		})
+		if err != nil {
+			t.Fatalf("failed to register user: %v", err)
+		}
+		return authService, db
+	}
+
+	t.Run("locks out after repeated failures", func(t *testing.T) {
+		clock := time.Now()
+		authService, db := setup(t, func() time.Time { return clock })
+		defer db.Close()
+		ctx := context.Background()
+
+		for i := 0; i < 3; i++ {
+			if _, _, err := authService.Login(ctx, "locked@example.com", "wrong"); err != domain.ErrInvalidCredentials {
+				t.Fatalf("attempt %d: expected ErrInvalidCredentials, got %v", i+1, err)
+			}
+		}
+
+		// Even the right password is refused during the lockout
+		_, _, err := authService.Login(ctx, "LOCKED@example.com", "password123")
+		if !errors.Is(err, domain.ErrTooManyAttempts) {
+			t.Fatalf("expected ErrTooManyAttempts, got %v", err)
+		}
+		var lockout *domain.TooManyAttemptsError
+		if !errors.As(err, &lockout) || lockout.RetryAfter != 10*time.Minute {
+			t.Errorf("expected RetryAfter of 10m, got %v", err)
+		}
+
+		// The lockout ends with the window
+		clock = clock.Add(10 * time.Minute)
+		if _, _, err := authService.Login(ctx, "locked@example.com", "password123"); err != nil {
+			t.Errorf("expected login after the window, got %v", err)
+		}
+	})
+
+	t.Run("successful login resets the counter", func(t *testing.T) {
+		authService, db := setup(t, time.Now)
+		defer db.Close()
+		ctx := context.Background()
+
+		for i := 0; i < 2; i++ {
+			authService.Login(ctx, "locked@example.com", "wrong")
+		}
+		if _, _, err := authService.Login(ctx, "locked@example.com", "password123"); err != nil {
+			t.Fatalf("expected login to succeed, got %v", err)
+		}
+		for i := 0; i < 2; i++ {
+			authService.Login(ctx, "locked@example.com", "wrong")
+		}
+
+		if _, _, err := authService.Login(ctx, "locked@example.com", "password123"); err != nil {
+			t.Errorf("expected login to succeed after the reset, got %v", err)
+		}
+	})
+
+	t.Run("unknown emails are counted", func(t *testing.T) {
+		authService, db := setup(t, time.Now)
+		defer db.Close()
+		ctx := context.Background()
+
+		for i := 0; i < 3; i++ {
+			authService.Login(ctx, "ghost@example.com", "wrong")
+		}
+		if _, _, err := authService.Login(ctx, "ghost@example.com", "wrong"); !errors.Is(err, domain.ErrTooManyAttempts) {
+			t.Errorf("expected ErrTooManyAttempts, got %v", err)
+		}
+	})
+}
+
+func TestLoginRehashesPassword(t *testing.T) {
+	storedCost := func(t *testing.T, db *sql.DB, userID int64) int {
+		t.Helper()
+		var hash string
+		if err := db.QueryRow(`SELECT password_hash FROM users WHERE id = ?`, userID).Scan(&hash); err != nil {
+			t.Fatalf("failed to read password hash: %v", err)
+		}
+		cost, err := bcrypt.Cost([]byte(hash))
+		if err != nil {
+			t.Fatalf("stored hash is not bcrypt: %v", err)
+		}
+		return cost
+	}
+
+	t.Run("upgrades a hash stored with an old cost", func(t *testing.T) {
+		authService, db := newTestAuthService(t)
+		defer db.Close()
+		ctx := context.Background()
+
+		config := DefaultAuthServiceConfig()
+		config.BcryptCost = bcrypt.MinCost
+		authService.SetConfig(config)
+
+		user, _, err := authService.Register(ctx, &domain.CreateUserInput{
+			Email:    "rehash@example.com",
+			Username: "rehashuser",
+			Password: "password123",
+		})
+		if err != nil {
+			t.Fatalf("failed to register user: %v", err)
+		}
+		if cost := storedCost(t, db, user.ID); cost != bcrypt.MinCost {
+			t.Fatalf("expected initial cost %d, got %d", bcrypt.MinCost, cost)
+		}
+
+		config.BcryptCost = bcrypt.MinCost + 2
+		authService.SetConfig(config)
+
+		if _, _, err := authService.Login(ctx, "rehash@example.com", "password123"); err != nil {
+			t.Fatalf("expected login to succeed, got %v", err)
+		}
+		if cost := storedCost(t, db, user.ID); cost != bcrypt.MinCost+2 {
+			t.Errorf("expected hash upgraded to cost %d, got %d", bcrypt.MinCost+2, cost)
+		}
+
+		// The upgraded hash still verifies
+		if _, _, err := authService.Login(ctx, "rehash@example.com", "password123"); err != nil {
+			t.Errorf("expected login with upgraded hash to succeed, got %v", err)
+		}
+	})
+
+	t.Run("leaves a stronger hash alone", func(t *testing.T) {
+		authService, db := newTestAuthService(t)
+		defer db.Close()
+		ctx := context.Background()
+
+		config := DefaultAuthServiceConfig()
+		config.BcryptCost = bcrypt.MinCost + 2
+		authService.SetConfig(config)
+
+		user, _, err := authService.Register(ctx, &domain.CreateUserInput{
+			Email:    "strong@example.com",
+			Username: "stronguser",
+			Password: "password123",
+		})
+		if err != nil {
+			t.Fatalf("failed to register user: %v", err)
+		}
+
+		config.BcryptCost = bcrypt.MinCost
+		authService.SetConfig(config)
+
+		if _, _, err := authService.Login(ctx, "strong@example.com", "password123"); err != nil {
+			t.Fatalf("expected login to succeed, got %v", err)
+		}
+		if cost := storedCost(t, db, user.ID); cost != bcrypt.MinCost+2 {
+			t.Errorf("expected cost %d to be kept, got %d", bcrypt.MinCost+2, cost)
+		}
+	})
+}
+
+// =============================================================================
+// Refresh Token Tests
+// =============================================================================
+
+func TestRefreshTokens(t *testing.T) {
+	register := func(t *testing.T, authService *AuthService) *domain.TokenPair {
+		t.Helper()
+		_, tokens, err := authService.Register(context.Background(), &domain.CreateUserInput{
+			Email:    "refresh@example.com",
+			Username: "refreshuser",
+			Password: "password123",
+		})
+		if err != nil {
+			t.Fatalf("failed to register user: %v", err)
+		}
+		return tokens
+	}
+
+	t.Run("issues a new pair and rotates the old token", func(t *testing.T) {
+		authService, db := newTestAuthService(t)
+		defer db.Close()
+		ctx := context.Background()
+
+		tokens := register(t, authService)
+
+		user, next, err := authService.RefreshTokens(ctx, tokens.RefreshToken)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if user.Username != "refreshuser" {
+			t.Errorf("expected refreshuser, got %s", user.Username)
+		}
+		if next.RefreshToken == tokens.RefreshToken {
+			t.Error("expected a new refresh token")
+		}
+		if userID, err := authService.ValidateToken(ctx, next.AccessToken); err != nil || userID != user.ID {
+			t.Errorf("expected a valid access token for user %d, got %d (%v)", user.ID, userID, err)
+		}
+	})
+
+	t.Run("rejects reuse after rotation and revokes the new token", func(t *testing.T) {
+		authService, db := newTestAuthService(t)
+		defer db.Close()
+		ctx := context.Background()
+
+		tokens := register(t, authService)
+		_, next, err := authService.RefreshTokens(ctx, tokens.RefreshToken)
+		if err != nil {
+			t.Fatalf("failed to refresh: %v", err)
+		}
+
+		if _, _, err := authService.RefreshTokens(ctx, tokens.RefreshToken); err != domain.ErrInvalidRefreshToken {
+			t.Errorf("expected ErrInvalidRefreshToken on reuse, got %v", err)
+		}
+		if _, _, err := authService.RefreshTokens(ctx, next.RefreshToken); err != domain.ErrInvalidRefreshToken {
+			t.Errorf("expected the rotated token to be revoked after reuse, got %v", err)
+		}
+	})
+
+	t.Run("rejects an expired token", func(t *testing.T) {
+		authService, db := newTestAuthService(t)
+		defer db.Close()
+
+		config := DefaultAuthServiceConfig()
+		config.RefreshTokenExpiry = -time.Hour
+		authService.SetConfig(config)
+
+		tokens := register(t, authService)
+
+		if _, _, err := authService.RefreshTokens(context.Background(), tokens.RefreshToken); err != domain.ErrInvalidRefreshToken {
+			t.Errorf("expected ErrInvalidRefreshToken, got %v", err)
+		}
+	})
+
+	t.Run("rejects an unknown token", func(t *testing.T) {
+		authService, db := newTestAuthService(t)
+		defer db.Close()
+
+		if _, _, err := authService.RefreshTokens(context.Background(), "not-a-real-token"); err != domain.ErrInvalidRefreshToken {
+			t.Errorf("expected ErrInvalidRefreshToken, got %v", err)
+		}
+	})
+
+	t.Run("logout revokes the token", func(t *testing.T) {
+		authService, db := newTestAuthService(t)
+		defer db.Close()
+		ctx := context.Background()
+
+		tokens := register(t, authService)
+
+		if err := authService.Logout(ctx, tokens.RefreshToken, ""); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if err := authService.Logout(ctx, tokens.RefreshToken, ""); err != nil {
+			t.Errorf("expected logging out twice to succeed, got %v", err)
+		}
+		if _, _, err := authService.RefreshTokens(ctx, tokens.RefreshToken); err != domain.ErrInvalidRefreshToken {
+			t.Errorf("expected ErrInvalidRefreshToken after logout, got %v", err)
+		}
+	})
+
+	t.Run("logout revokes the access token", func(t *testing.T) {
+		authService, db := newTestAuthService(t)
+		defer db.Close()
+		ctx := context.Background()
+
+		tokens := register(t, authService)
+
+		if _, err := authService.ValidateToken(ctx, tokens.AccessToken); err != nil {
+			t.Fatalf("expected token to be valid before logout, got %v", err)
+		}
+		if err := authService.Logout(ctx, "", tokens.AccessToken); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if _, err := authService.ValidateToken(ctx, tokens.AccessToken); err != domain.ErrTokenRevoked {
+			t.Errorf("expected ErrTokenRevoked after logout, got %v", err)
+		}
+
+		// Other tokens for the same user are unaffected
+		other, err := authService.GenerateToken(1)
+		if err != nil {
+			t.Fatalf("failed to generate token: %v", err)
+		}
+		if _, err := authService.ValidateToken(ctx, other); err != nil {
+			t.Errorf("expected a different token to stay valid, got %v", err)
+		}
+	})
+
+	t.Run("purge drops expired blocklist entries", func(t *testing.T) {
+		authService, db := newTestAuthService(t)
+		defer db.Close()
+		ctx := context.Background()
+
+		tokens := register(t, authService)
+		if err := authService.Logout(ctx, "", tokens.AccessToken); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if _, err := db.Exec(`UPDATE revoked_tokens SET expires_at = ?`, time.Now().Add(-time.Minute)); err != nil {
+			t.Fatalf("failed to expire blocklist entry: %v", err)
+		}
+
+		deleted, err := authService.PurgeRevokedTokens(ctx)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if deleted != 1 {
+			t.Errorf("expected 1 entry purged, got %d", deleted)
+		}
+	})
+}
+
+// =============================================================================
+// Password Reset Tests
+// =============================================================================
+
+func TestPasswordReset(t *testing.T) {
+	// setup registers a user and captures reset tokens published on the bus
+	setup := func(t *testing.T, ttl time.Duration) (*AuthService, *sql.DB, *[]string) {
+		t.Helper()
+		authService, db := newTestAuthService(t)
+
+		config := DefaultAuthServiceConfig()
+		config.PasswordResetTTL = ttl
+		authService.SetConfig(config)
+
+		var tokens []string
+		bus := events.NewBus(newTestLogger())
+		bus.Subscribe(func(ctx context.Context, event events.Event) {
+			if e, ok := event.(events.PasswordResetRequested); ok {
+				tokens = append(tokens, e.Token)
+			}
+		})
+		authService.SetEventBus(bus)
+
+		_, _, err := authService.Register(context.Background(), &domain.CreateUserInput{
+			Email:    "reset@example.com",
+			Username: "resetuser",
+			Password: "oldpassword",
+		})
+		if err != nil {
+			t.Fatalf("failed to register user: %v", err)
+		}
+		return authService, db, &tokens
+	}
+
+	t.Run("resets the password and signs out other sessions", func(t *testing.T) {
+		authService, db, tokens := setup(t, time.Hour)
+		defer db.Close()
+		ctx := context.Background()
+
+		_, session, _ := authService.Login(ctx, "reset@example.com", "oldpassword")
+
+		if err := authService.RequestPasswordReset(ctx, "Reset@Example.com"); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(*tokens) != 1 {
+			t.Fatalf("expected 1 published token, got %d", len(*tokens))
+		}
+
+		if err := authService.ConfirmPasswordReset(ctx, (*tokens)[0], "newpassword"); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if _, _, err := authService.Login(ctx, "reset@example.com", "newpassword"); err != nil {
+			t.Errorf("expected login with new password, got %v", err)
+		}
+		if _, _, err := authService.Login(ctx, "reset@example.com", "oldpassword"); err != domain.ErrInvalidCredentials {
+			t.Errorf("expected old password to be rejected, got %v", err)
+		}
+		if _, _, err := authService.RefreshTokens(ctx, session.RefreshToken); err != domain.ErrInvalidRefreshToken {
+			t.Errorf("expected refresh token to be revoked, got %v", err)
+		}
+	})
+
+	t.Run("unknown email succeeds without a token", func(t *testing.T) {
+		authService, db, tokens := setup(t, time.Hour)
+		defer db.Close()
+
+		if err := authService.RequestPasswordReset(context.Background(), "nobody@example.com"); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+		if len(*tokens) != 0 {
+			t.Errorf("expected no published token, got %d", len(*tokens))
+		}
+	})
+
+	t.Run("rejects an already used token", func(t *testing.T) {
+		authService, db, tokens := setup(t, time.Hour)
+		defer db.Close()
+		ctx := context.Background()
+
+		authService.RequestPasswordReset(ctx, "reset@example.com")
+		if err := authService.ConfirmPasswordReset(ctx, (*tokens)[0], "newpassword"); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if err := authService.ConfirmPasswordReset(ctx, (*tokens)[0], "otherpassword"); err != domain.ErrInvalidResetToken {
+			t.Errorf("expected ErrInvalidResetToken, got %v", err)
+		}
+	})
+
+	t.Run("rejects an expired token", func(t *testing.T) {
+		authService, db, tokens := setup(t, -time.Minute)
+		defer db.Close()
+		ctx := context.Background()
+
+		authService.RequestPasswordReset(ctx, "reset@example.com")
+
+		if err := authService.ConfirmPasswordReset(ctx, (*tokens)[0], "newpassword"); err != domain.ErrInvalidResetToken {
+			t.Errorf("expected ErrInvalidResetToken, got %v", err)
+		}
+		if _, _, err := authService.Login(ctx, "reset@example.com", "oldpassword"); err != nil {
+			t.Errorf("expected old password to still work, got %v", err)
+		}
+	})
+
+	t.Run("rejects a blank new password", func(t *testing.T) {
+		authService, db, tokens := setup(t, time.Hour)
+		defer db.Close()
+		ctx := context.Background()
+
+		authService.RequestPasswordReset(ctx, "reset@example.com")
+
+		if _, ok := authService.ConfirmPasswordReset(ctx, (*tokens)[0], "").(*domain.ValidationErrors); !ok {
+			t.Error("expected ValidationErrors for a blank password")
+		}
+	})
 }
 
 // =============================================================================
 // TDD: JWT Token Tests
 // =============================================================================
 
+func TestExpiredTokenCleanup(t *testing.T) {
+	authService, db := newTestAuthService(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	user, _, err := authService.Register(ctx, &domain.CreateUserInput{
+		Email:    "sweep@example.com",
+		Username: "sweeper",
+		Password: "password123",
+	})
+	if err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	past := time.Now().Add(-time.Minute)
+	if _, err := db.Exec(`INSERT INTO refresh_tokens (user_id, token_hash, expires_at) VALUES (?, 'stale-refresh', ?)`, user.ID, past); err != nil {
+		t.Fatalf("failed to insert expired refresh token: %v", err)
+	}
+	if _, err := db.Exec(`INSERT INTO password_resets (user_id, token_hash, expires_at) VALUES (?, 'stale-reset', ?)`, user.ID, past); err != nil {
+		t.Fatalf("failed to insert expired password reset: %v", err)
+	}
+	if err := authService.RequestPasswordReset(ctx, "sweep@example.com"); err != nil {
+		t.Fatalf("RequestPasswordReset() error = %v", err)
+	}
+
+	count := func(table string) int {
+		t.Helper()
+		var n int
+		if err := db.QueryRow(`SELECT COUNT(*) FROM ` + table).Scan(&n); err != nil {
+			t.Fatalf("failed to count %s: %v", table, err)
+		}
+		return n
+	}
+
+	cleanupCtx, stop := context.WithCancel(ctx)
+	defer stop()
+	authService.StartExpiredTokenCleanup(cleanupCtx, 10*time.Millisecond)
+
+	deadline := time.Now().Add(2 * time.Second)
+	for count("refresh_tokens") != 1 || count("password_resets") != 1 {
+		if time.Now().After(deadline) {
+			t.Fatalf("expired tokens not pruned: %d refresh tokens, %d password resets left",
+				count("refresh_tokens"), count("password_resets"))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Only the expired rows are gone
+	var stale int
+	db.QueryRow(`SELECT COUNT(*) FROM refresh_tokens WHERE token_hash = 'stale-refresh'`).Scan(&stale)
+	if stale != 0 {
+		t.Error("expected the expired refresh token to be pruned")
+	}
+	db.QueryRow(`SELECT COUNT(*) FROM password_resets WHERE token_hash = 'stale-reset'`).Scan(&stale)
+	if stale != 0 {
+		t.Error("expected the expired password reset to be pruned")
+	}
+}
+
 func TestGenerateToken(t *testing.T) {
 	t.Run("generates a valid JWT token", func(t *testing.T) {
 		authService, db := newTestAuthService(t)
@@ -329,7 +1081,7 @@ func TestValidateToken(t *testing.T) {
 		}
 
 		// Validate the token
-		userID, err := authService.ValidateToken(token)
+		userID, err := authService.ValidateToken(context.Background(), token)
 
 		if err != nil {
 			t.Errorf("expected no error, got %v", err)
@@ -343,7 +1095,7 @@ func TestValidateToken(t *testing.T) {
 		authService, db := newTestAuthService(t)
 		defer db.Close()
 
-		_, err := authService.ValidateToken("invalid.token.here")
+		_, err := authService.ValidateToken(context.Background(), "invalid.token.here")
 
 		if err == nil {
 			t.Error("expected error for invalid token")
@@ -358,7 +1110,10 @@ func TestValidateToken(t *testing.T) {
 		userRepo := repository.NewSQLiteUserRepository(db, logger)
 
 		// Create service with very short expiry
-		authService := NewAuthService(userRepo, "test-jwt-secret", -1*time.Hour, logger)
+		refreshTokenRepo := repository.NewSQLiteRefreshTokenRepository(db, logger)
+		passwordResetRepo := repository.NewSQLitePasswordResetRepository(db, logger)
+		revokedTokenRepo := repository.NewSQLiteRevokedTokenRepository(db, logger)
+		authService := NewAuthService(userRepo, refreshTokenRepo, passwordResetRepo, revokedTokenRepo, "test-jwt-secret", -1*time.Hour, logger)
 
 		// Generate a token (already expired)
 		token, err := authService.GenerateToken(123)
@@ -367,7 +1122,7 @@ func TestValidateToken(t *testing.T) {
 		}
 
 		// Validate the token - should fail
-		_, err = authService.ValidateToken(token)
+		_, err = authService.ValidateToken(context.Background(), token)
 
 		if err == nil {
 			t.Error("expected error for expired token")
@@ -382,8 +1137,11 @@ func TestValidateToken(t *testing.T) {
 		userRepo := repository.NewSQLiteUserRepository(db, logger)
 
 		// Create two services with different secrets
-		authService1 := NewAuthService(userRepo, "secret1", 24*time.Hour, logger)
-		authService2 := NewAuthService(userRepo, "secret2", 24*time.Hour, logger)
+		refreshTokenRepo := repository.NewSQLiteRefreshTokenRepository(db, logger)
+		passwordResetRepo := repository.NewSQLitePasswordResetRepository(db, logger)
+		revokedTokenRepo := repository.NewSQLiteRevokedTokenRepository(db, logger)
+		authService1 := NewAuthService(userRepo, refreshTokenRepo, passwordResetRepo, revokedTokenRepo, "secret1", 24*time.Hour, logger)
+		authService2 := NewAuthService(userRepo, refreshTokenRepo, passwordResetRepo, revokedTokenRepo, "secret2", 24*time.Hour, logger)
 
 		// Generate a token with service1
 		token, err := authService1.GenerateToken(123)
@@ -392,7 +1150,7 @@ func TestValidateToken(t *testing.T) {
 		}
 
 		// Try to validate with service2 (different secret)
-		_, err = authService2.ValidateToken(token)
+		_, err = authService2.ValidateToken(context.Background(), token)
 
 		if err == nil {
 			t.Error("expected error for token with wrong secret")
@@ -491,7 +1249,7 @@ func TestUpdateUser(t *testing.T) {
 		}
 	})
 
-	t.Run("updates user password", func(t *testing.T) {
+	t.Run("rejects password changes", func(t *testing.T) {
 		authService, db := newTestAuthService(t)
 		defer db.Close()
 
@@ -508,27 +1266,20 @@ func TestUpdateUser(t *testing.T) {
 			t.Fatalf("failed to register user: %v", err)
 		}
 
-		// Update password
 		newPassword := "newpassword"
 		updateInput := &domain.UpdateUserInput{
 			Password: &newPassword,
 		}
 
 		_, err = authService.UpdateUser(ctx, user.ID, updateInput)
-		if err != nil {
-			t.Errorf("expected no error, got %v", err)
+		if _, ok := err.(*domain.ValidationErrors); !ok {
+			t.Fatalf("expected ValidationErrors, got %v", err)
 		}
 
-		// Verify login with new password works
-		_, _, err = authService.Login(ctx, "passupdate@example.com", "newpassword")
-		if err != nil {
-			t.Errorf("login with new password should work: %v", err)
-		}
-
-		// Verify login with old password fails
+		// The old password must still work
 		_, _, err = authService.Login(ctx, "passupdate@example.com", "oldpassword")
-		if err == nil {
-			t.Error("login with old password should fail")
+		if err != nil {
+			t.Errorf("login with old password should still work: %v", err)
 		}
 	})
 
@@ -570,3 +1321,130 @@ func TestUpdateUser(t *testing.T) {
 		}
 	})
 }
+
+// =============================================================================
+// TDD: RejectHTML Tests
+// =============================================================================
+
+func TestChangePassword(t *testing.T) {
+	register := func(t *testing.T, authService *AuthService) (*domain.User, *domain.TokenPair) {
+		t.Helper()
+		user, tokens, err := authService.Register(context.Background(), &domain.CreateUserInput{
+			Email:    "change@example.com",
+			Username: "changeuser",
+			Password: "oldpassword",
+		})
+		if err != nil {
+			t.Fatalf("failed to register user: %v", err)
+		}
+		return user, tokens
+	}
+
+	t.Run("changes the password with the correct current password", func(t *testing.T) {
+		authService, db := newTestAuthService(t)
+		defer db.Close()
+
+		ctx := context.Background()
+		user, session := register(t, authService)
+
+		_, tokens, err := authService.ChangePassword(ctx, user.ID, "oldpassword", "newpassword")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if tokens.AccessToken == "" || tokens.RefreshToken == "" {
+			t.Error("expected a new token pair")
+		}
+
+		if _, _, err := authService.Login(ctx, "change@example.com", "newpassword"); err != nil {
+			t.Errorf("login with new password should work: %v", err)
+		}
+		if _, _, err := authService.Login(ctx, "change@example.com", "oldpassword"); err != domain.ErrInvalidCredentials {
+			t.Errorf("expected ErrInvalidCredentials for old password, got %v", err)
+		}
+		if _, _, err := authService.RefreshTokens(ctx, session.RefreshToken); err != domain.ErrInvalidRefreshToken {
+			t.Errorf("expected earlier refresh token to be revoked, got %v", err)
+		}
+	})
+
+	t.Run("rejects an incorrect current password", func(t *testing.T) {
+		authService, db := newTestAuthService(t)
+		defer db.Close()
+
+		ctx := context.Background()
+		user, _ := register(t, authService)
+
+		_, _, err := authService.ChangePassword(ctx, user.ID, "wrongpassword", "newpassword")
+		validationErrors, ok := err.(*domain.ValidationErrors)
+		if !ok {
+			t.Fatalf("expected ValidationErrors, got %v", err)
+		}
+		if len(validationErrors.Errors) != 1 || validationErrors.Errors[0].Field != "password" || validationErrors.Errors[0].Message != "is invalid" {
+			t.Errorf("expected password: is invalid, got %v", validationErrors.Errors)
+		}
+
+		if _, _, err := authService.Login(ctx, "change@example.com", "oldpassword"); err != nil {
+			t.Errorf("old password should still work: %v", err)
+		}
+	})
+
+	t.Run("rejects a short new password", func(t *testing.T) {
+		authService, db := newTestAuthService(t)
+		defer db.Close()
+
+		user, _ := register(t, authService)
+
+		_, _, err := authService.ChangePassword(context.Background(), user.ID, "oldpassword", "short")
+		validationErrors, ok := err.(*domain.ValidationErrors)
+		if !ok {
+			t.Fatalf("expected ValidationErrors, got %v", err)
+		}
+		if len(validationErrors.Errors) != 1 || validationErrors.Errors[0].Field != "newPassword" {
+			t.Errorf("expected newPassword error, got %v", validationErrors.Errors)
+		}
+	})
+}
+
+func TestAuthService_RejectHTML(t *testing.T) {
+	t.Run("rejects HTML in username on register when enabled", func(t *testing.T) {
+		authService, db := newTestAuthService(t)
+		defer db.Close()
+
+		authService.SetConfig(AuthServiceConfig{RejectHTML: true})
+
+		_, _, err := authService.Register(context.Background(), &domain.CreateUserInput{
+			Email:    "html@example.com",
+			Username: "<b>bold</b>",
+			Password: "password123",
+		})
+		if _, ok := err.(*domain.ValidationErrors); !ok {
+			t.Fatalf("expected ValidationErrors, got %T", err)
+		}
+	})
+
+	t.Run("rejects HTML in bio on update when enabled", func(t *testing.T) {
+		authService, db := newTestAuthService(t)
+		defer db.Close()
+
+		authService.SetConfig(AuthServiceConfig{RejectHTML: true})
+		ctx := context.Background()
+
+		user, _, err := authService.Register(ctx, &domain.CreateUserInput{
+			Email:    "bio@example.com",
+			Username: "biouser",
+			Password: "password123",
+		})
+		if err != nil {
+			t.Fatalf("failed to register user: %v", err)
+		}
+
+		bio := "I <3 Go, see <a href=\"x\">here</a>"
+		_, err = authService.UpdateUser(ctx, user.ID, &domain.UpdateUserInput{Bio: &bio})
+		validationErr, ok := err.(*domain.ValidationErrors)
+		if !ok {
+			t.Fatalf("expected ValidationErrors, got %T", err)
+		}
+		if len(validationErr.Errors) != 1 || validationErr.Errors[0].Field != "bio" {
+			t.Errorf("expected a single bio error, got %+v", validationErr.Errors)
+		}
+	})
+}
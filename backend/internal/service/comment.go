@@ -3,17 +3,48 @@ package service
 import (
 	"context"
 	"log/slog"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
 	"github.com/alexlee0213/realworld-conduit/backend/internal/repository"
 )
 
+// CommentServiceConfig holds tunable comment behavior
+type CommentServiceConfig struct {
+	// MinInterval is the minimum time between two comments by the same user
+	// across all articles (0 disables the cooldown)
+	MinInterval time.Duration
+	// MaxOffset is the largest pagination offset accepted by list endpoints
+	// (0 disables the cap)
+	MaxOffset int
+	// DefaultSort is the comment order used when a request doesn't ask for one
+	DefaultSort domain.CommentSort
+	// MinAccountAge is how old an account must be before it can comment
+	// (0 disables the check)
+	MinAccountAge time.Duration
+	// MaintainerIDs are the users allowed to review comment reports
+	MaintainerIDs []int64
+}
+
+// DefaultCommentServiceConfig returns the default comment configuration
+func DefaultCommentServiceConfig() CommentServiceConfig {
+	return CommentServiceConfig{
+		MinInterval:   0,
+		MaxOffset:     DefaultMaxOffset,
+		DefaultSort:   domain.CommentSortOldest,
+		MinAccountAge: 0,
+	}
+}
+
 // CommentService handles comment business logic
 type CommentService struct {
 	commentRepo repository.CommentRepository
 	articleRepo repository.ArticleRepository
 	userRepo    repository.UserRepository
+	followRepo  repository.FollowRepository
+	config      CommentServiceConfig
 	logger      *slog.Logger
 }
 
@@ -22,16 +53,24 @@ func NewCommentService(
 	commentRepo repository.CommentRepository,
 	articleRepo repository.ArticleRepository,
 	userRepo repository.UserRepository,
+	followRepo repository.FollowRepository,
 	logger *slog.Logger,
 ) *CommentService {
 	return &CommentService{
 		commentRepo: commentRepo,
 		articleRepo: articleRepo,
 		userRepo:    userRepo,
+		followRepo:  followRepo,
+		config:      DefaultCommentServiceConfig(),
 		logger:      logger,
 	}
 }
 
+// SetConfig replaces the service configuration
+func (s *CommentService) SetConfig(config CommentServiceConfig) {
+	s.config = config
+}
+
 // CreateComment creates a new comment on an article
 func (s *CommentService) CreateComment(ctx context.Context, slug string, authorID int64, input *domain.CreateCommentInput) (*domain.Comment, error) {
 	// Validate input
@@ -40,15 +79,39 @@ func (s *CommentService) CreateComment(ctx context.Context, slug string, authorI
 	}
 
 	// Get the article by slug to verify it exists
-	article, err := s.articleRepo.GetArticleBySlug(ctx, slug)
+	article, err := loadVisibleArticle(ctx, s.articleRepo, slug, &authorID)
 	if err != nil {
 		return nil, err
 	}
 
+	if !article.CommentsEnabled {
+		return nil, domain.ErrCommentsDisabled
+	}
+
+	if err := checkAccountAge(ctx, s.userRepo, authorID, s.config.MinAccountAge); err != nil {
+		return nil, err
+	}
+
+	// Enforce the per-user cooldown between comments
+	if s.config.MinInterval > 0 {
+		latest, err := s.commentRepo.GetLatestCommentTimeByAuthor(ctx, authorID)
+		if err != nil {
+			return nil, err
+		}
+		if !latest.IsZero() && time.Since(latest) < s.config.MinInterval {
+			s.logger.Warn("comment rejected by cooldown",
+				"author_id", authorID,
+				"last_comment_at", latest,
+			)
+			return nil, domain.ErrCommentTooSoon
+		}
+	}
+
 	comment := &domain.Comment{
 		Body:      strings.TrimSpace(input.Body),
 		ArticleID: article.ID,
 		AuthorID:  authorID,
+		ParentID:  input.ParentID,
 	}
 
 	if err := s.commentRepo.CreateComment(ctx, comment); err != nil {
@@ -72,37 +135,193 @@ func (s *CommentService) CreateComment(ctx context.Context, slug string, authorI
 	return comment, nil
 }
 
-// GetCommentsByArticleSlug retrieves all comments for an article
-func (s *CommentService) GetCommentsByArticleSlug(ctx context.Context, slug string) ([]*domain.Comment, error) {
+// GetCommentsByArticleSlug retrieves a page of an article's comments and the
+// article's total comment count
+// An empty sort falls back to the configured default order
+// currentUserID is optional - if provided, the following status of each author will be included
+func (s *CommentService) GetCommentsByArticleSlug(ctx context.Context, slug string, params *domain.CommentListParams, currentUserID *int64) ([]*domain.Comment, int, error) {
 	// Get the article by slug to verify it exists and get its ID
-	article, err := s.articleRepo.GetArticleBySlug(ctx, slug)
+	article, err := loadVisibleArticle(ctx, s.articleRepo, slug, currentUserID)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+
+	if params == nil {
+		params = domain.DefaultCommentListParams()
+	}
+
+	// Apply defaults if not set
+	if params.Sort == "" {
+		params.Sort = s.config.DefaultSort
+	}
+	if params.Limit <= 0 {
+		params.Limit = 20
+	}
+	if params.Limit > 100 {
+		params.Limit = 100
+	}
+	if params.Offset < 0 {
+		params.Offset = 0
+	}
+	if err := validateOffset(params.Offset, s.config.MaxOffset); err != nil {
+		return nil, 0, err
 	}
 
-	comments, err := s.commentRepo.GetCommentsByArticleID(ctx, article.ID)
+	comments, total, err := s.commentRepo.GetCommentsByArticleID(ctx, article.ID, params)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+
+	if err := s.loadCommentAuthors(ctx, comments, currentUserID); err != nil {
+		return nil, 0, err
+	}
+
+	return comments, total, nil
+}
+
+// loadCommentAuthors attaches author profiles and following status to comments
+// using one query for the profiles and one for the follow status, regardless of
+// how many comments there are
+func (s *CommentService) loadCommentAuthors(ctx context.Context, comments []*domain.Comment, currentUserID *int64) error {
+	if len(comments) == 0 {
+		return nil
 	}
 
-	// Load author information for each comment
+	// Collect distinct author IDs
+	seen := make(map[int64]bool)
+	authorIDs := make([]int64, 0)
 	for _, comment := range comments {
-		author, err := s.userRepo.GetUserByID(ctx, comment.AuthorID)
+		if !seen[comment.AuthorID] {
+			seen[comment.AuthorID] = true
+			authorIDs = append(authorIDs, comment.AuthorID)
+		}
+	}
+
+	authors, err := s.userRepo.GetProfilesByIDs(ctx, authorIDs)
+	if err != nil {
+		return err
+	}
+
+	following := make(map[int64]bool)
+	if currentUserID != nil && *currentUserID != 0 {
+		following, err = s.followRepo.IsFollowingBulk(ctx, *currentUserID, authorIDs)
 		if err != nil {
-			s.logger.Error("failed to get comment author", "error", err, "author_id", comment.AuthorID)
+			s.logger.Error("failed to check follow status",
+				"error", err,
+				"follower_id", *currentUserID,
+			)
+			// Don't fail the request, just report not following
+			following = make(map[int64]bool)
+		}
+	}
+
+	for _, comment := range comments {
+		author, ok := authors[comment.AuthorID]
+		if !ok {
+			s.logger.Error("failed to get comment author", "author_id", comment.AuthorID)
 			continue
 		}
 		comment.Author = author
+		comment.AuthorFollowing = following[comment.AuthorID]
+	}
+
+	return nil
+}
+
+// GetCommentsByAuthorUsername retrieves a user's recent comments across all articles
+func (s *CommentService) GetCommentsByAuthorUsername(ctx context.Context, username string, limit, offset int) ([]*domain.Comment, int, error) {
+	author, err := s.userRepo.GetUserByUsername(ctx, username)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Apply defaults if not set
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	if err := validateOffset(offset, s.config.MaxOffset); err != nil {
+		return nil, 0, err
+	}
+
+	comments, total, err := s.commentRepo.ListCommentsByAuthor(ctx, author.ID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Every comment shares the same author
+	for _, comment := range comments {
+		comment.Author = author
+	}
+
+	return comments, total, nil
+}
+
+// UpdateComment replaces the body of a comment
+// Only the comment author can edit the comment (explicit authorization check)
+func (s *CommentService) UpdateComment(ctx context.Context, slug string, commentID int64, userID int64, input *domain.UpdateCommentInput) (*domain.Comment, error) {
+	// Validate input
+	if validationErrors := input.Validate(); validationErrors.HasErrors() {
+		return nil, validationErrors
+	}
+
+	// Get the article by slug to verify it exists
+	article, err := loadVisibleArticle(ctx, s.articleRepo, slug, &userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the comment, which must belong to this article
+	comment, err := s.commentRepo.GetCommentByID(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.ArticleID != article.ID {
+		return nil, domain.ErrCommentNotFound
+	}
+
+	// EXPLICIT AUTHORIZATION CHECK: Only the author can edit
+	if comment.AuthorID != userID {
+		s.logger.Warn("unauthorized comment update attempt",
+			"comment_id", commentID,
+			"author_id", comment.AuthorID,
+			"attempted_by", userID,
+		)
+		return nil, domain.ErrForbidden
+	}
+
+	comment.Body = strings.TrimSpace(input.Body)
+	if err := s.commentRepo.UpdateComment(ctx, comment); err != nil {
+		return nil, err
 	}
 
-	return comments, nil
+	// Load author information
+	author, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to get comment author", "error", err, "author_id", userID)
+		return nil, err
+	}
+	comment.Author = author
+
+	s.logger.Info("comment updated",
+		"comment_id", commentID,
+		"article_slug", slug,
+		"updated_by", userID,
+	)
+
+	return comment, nil
 }
 
 // DeleteComment deletes a comment
 // Only the comment author can delete the comment (explicit authorization check)
 func (s *CommentService) DeleteComment(ctx context.Context, slug string, commentID int64, userID int64) error {
 	// Get the article by slug to verify it exists
-	_, err := s.articleRepo.GetArticleBySlug(ctx, slug)
+	_, err := loadVisibleArticle(ctx, s.articleRepo, slug, &userID)
 	if err != nil {
 		return err
 	}
@@ -135,3 +354,69 @@ func (s *CommentService) DeleteComment(ctx context.Context, slug string, comment
 
 	return nil
 }
+
+// ReportComment flags a comment for maintainer review
+// A user reporting the same comment twice gets their existing report back
+func (s *CommentService) ReportComment(ctx context.Context, slug string, commentID int64, reporterID int64, input *domain.ReportCommentInput) (*domain.CommentReport, error) {
+	// Validate input
+	if validationErrors := input.Validate(); validationErrors.HasErrors() {
+		return nil, validationErrors
+	}
+
+	// Get the article by slug to verify it exists
+	article, err := loadVisibleArticle(ctx, s.articleRepo, slug, &reporterID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the comment, which must belong to this article
+	comment, err := s.commentRepo.GetCommentByID(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.ArticleID != article.ID {
+		return nil, domain.ErrCommentNotFound
+	}
+
+	report := &domain.CommentReport{
+		CommentID:  comment.ID,
+		ReporterID: reporterID,
+		Reason:     strings.TrimSpace(input.Reason),
+	}
+	if err := s.commentRepo.CreateReport(ctx, report); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("comment reported",
+		"comment_id", commentID,
+		"article_slug", slug,
+		"reported_by", reporterID,
+	)
+
+	return report, nil
+}
+
+// ListReports retrieves a page of comment reports, newest first
+// Only configured maintainers can list reports
+func (s *CommentService) ListReports(ctx context.Context, userID int64, limit, offset int) ([]*domain.CommentReport, int, error) {
+	if !slices.Contains(s.config.MaintainerIDs, userID) {
+		s.logger.Warn("unauthorized report list attempt", "attempted_by", userID)
+		return nil, 0, domain.ErrForbidden
+	}
+
+	// Apply defaults if not set
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	if err := validateOffset(offset, s.config.MaxOffset); err != nil {
+		return nil, 0, err
+	}
+
+	return s.commentRepo.ListReports(ctx, limit, offset)
+}
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"os"
 	"testing"
+	"time"
 
 	_ "github.com/mattn/go-sqlite3"
 
@@ -56,6 +57,12 @@ func setupCommentTestDB(t *testing.T) *sql.DB {
 			title TEXT NOT NULL,
 			description TEXT NOT NULL DEFAULT '',
 			body TEXT NOT NULL DEFAULT '',
+			cover_image TEXT NOT NULL DEFAULT '',
+			published BOOLEAN NOT NULL DEFAULT 1,
+			comments_enabled BOOLEAN NOT NULL DEFAULT 1,
+			publish_at TIMESTAMP,
+			view_count INTEGER NOT NULL DEFAULT 0,
+			favorites_count INTEGER NOT NULL DEFAULT 0,
 			author_id INTEGER NOT NULL,
 			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
 			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
@@ -73,6 +80,7 @@ func setupCommentTestDB(t *testing.T) *sql.DB {
 			body TEXT NOT NULL,
 			article_id INTEGER NOT NULL,
 			author_id INTEGER NOT NULL,
+			parent_id INTEGER REFERENCES comments(id) ON DELETE SET NULL,
 			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
 			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
 			FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE,
@@ -83,6 +91,23 @@ func setupCommentTestDB(t *testing.T) *sql.DB {
 		t.Fatalf("failed to create comments table: %v", err)
 	}
 
+	// Create comment reports table
+	_, err = db.Exec(`
+		CREATE TABLE comment_reports (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			comment_id INTEGER NOT NULL,
+			reporter_id INTEGER NOT NULL,
+			reason TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			UNIQUE (comment_id, reporter_id),
+			FOREIGN KEY (comment_id) REFERENCES comments(id) ON DELETE CASCADE,
+			FOREIGN KEY (reporter_id) REFERENCES users(id) ON DELETE CASCADE
+		)
+	`)
+	if err != nil {
+		t.Fatalf("failed to create comment_reports table: %v", err)
+	}
+
 	// Create tags table
 	_, err = db.Exec(`
 		CREATE TABLE tags (
@@ -155,11 +180,44 @@ func newTestCommentService(t *testing.T) (*CommentService, *sql.DB) {
 	commentRepo := repository.NewSQLiteCommentRepository(db, logger)
 	articleRepo := repository.NewSQLiteArticleRepository(db, logger)
 	userRepo := repository.NewSQLiteUserRepository(db, logger)
+	followRepo := repository.NewSQLiteFollowRepository(db, logger)
 
-	commentService := NewCommentService(commentRepo, articleRepo, userRepo, logger)
+	commentService := NewCommentService(commentRepo, articleRepo, userRepo, followRepo, logger)
 	return commentService, db
 }
 
+// countingUserRepository counts user lookups made by the service
+type countingUserRepository struct {
+	repository.UserRepository
+	calls int
+}
+
+func (r *countingUserRepository) GetUserByID(ctx context.Context, id int64) (*domain.User, error) {
+	r.calls++
+	return r.UserRepository.GetUserByID(ctx, id)
+}
+
+func (r *countingUserRepository) GetProfilesByIDs(ctx context.Context, ids []int64) (map[int64]*domain.User, error) {
+	r.calls++
+	return r.UserRepository.GetProfilesByIDs(ctx, ids)
+}
+
+// countingFollowRepository counts follow status lookups made by the service
+type countingFollowRepository struct {
+	repository.FollowRepository
+	calls int
+}
+
+func (r *countingFollowRepository) IsFollowing(ctx context.Context, followerID, followingID int64) (bool, error) {
+	r.calls++
+	return r.FollowRepository.IsFollowing(ctx, followerID, followingID)
+}
+
+func (r *countingFollowRepository) IsFollowingBulk(ctx context.Context, followerID int64, followingIDs []int64) (map[int64]bool, error) {
+	r.calls++
+	return r.FollowRepository.IsFollowingBulk(ctx, followerID, followingIDs)
+}
+
 // createCommentTestUser creates a test user and returns the user ID
 func createCommentTestUser(t *testing.T, db *sql.DB, username, email string) int64 {
 	t.Helper()
@@ -281,6 +339,159 @@ func TestCommentService_CreateComment(t *testing.T) {
 	})
 }
 
+func TestCommentService_HiddenArticles(t *testing.T) {
+	hidden := map[string]string{
+		"draft":     `UPDATE articles SET published = 0 WHERE slug = ?`,
+		"scheduled": `UPDATE articles SET publish_at = datetime('now', '+1 hour') WHERE slug = ?`,
+	}
+
+	for name, hide := range hidden {
+		t.Run(name, func(t *testing.T) {
+			service, db := newTestCommentService(t)
+			defer db.Close()
+
+			authorID := createCommentTestUser(t, db, "author", "author@example.com")
+			otherID := createCommentTestUser(t, db, "other", "other@example.com")
+			slug := createCommentTestArticle(t, db, authorID, "hidden-article", "Hidden Article")
+			ctx := context.Background()
+
+			// A comment left while the article was still public
+			comment, err := service.CreateComment(ctx, slug, otherID, &domain.CreateCommentInput{Body: "Before"})
+			if err != nil {
+				t.Fatalf("failed to create comment: %v", err)
+			}
+			if _, err := db.Exec(hide, slug); err != nil {
+				t.Fatalf("failed to hide article: %v", err)
+			}
+
+			if _, err := service.CreateComment(ctx, slug, otherID, &domain.CreateCommentInput{Body: "After"}); err != domain.ErrArticleNotFound {
+				t.Errorf("CreateComment: expected ErrArticleNotFound, got %v", err)
+			}
+			if _, _, err := service.GetCommentsByArticleSlug(ctx, slug, nil, &otherID); err != domain.ErrArticleNotFound {
+				t.Errorf("GetCommentsByArticleSlug: expected ErrArticleNotFound, got %v", err)
+			}
+			if _, _, err := service.GetCommentsByArticleSlug(ctx, slug, nil, nil); err != domain.ErrArticleNotFound {
+				t.Errorf("GetCommentsByArticleSlug anonymous: expected ErrArticleNotFound, got %v", err)
+			}
+			if _, err := service.ReportComment(ctx, slug, comment.ID, otherID, &domain.ReportCommentInput{}); err != domain.ErrArticleNotFound {
+				t.Errorf("ReportComment: expected ErrArticleNotFound, got %v", err)
+			}
+
+			// The article's author still sees and adds comments
+			comments, _, err := service.GetCommentsByArticleSlug(ctx, slug, nil, &authorID)
+			if err != nil || len(comments) != 1 {
+				t.Errorf("expected author to list 1 comment, got %d (%v)", len(comments), err)
+			}
+			if _, err := service.CreateComment(ctx, slug, authorID, &domain.CreateCommentInput{Body: "Note"}); err != nil {
+				t.Errorf("expected author to comment, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCommentService_CommentsDisabled(t *testing.T) {
+	t.Run("rejects new comments when disabled but keeps existing ones visible", func(t *testing.T) {
+		service, db := newTestCommentService(t)
+		defer db.Close()
+
+		userID := createCommentTestUser(t, db, "testuser", "test@example.com")
+		slug := createCommentTestArticle(t, db, userID, "test-article", "Test Article")
+		ctx := context.Background()
+
+		if _, err := service.CreateComment(ctx, slug, userID, &domain.CreateCommentInput{Body: "Before"}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		articleRepo := repository.NewSQLiteArticleRepository(db, newCommentTestLogger())
+		article, err := articleRepo.GetArticleBySlug(ctx, slug)
+		if err != nil {
+			t.Fatalf("failed to load article: %v", err)
+		}
+		if err := articleRepo.SetCommentsEnabled(ctx, article.ID, false); err != nil {
+			t.Fatalf("failed to disable comments: %v", err)
+		}
+
+		_, err = service.CreateComment(ctx, slug, userID, &domain.CreateCommentInput{Body: "After"})
+		if err != domain.ErrCommentsDisabled {
+			t.Errorf("expected ErrCommentsDisabled, got %v", err)
+		}
+
+		comments, _, err := service.GetCommentsByArticleSlug(ctx, slug, nil, nil)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(comments) != 1 {
+			t.Errorf("expected existing comment to remain visible, got %d comments", len(comments))
+		}
+	})
+}
+
+func TestCommentService_Cooldown(t *testing.T) {
+	t.Run("rejects rapid comments", func(t *testing.T) {
+		service, db := newTestCommentService(t)
+		defer db.Close()
+		service.SetConfig(CommentServiceConfig{MinInterval: time.Minute})
+
+		userID := createCommentTestUser(t, db, "testuser", "test@example.com")
+		first := createCommentTestArticle(t, db, userID, "first-article", "First Article")
+		second := createCommentTestArticle(t, db, userID, "second-article", "Second Article")
+		ctx := context.Background()
+
+		if _, err := service.CreateComment(ctx, first, userID, &domain.CreateCommentInput{Body: "First"}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		// The cooldown applies across articles
+		_, err := service.CreateComment(ctx, second, userID, &domain.CreateCommentInput{Body: "Too soon"})
+		if err != domain.ErrCommentTooSoon {
+			t.Errorf("expected ErrCommentTooSoon, got %v", err)
+		}
+
+		// Other users are not affected
+		otherID := createCommentTestUser(t, db, "otheruser", "other@example.com")
+		if _, err := service.CreateComment(ctx, second, otherID, &domain.CreateCommentInput{Body: "Mine"}); err != nil {
+			t.Errorf("expected other user to comment, got %v", err)
+		}
+	})
+
+	t.Run("allows spaced comments", func(t *testing.T) {
+		service, db := newTestCommentService(t)
+		defer db.Close()
+		service.SetConfig(CommentServiceConfig{MinInterval: time.Minute})
+
+		userID := createCommentTestUser(t, db, "testuser", "test@example.com")
+		slug := createCommentTestArticle(t, db, userID, "test-article", "Test Article")
+
+		old := time.Now().Add(-2 * time.Minute)
+		_, err := db.Exec(`
+			INSERT INTO comments (body, article_id, author_id, created_at, updated_at)
+			VALUES ('Earlier', 1, ?, ?, ?)
+		`, userID, old, old)
+		if err != nil {
+			t.Fatalf("failed to create earlier comment: %v", err)
+		}
+
+		if _, err := service.CreateComment(context.Background(), slug, userID, &domain.CreateCommentInput{Body: "Later"}); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		service, db := newTestCommentService(t)
+		defer db.Close()
+
+		userID := createCommentTestUser(t, db, "testuser", "test@example.com")
+		slug := createCommentTestArticle(t, db, userID, "test-article", "Test Article")
+		ctx := context.Background()
+
+		for _, body := range []string{"One", "Two", "Three"} {
+			if _, err := service.CreateComment(ctx, slug, userID, &domain.CreateCommentInput{Body: body}); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		}
+	})
+}
+
 // =============================================================================
 // GetCommentsByArticleSlug Tests
 // =============================================================================
@@ -302,7 +513,7 @@ func TestCommentService_GetCommentsByArticleSlug(t *testing.T) {
 			service.CreateComment(ctx, slug, authorID, input)
 		}
 
-		comments, err := service.GetCommentsByArticleSlug(ctx, slug)
+		comments, _, err := service.GetCommentsByArticleSlug(ctx, slug, nil, nil)
 		if err != nil {
 			t.Fatalf("expected no error, got %v", err)
 		}
@@ -319,7 +530,7 @@ func TestCommentService_GetCommentsByArticleSlug(t *testing.T) {
 		slug := createCommentTestArticle(t, db, authorID, "test-article", "Test Article")
 		ctx := context.Background()
 
-		comments, err := service.GetCommentsByArticleSlug(ctx, slug)
+		comments, _, err := service.GetCommentsByArticleSlug(ctx, slug, nil, nil)
 		if err != nil {
 			t.Fatalf("expected no error, got %v", err)
 		}
@@ -334,11 +545,129 @@ func TestCommentService_GetCommentsByArticleSlug(t *testing.T) {
 
 		ctx := context.Background()
 
-		_, err := service.GetCommentsByArticleSlug(ctx, "non-existent-slug")
+		_, _, err := service.GetCommentsByArticleSlug(ctx, "non-existent-slug", nil, nil)
 		if err != domain.ErrArticleNotFound {
 			t.Errorf("expected ErrArticleNotFound, got %v", err)
 		}
 	})
+
+	t.Run("batch loads authors and following status", func(t *testing.T) {
+		db := setupCommentTestDB(t)
+		defer db.Close()
+
+		logger := newCommentTestLogger()
+		userRepo := &countingUserRepository{UserRepository: repository.NewSQLiteUserRepository(db, logger)}
+		followRepo := &countingFollowRepository{FollowRepository: repository.NewSQLiteFollowRepository(db, logger)}
+		service := NewCommentService(
+			repository.NewSQLiteCommentRepository(db, logger),
+			repository.NewSQLiteArticleRepository(db, logger),
+			userRepo,
+			followRepo,
+			logger,
+		)
+
+		readerID := createCommentTestUser(t, db, "reader", "reader@example.com")
+		authorIDs := []int64{
+			createCommentTestUser(t, db, "alice", "alice@example.com"),
+			createCommentTestUser(t, db, "bob", "bob@example.com"),
+			createCommentTestUser(t, db, "carol", "carol@example.com"),
+		}
+		slug := createCommentTestArticle(t, db, authorIDs[0], "test-article", "Test Article")
+		ctx := context.Background()
+
+		if _, err := db.Exec(`INSERT INTO follows (follower_id, following_id) VALUES (?, ?)`, readerID, authorIDs[1]); err != nil {
+			t.Fatalf("failed to follow: %v", err)
+		}
+
+		for i := 0; i < 30; i++ {
+			input := &domain.CreateCommentInput{Body: "Comment"}
+			if _, err := service.CreateComment(ctx, slug, authorIDs[i%3], input); err != nil {
+				t.Fatalf("failed to create comment: %v", err)
+			}
+		}
+		userRepo.calls = 0
+
+		comments, _, err := service.GetCommentsByArticleSlug(ctx, slug, &domain.CommentListParams{Limit: 30}, &readerID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(comments) != 30 {
+			t.Fatalf("expected 30 comments, got %d", len(comments))
+		}
+
+		usernames := map[int64]string{authorIDs[0]: "alice", authorIDs[1]: "bob", authorIDs[2]: "carol"}
+		for _, comment := range comments {
+			if comment.Author == nil || comment.Author.Username != usernames[comment.AuthorID] {
+				t.Fatalf("expected author %s for comment %d, got %+v", usernames[comment.AuthorID], comment.ID, comment.Author)
+			}
+			if want := comment.AuthorID == authorIDs[1]; comment.AuthorFollowing != want {
+				t.Errorf("expected following %v for %s, got %v", want, comment.Author.Username, comment.AuthorFollowing)
+			}
+		}
+
+		if userRepo.calls != 1 {
+			t.Errorf("expected 1 user query, got %d", userRepo.calls)
+		}
+		if followRepo.calls != 1 {
+			t.Errorf("expected 1 follow query, got %d", followRepo.calls)
+		}
+	})
+}
+
+func TestCommentService_GetCommentsByArticleSlug_Pagination(t *testing.T) {
+	service, db := newTestCommentService(t)
+	defer db.Close()
+
+	authorID := createCommentTestUser(t, db, "author", "author@example.com")
+	slug := createCommentTestArticle(t, db, authorID, "test-article", "Test Article")
+	emptySlug := createCommentTestArticle(t, db, authorID, "empty-article", "Empty Article")
+	ctx := context.Background()
+
+	for i := 0; i < 25; i++ {
+		if _, err := service.CreateComment(ctx, slug, authorID, &domain.CreateCommentInput{Body: "Comment"}); err != nil {
+			t.Fatalf("failed to create comment: %v", err)
+		}
+	}
+
+	t.Run("defaults to 20 per page", func(t *testing.T) {
+		comments, total, err := service.GetCommentsByArticleSlug(ctx, slug, nil, nil)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(comments) != 20 || total != 25 {
+			t.Errorf("expected 20 of 25 comments, got %d of %d", len(comments), total)
+		}
+	})
+
+	t.Run("returns the remainder past the offset", func(t *testing.T) {
+		comments, total, err := service.GetCommentsByArticleSlug(ctx, slug, &domain.CommentListParams{Limit: 20, Offset: 20}, nil)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(comments) != 5 || total != 25 {
+			t.Errorf("expected 5 of 25 comments, got %d of %d", len(comments), total)
+		}
+	})
+
+	t.Run("caps the limit at 100", func(t *testing.T) {
+		params := &domain.CommentListParams{Limit: 500}
+		if _, _, err := service.GetCommentsByArticleSlug(ctx, slug, params, nil); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if params.Limit != 100 {
+			t.Errorf("expected limit 100, got %d", params.Limit)
+		}
+	})
+
+	t.Run("returns an empty page for an article without comments", func(t *testing.T) {
+		comments, total, err := service.GetCommentsByArticleSlug(ctx, emptySlug, nil, nil)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if comments == nil || len(comments) != 0 || total != 0 {
+			t.Errorf("expected an empty non-nil page, got %v (total %d)", comments, total)
+		}
+	})
 }
 
 // =============================================================================
@@ -365,7 +694,7 @@ func TestCommentService_DeleteComment(t *testing.T) {
 		}
 
 		// Verify deletion
-		comments, _ := service.GetCommentsByArticleSlug(ctx, slug)
+		comments, _, _ := service.GetCommentsByArticleSlug(ctx, slug, nil, nil)
 		if len(comments) != 0 {
 			t.Error("expected comment to be deleted")
 		}
@@ -418,3 +747,201 @@ func TestCommentService_DeleteComment(t *testing.T) {
 		}
 	})
 }
+
+func TestCommentService_UpdateComment(t *testing.T) {
+	t.Run("successfully updates own comment", func(t *testing.T) {
+		service, db := newTestCommentService(t)
+		defer db.Close()
+
+		authorID := createCommentTestUser(t, db, "author", "author@example.com")
+		slug := createCommentTestArticle(t, db, authorID, "test-article", "Test Article")
+		ctx := context.Background()
+
+		comment, _ := service.CreateComment(ctx, slug, authorID, &domain.CreateCommentInput{Body: "Original"})
+
+		updated, err := service.UpdateComment(ctx, slug, comment.ID, authorID, &domain.UpdateCommentInput{Body: "  Edited  "})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if updated.Body != "Edited" {
+			t.Errorf("expected trimmed body %q, got %q", "Edited", updated.Body)
+		}
+		if updated.Author == nil || updated.Author.ID != authorID {
+			t.Error("expected author to be loaded")
+		}
+	})
+
+	t.Run("fails when non-author tries to update", func(t *testing.T) {
+		service, db := newTestCommentService(t)
+		defer db.Close()
+
+		authorID := createCommentTestUser(t, db, "author", "author@example.com")
+		otherUserID := createCommentTestUser(t, db, "other", "other@example.com")
+		slug := createCommentTestArticle(t, db, authorID, "test-article", "Test Article")
+		ctx := context.Background()
+
+		comment, _ := service.CreateComment(ctx, slug, authorID, &domain.CreateCommentInput{Body: "Protected comment"})
+
+		_, err := service.UpdateComment(ctx, slug, comment.ID, otherUserID, &domain.UpdateCommentInput{Body: "Hijacked"})
+		if err != domain.ErrForbidden {
+			t.Errorf("expected ErrForbidden, got %v", err)
+		}
+	})
+
+	t.Run("fails with a blank body", func(t *testing.T) {
+		service, db := newTestCommentService(t)
+		defer db.Close()
+
+		authorID := createCommentTestUser(t, db, "author", "author@example.com")
+		slug := createCommentTestArticle(t, db, authorID, "test-article", "Test Article")
+		ctx := context.Background()
+
+		comment, _ := service.CreateComment(ctx, slug, authorID, &domain.CreateCommentInput{Body: "Original"})
+
+		_, err := service.UpdateComment(ctx, slug, comment.ID, authorID, &domain.UpdateCommentInput{Body: "   "})
+		if _, ok := err.(*domain.ValidationErrors); !ok {
+			t.Errorf("expected ValidationErrors, got %v", err)
+		}
+	})
+
+	t.Run("fails for a comment on another article", func(t *testing.T) {
+		service, db := newTestCommentService(t)
+		defer db.Close()
+
+		authorID := createCommentTestUser(t, db, "author", "author@example.com")
+		slug := createCommentTestArticle(t, db, authorID, "test-article", "Test Article")
+		otherSlug := createCommentTestArticle(t, db, authorID, "other-article", "Other Article")
+		ctx := context.Background()
+
+		comment, _ := service.CreateComment(ctx, otherSlug, authorID, &domain.CreateCommentInput{Body: "Elsewhere"})
+
+		_, err := service.UpdateComment(ctx, slug, comment.ID, authorID, &domain.UpdateCommentInput{Body: "Edited"})
+		if err != domain.ErrCommentNotFound {
+			t.Errorf("expected ErrCommentNotFound, got %v", err)
+		}
+	})
+}
+
+// =============================================================================
+// ReportComment Tests
+// =============================================================================
+
+func TestCommentService_ReportComment(t *testing.T) {
+	t.Run("reporting twice keeps a single report", func(t *testing.T) {
+		service, db := newTestCommentService(t)
+		defer db.Close()
+
+		authorID := createCommentTestUser(t, db, "author", "author@example.com")
+		reporterID := createCommentTestUser(t, db, "reporter", "reporter@example.com")
+		slug := createCommentTestArticle(t, db, authorID, "test-article", "Test Article")
+		ctx := context.Background()
+
+		comment, _ := service.CreateComment(ctx, slug, authorID, &domain.CreateCommentInput{Body: "Offensive"})
+
+		first, err := service.ReportComment(ctx, slug, comment.ID, reporterID, &domain.ReportCommentInput{Reason: "  spam  "})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if first.Reason != "spam" {
+			t.Errorf("expected trimmed reason %q, got %q", "spam", first.Reason)
+		}
+
+		second, err := service.ReportComment(ctx, slug, comment.ID, reporterID, &domain.ReportCommentInput{})
+		if err != nil {
+			t.Fatalf("expected duplicate report to succeed, got %v", err)
+		}
+		if second.ID != first.ID {
+			t.Errorf("expected existing report %d, got %d", first.ID, second.ID)
+		}
+
+		var count int
+		db.QueryRow(`SELECT COUNT(*) FROM comment_reports`).Scan(&count)
+		if count != 1 {
+			t.Errorf("expected 1 report row, got %d", count)
+		}
+	})
+
+	t.Run("fails for a missing comment", func(t *testing.T) {
+		service, db := newTestCommentService(t)
+		defer db.Close()
+
+		authorID := createCommentTestUser(t, db, "author", "author@example.com")
+		slug := createCommentTestArticle(t, db, authorID, "test-article", "Test Article")
+
+		_, err := service.ReportComment(context.Background(), slug, 99999, authorID, &domain.ReportCommentInput{})
+		if err != domain.ErrCommentNotFound {
+			t.Errorf("expected ErrCommentNotFound, got %v", err)
+		}
+	})
+
+	t.Run("fails for a missing article", func(t *testing.T) {
+		service, db := newTestCommentService(t)
+		defer db.Close()
+
+		userID := createCommentTestUser(t, db, "reporter", "reporter@example.com")
+
+		_, err := service.ReportComment(context.Background(), "no-such-article", 1, userID, &domain.ReportCommentInput{})
+		if err != domain.ErrArticleNotFound {
+			t.Errorf("expected ErrArticleNotFound, got %v", err)
+		}
+	})
+
+	t.Run("only maintainers can list reports", func(t *testing.T) {
+		service, db := newTestCommentService(t)
+		defer db.Close()
+
+		maintainerID := createCommentTestUser(t, db, "maintainer", "maintainer@example.com")
+		userID := createCommentTestUser(t, db, "user", "user@example.com")
+		config := DefaultCommentServiceConfig()
+		config.MaintainerIDs = []int64{maintainerID}
+		service.SetConfig(config)
+		ctx := context.Background()
+
+		if _, _, err := service.ListReports(ctx, userID, 20, 0); err != domain.ErrForbidden {
+			t.Errorf("expected ErrForbidden, got %v", err)
+		}
+		if _, _, err := service.ListReports(ctx, maintainerID, 20, 0); err != nil {
+			t.Errorf("expected no error for maintainer, got %v", err)
+		}
+	})
+}
+
+// =============================================================================
+// MinAccountAge Tests
+// =============================================================================
+
+func TestCommentService_MinAccountAge(t *testing.T) {
+	t.Run("blocks an account younger than the minimum age", func(t *testing.T) {
+		service, db := newTestCommentService(t)
+		defer db.Close()
+
+		service.SetConfig(CommentServiceConfig{MinAccountAge: time.Hour})
+
+		authorID := createCommentTestUser(t, db, "author", "author@example.com")
+		commenterID := createCommentTestUser(t, db, "newbie", "newbie@example.com")
+		slug := createCommentTestArticle(t, db, authorID, "test-article", "Test Article")
+
+		_, err := service.CreateComment(context.Background(), slug, commenterID, &domain.CreateCommentInput{Body: "Hi"})
+		if err != domain.ErrAccountTooNew {
+			t.Errorf("expected ErrAccountTooNew, got %v", err)
+		}
+	})
+
+	t.Run("allows an account older than the minimum age", func(t *testing.T) {
+		service, db := newTestCommentService(t)
+		defer db.Close()
+
+		service.SetConfig(CommentServiceConfig{MinAccountAge: time.Hour})
+
+		authorID := createCommentTestUser(t, db, "author", "author@example.com")
+		commenterID := createCommentTestUser(t, db, "veteran", "veteran@example.com")
+		slug := createCommentTestArticle(t, db, authorID, "test-article", "Test Article")
+		if _, err := db.Exec("UPDATE users SET created_at = ? WHERE id = ?", time.Now().Add(-2*time.Hour), commenterID); err != nil {
+			t.Fatalf("failed to backdate user: %v", err)
+		}
+
+		if _, err := service.CreateComment(context.Background(), slug, commenterID, &domain.CreateCommentInput{Body: "Hi"}); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	})
+}
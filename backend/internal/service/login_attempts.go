@@ -0,0 +1,106 @@
+package service
+
+import (
+	"sync"
+	"time"
+)
+
+// Default login lockout policy
+const (
+	DefaultMaxLoginFailures   = 5
+	DefaultLoginFailureWindow = 15 * time.Minute
+)
+
+// LoginAttemptTracker counts failed logins per account so repeated failures
+// can be locked out. Keys are normalized emails.
+type LoginAttemptTracker interface {
+	// LockedFor returns how long key is locked out, or 0 if it may try to log in
+	LockedFor(key string) time.Duration
+	// RecordFailure counts a failed login for key
+	RecordFailure(key string)
+	// Reset clears key's failures after a successful login
+	Reset(key string)
+}
+
+// loginFailures tracks one key's failures in the current window
+type loginFailures struct {
+	start time.Time
+	count int
+}
+
+// MemoryLoginAttemptTracker is an in-process LoginAttemptTracker. A key is
+// locked out once it reaches maxFailures within a fixed window starting at
+// its first failure, until that window ends. Counts are per process, so
+// several instances each enforce their own limit.
+type MemoryLoginAttemptTracker struct {
+	mu          sync.Mutex
+	maxFailures int
+	window      time.Duration
+	keys        map[string]*loginFailures
+	lastSweep   time.Time
+	now         func() time.Time
+}
+
+// NewMemoryLoginAttemptTracker creates an in-memory tracker
+func NewMemoryLoginAttemptTracker(maxFailures int, window time.Duration) *MemoryLoginAttemptTracker {
+	return newMemoryLoginAttemptTrackerWithClock(maxFailures, window, time.Now)
+}
+
+func newMemoryLoginAttemptTrackerWithClock(maxFailures int, window time.Duration, now func() time.Time) *MemoryLoginAttemptTracker {
+	return &MemoryLoginAttemptTracker{
+		maxFailures: maxFailures,
+		window:      window,
+		keys:        make(map[string]*loginFailures),
+		lastSweep:   now(),
+		now:         now,
+	}
+}
+
+// LockedFor implements LoginAttemptTracker
+func (t *MemoryLoginAttemptTracker) LockedFor(key string) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	f, ok := t.keys[key]
+	if !ok || now.Sub(f.start) >= t.window || f.count < t.maxFailures {
+		return 0
+	}
+	return f.start.Add(t.window).Sub(now)
+}
+
+// RecordFailure implements LoginAttemptTracker
+func (t *MemoryLoginAttemptTracker) RecordFailure(key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	t.sweep(now)
+
+	f, ok := t.keys[key]
+	if !ok || now.Sub(f.start) >= t.window {
+		t.keys[key] = &loginFailures{start: now, count: 1}
+		return
+	}
+	f.count++
+}
+
+// Reset implements LoginAttemptTracker
+func (t *MemoryLoginAttemptTracker) Reset(key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.keys, key)
+}
+
+// sweep drops expired windows so abandoned keys don't accumulate in memory
+func (t *MemoryLoginAttemptTracker) sweep(now time.Time) {
+	if now.Sub(t.lastSweep) < t.window {
+		return
+	}
+	for key, f := range t.keys {
+		if now.Sub(f.start) >= t.window {
+			delete(t.keys, key)
+		}
+	}
+	t.lastSweep = now
+}
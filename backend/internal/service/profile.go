@@ -5,6 +5,7 @@ import (
 	"log/slog"
 
 	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
+	"github.com/alexlee0213/realworld-conduit/backend/internal/events"
 	"github.com/alexlee0213/realworld-conduit/backend/internal/repository"
 )
 
@@ -12,6 +13,8 @@ import (
 type ProfileService struct {
 	userRepo   repository.UserRepository
 	followRepo repository.FollowRepository
+	blockRepo  repository.BlockRepository
+	eventBus   *events.Bus
 	logger     *slog.Logger
 }
 
@@ -19,15 +22,22 @@ type ProfileService struct {
 func NewProfileService(
 	userRepo repository.UserRepository,
 	followRepo repository.FollowRepository,
+	blockRepo repository.BlockRepository,
 	logger *slog.Logger,
 ) *ProfileService {
 	return &ProfileService{
 		userRepo:   userRepo,
 		followRepo: followRepo,
+		blockRepo:  blockRepo,
 		logger:     logger,
 	}
 }
 
+// SetEventBus sets the bus that follow changes are published to
+func (s *ProfileService) SetEventBus(bus *events.Bus) {
+	s.eventBus = bus
+}
+
 // GetProfileByUsername retrieves a user's profile by username
 // currentUserID is optional - if provided, the following status will be included
 func (s *ProfileService) GetProfileByUsername(ctx context.Context, username string, currentUserID *int64) (*domain.Profile, error) {
@@ -52,7 +62,7 @@ func (s *ProfileService) GetProfileByUsername(ctx context.Context, username stri
 		}
 	}
 
-	return domain.NewProfileFromUser(user, following), nil
+	return s.profileWithCounts(ctx, user, following)
 }
 
 // FollowUser makes the current user follow the target user
@@ -71,6 +81,15 @@ func (s *ProfileService) FollowUser(ctx context.Context, followerID int64, usern
 		return nil, domain.ErrValidation
 	}
 
+	// A user can't follow someone who has blocked them
+	blocked, err := s.blockRepo.IsBlocked(ctx, targetUser.ID, followerID)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return nil, domain.ErrBlockedByUser
+	}
+
 	// Create follow relationship
 	if err := s.followRepo.FollowUser(ctx, followerID, targetUser.ID); err != nil {
 		return nil, err
@@ -82,8 +101,10 @@ func (s *ProfileService) FollowUser(ctx context.Context, followerID int64, usern
 		"following_id", targetUser.ID,
 	)
 
+	s.eventBus.Publish(ctx, events.Followed{FollowerID: followerID, FollowingID: targetUser.ID, Following: true})
+
 	// Return profile with following=true
-	return domain.NewProfileFromUser(targetUser, true), nil
+	return s.profileWithCounts(ctx, targetUser, true)
 }
 
 // UnfollowUser makes the current user unfollow the target user
@@ -105,6 +126,160 @@ func (s *ProfileService) UnfollowUser(ctx context.Context, followerID int64, use
 		"following_id", targetUser.ID,
 	)
 
+	s.eventBus.Publish(ctx, events.Followed{FollowerID: followerID, FollowingID: targetUser.ID, Following: false})
+
 	// Return profile with following=false
-	return domain.NewProfileFromUser(targetUser, false), nil
+	return s.profileWithCounts(ctx, targetUser, false)
+}
+
+// BlockUser makes the current user block the target user. The target's
+// articles are hidden from the blocker's listings and any follow of the
+// blocker by the target is removed.
+func (s *ProfileService) BlockUser(ctx context.Context, blockerID int64, username string) (*domain.Profile, error) {
+	targetUser, err := s.userRepo.GetUserByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	if blockerID == targetUser.ID {
+		errs := &domain.ValidationErrors{}
+		errs.Add("profile", "cannot block yourself")
+		return nil, errs
+	}
+
+	if err := s.blockRepo.Block(ctx, blockerID, targetUser.ID); err != nil {
+		return nil, err
+	}
+	if err := s.followRepo.UnfollowUser(ctx, targetUser.ID, blockerID); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("user blocked",
+		"blocker_id", blockerID,
+		"blocked_username", username,
+		"blocked_id", targetUser.ID,
+	)
+
+	return s.GetProfileByUsername(ctx, username, &blockerID)
+}
+
+// UnblockUser removes the current user's block of the target user
+func (s *ProfileService) UnblockUser(ctx context.Context, blockerID int64, username string) (*domain.Profile, error) {
+	targetUser, err := s.userRepo.GetUserByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.blockRepo.Unblock(ctx, blockerID, targetUser.ID); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("user unblocked",
+		"blocker_id", blockerID,
+		"blocked_username", username,
+		"blocked_id", targetUser.ID,
+	)
+
+	return s.GetProfileByUsername(ctx, username, &blockerID)
+}
+
+// profileWithCounts builds the profile of user including its follower and
+// following counts
+func (s *ProfileService) profileWithCounts(ctx context.Context, user *domain.User, following bool) (*domain.Profile, error) {
+	profile := domain.NewProfileFromUser(user, following)
+
+	followersCount, err := s.followRepo.CountFollowers(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	followingCount, err := s.followRepo.CountFollowing(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	profile.FollowersCount = followersCount
+	profile.FollowingCount = followingCount
+
+	return profile, nil
+}
+
+// ListFollowers returns a page of the profiles following username, most
+// recent follower first, and the total follower count. currentUserID is
+// optional - if provided, each profile's following flag is set for that user.
+func (s *ProfileService) ListFollowers(ctx context.Context, username string, currentUserID *int64, limit, offset int) ([]*domain.Profile, int, error) {
+	user, err := s.userRepo.GetUserByUsername(ctx, username)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	followerIDs, err := s.followRepo.GetFollowers(ctx, user.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return s.loadProfilesPage(ctx, followerIDs, currentUserID, limit, offset)
+}
+
+// ListFollowing returns a page of the profiles username follows, most recent
+// follow first, and the total count. currentUserID is optional - if provided,
+// each profile's following flag is set for that user.
+func (s *ProfileService) ListFollowing(ctx context.Context, username string, currentUserID *int64, limit, offset int) ([]*domain.Profile, int, error) {
+	user, err := s.userRepo.GetUserByUsername(ctx, username)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	followingIDs, err := s.followRepo.GetFollowing(ctx, user.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return s.loadProfilesPage(ctx, followingIDs, currentUserID, limit, offset)
+}
+
+// loadProfilesPage batch-loads the profiles for one page of ids, keeping
+// their order, and returns them with the total number of ids
+func (s *ProfileService) loadProfilesPage(ctx context.Context, ids []int64, currentUserID *int64, limit, offset int) ([]*domain.Profile, int, error) {
+	// Apply defaults if not set
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	total := len(ids)
+	page := ids[min(offset, total):min(offset+limit, total)]
+	if len(page) == 0 {
+		return []*domain.Profile{}, total, nil
+	}
+
+	users, err := s.userRepo.GetProfilesByIDs(ctx, page)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	following := make(map[int64]bool)
+	if currentUserID != nil && *currentUserID != 0 {
+		following, err = s.followRepo.IsFollowingBulk(ctx, *currentUserID, page)
+		if err != nil {
+			s.logger.Error("failed to check follow status",
+				"error", err,
+				"follower_id", *currentUserID,
+			)
+			// Don't fail the request, just log the error
+			following = make(map[int64]bool)
+		}
+	}
+
+	profiles := make([]*domain.Profile, 0, len(page))
+	for _, id := range page {
+		if user, ok := users[id]; ok {
+			profiles = append(profiles, domain.NewProfileFromUser(user, following[id]))
+		}
+	}
+
+	return profiles, total, nil
 }
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"os"
 	"testing"
+	"time"
 
 	_ "github.com/mattn/go-sqlite3"
 
@@ -23,6 +24,7 @@ func setupProfileTestDB(t *testing.T) *sql.DB {
 	}
 
 	// Drop existing tables for clean state
+	db.Exec("DROP TABLE IF EXISTS blocks")
 	db.Exec("DROP TABLE IF EXISTS follows")
 	db.Exec("DROP TABLE IF EXISTS users")
 
@@ -58,6 +60,21 @@ func setupProfileTestDB(t *testing.T) *sql.DB {
 		t.Fatalf("failed to create follows table: %v", err)
 	}
 
+	// Create blocks table
+	_, err = db.Exec(`
+		CREATE TABLE blocks (
+			blocker_id INTEGER NOT NULL,
+			blocked_id INTEGER NOT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (blocker_id, blocked_id),
+			FOREIGN KEY (blocker_id) REFERENCES users(id) ON DELETE CASCADE,
+			FOREIGN KEY (blocked_id) REFERENCES users(id) ON DELETE CASCADE
+		)
+	`)
+	if err != nil {
+		t.Fatalf("failed to create blocks table: %v", err)
+	}
+
 	return db
 }
 
@@ -73,8 +90,9 @@ func newTestProfileService(t *testing.T) (*ProfileService, *sql.DB) {
 	logger := newProfileTestLogger()
 	userRepo := repository.NewSQLiteUserRepository(db, logger)
 	followRepo := repository.NewSQLiteFollowRepository(db, logger)
+	blockRepo := repository.NewSQLiteBlockRepository(db, logger)
 
-	profileService := NewProfileService(userRepo, followRepo, logger)
+	profileService := NewProfileService(userRepo, followRepo, blockRepo, logger)
 	return profileService, db
 }
 
@@ -314,3 +332,252 @@ func TestProfileService_UnfollowUser(t *testing.T) {
 		}
 	})
 }
+
+// =============================================================================
+// ListFollowers Tests
+// =============================================================================
+
+func TestProfileService_ListFollowers(t *testing.T) {
+	t.Run("returns a page of followers with the viewer's following flags", func(t *testing.T) {
+		service, db := newTestProfileService(t)
+		defer db.Close()
+
+		targetID := createProfileTestUser(t, db, "target", "target@example.com")
+		viewerID := createProfileTestUser(t, db, "viewer", "viewer@example.com")
+		base := time.Now().Add(-time.Hour)
+		for i, name := range []string{"alice", "bob", "carol"} {
+			id := createProfileTestUser(t, db, name, name+"@example.com")
+			db.Exec("INSERT INTO follows (follower_id, following_id, created_at) VALUES (?, ?, ?)",
+				id, targetID, base.Add(time.Duration(i)*time.Minute))
+			if name == "bob" {
+				db.Exec("INSERT INTO follows (follower_id, following_id) VALUES (?, ?)", viewerID, id)
+			}
+		}
+		ctx := context.Background()
+
+		profiles, total, err := service.ListFollowers(ctx, "target", &viewerID, 2, 0)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if total != 3 {
+			t.Errorf("expected total 3, got %d", total)
+		}
+		if len(profiles) != 2 || profiles[0].Username != "carol" || profiles[1].Username != "bob" {
+			t.Fatalf("expected [carol bob], got %+v", profiles)
+		}
+		if profiles[0].Following || !profiles[1].Following {
+			t.Errorf("expected following flags [false true], got [%v %v]", profiles[0].Following, profiles[1].Following)
+		}
+
+		profiles, _, err = service.ListFollowers(ctx, "target", nil, 2, 2)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(profiles) != 1 || profiles[0].Username != "alice" {
+			t.Errorf("expected [alice] on the second page, got %+v", profiles)
+		}
+	})
+
+	t.Run("returns an empty list for a user with no followers", func(t *testing.T) {
+		service, db := newTestProfileService(t)
+		defer db.Close()
+
+		createProfileTestUser(t, db, "lonely", "lonely@example.com")
+
+		profiles, total, err := service.ListFollowers(context.Background(), "lonely", nil, 20, 0)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if profiles == nil || len(profiles) != 0 || total != 0 {
+			t.Errorf("expected an empty list, got %+v (total %d)", profiles, total)
+		}
+	})
+
+	t.Run("fails for non-existent user", func(t *testing.T) {
+		service, db := newTestProfileService(t)
+		defer db.Close()
+
+		_, _, err := service.ListFollowers(context.Background(), "nobody", nil, 20, 0)
+		if err != domain.ErrUserNotFound {
+			t.Errorf("expected ErrUserNotFound, got %v", err)
+		}
+	})
+}
+
+// =============================================================================
+// ListFollowing Tests
+// =============================================================================
+
+func TestProfileService_ListFollowing(t *testing.T) {
+	t.Run("returns the users followed", func(t *testing.T) {
+		service, db := newTestProfileService(t)
+		defer db.Close()
+
+		sourceID := createProfileTestUser(t, db, "source", "source@example.com")
+		for _, name := range []string{"alice", "bob"} {
+			id := createProfileTestUser(t, db, name, name+"@example.com")
+			db.Exec("INSERT INTO follows (follower_id, following_id) VALUES (?, ?)", sourceID, id)
+		}
+
+		profiles, total, err := service.ListFollowing(context.Background(), "source", &sourceID, 20, 0)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if total != 2 || len(profiles) != 2 {
+			t.Fatalf("expected 2 profiles, got %+v (total %d)", profiles, total)
+		}
+		for _, profile := range profiles {
+			if !profile.Following {
+				t.Errorf("expected %s to be followed by the viewer", profile.Username)
+			}
+		}
+	})
+
+	t.Run("fails for non-existent user", func(t *testing.T) {
+		service, db := newTestProfileService(t)
+		defer db.Close()
+
+		_, _, err := service.ListFollowing(context.Background(), "nobody", nil, 20, 0)
+		if err != domain.ErrUserNotFound {
+			t.Errorf("expected ErrUserNotFound, got %v", err)
+		}
+	})
+}
+
+// =============================================================================
+// Follow Count Tests
+// =============================================================================
+
+func TestProfileService_FollowCounts(t *testing.T) {
+	service, db := newTestProfileService(t)
+	defer db.Close()
+
+	aliceID := createProfileTestUser(t, db, "alice", "alice@example.com")
+	bobID := createProfileTestUser(t, db, "bob", "bob@example.com")
+	ctx := context.Background()
+
+	assertCounts := func(t *testing.T, profile *domain.Profile, followers, following int) {
+		t.Helper()
+		if profile.FollowersCount != followers || profile.FollowingCount != following {
+			t.Errorf("%s: expected followers=%d following=%d, got followers=%d following=%d",
+				profile.Username, followers, following, profile.FollowersCount, profile.FollowingCount)
+		}
+	}
+
+	profile, err := service.GetProfileByUsername(ctx, "bob", nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	assertCounts(t, profile, 0, 0)
+
+	// The follow response already reflects the new follower
+	profile, err = service.FollowUser(ctx, aliceID, "bob")
+	if err != nil {
+		t.Fatalf("failed to follow: %v", err)
+	}
+	assertCounts(t, profile, 1, 0)
+
+	profile, err = service.GetProfileByUsername(ctx, "alice", &bobID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	assertCounts(t, profile, 0, 1)
+
+	profile, err = service.FollowUser(ctx, bobID, "alice")
+	if err != nil {
+		t.Fatalf("failed to follow: %v", err)
+	}
+	assertCounts(t, profile, 1, 1)
+
+	profile, err = service.UnfollowUser(ctx, aliceID, "bob")
+	if err != nil {
+		t.Fatalf("failed to unfollow: %v", err)
+	}
+	assertCounts(t, profile, 0, 1)
+}
+
+// =============================================================================
+// Block Tests
+// =============================================================================
+
+func TestProfileService_BlockUser(t *testing.T) {
+	t.Run("rejects blocking yourself", func(t *testing.T) {
+		service, db := newTestProfileService(t)
+		defer db.Close()
+
+		userID := createProfileTestUser(t, db, "alice", "alice@example.com")
+
+		_, err := service.BlockUser(context.Background(), userID, "alice")
+		validationErrs, ok := err.(*domain.ValidationErrors)
+		if !ok {
+			t.Fatalf("expected ValidationErrors, got %v", err)
+		}
+		if validationErrs.Errors[0].Field != "profile" {
+			t.Errorf("expected profile field error, got %+v", validationErrs.Errors)
+		}
+	})
+
+	t.Run("blocked user can't follow the blocker", func(t *testing.T) {
+		service, db := newTestProfileService(t)
+		defer db.Close()
+
+		aliceID := createProfileTestUser(t, db, "alice", "alice@example.com")
+		bobID := createProfileTestUser(t, db, "bob", "bob@example.com")
+		ctx := context.Background()
+
+		if _, err := service.BlockUser(ctx, aliceID, "bob"); err != nil {
+			t.Fatalf("failed to block: %v", err)
+		}
+
+		if _, err := service.FollowUser(ctx, bobID, "alice"); err != domain.ErrBlockedByUser {
+			t.Errorf("expected ErrBlockedByUser, got %v", err)
+		}
+
+		// The blocker can still follow the user they blocked
+		if _, err := service.FollowUser(ctx, aliceID, "bob"); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+
+		if _, err := service.UnblockUser(ctx, aliceID, "bob"); err != nil {
+			t.Fatalf("failed to unblock: %v", err)
+		}
+		if _, err := service.FollowUser(ctx, bobID, "alice"); err != nil {
+			t.Errorf("expected follow to succeed after unblock, got %v", err)
+		}
+	})
+
+	t.Run("removes the blocked user's follow", func(t *testing.T) {
+		service, db := newTestProfileService(t)
+		defer db.Close()
+
+		aliceID := createProfileTestUser(t, db, "alice", "alice@example.com")
+		bobID := createProfileTestUser(t, db, "bob", "bob@example.com")
+		ctx := context.Background()
+
+		if _, err := service.FollowUser(ctx, bobID, "alice"); err != nil {
+			t.Fatalf("failed to follow: %v", err)
+		}
+		if _, err := service.BlockUser(ctx, aliceID, "bob"); err != nil {
+			t.Fatalf("failed to block: %v", err)
+		}
+
+		profile, err := service.GetProfileByUsername(ctx, "alice", &bobID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if profile.Following || profile.FollowersCount != 0 {
+			t.Errorf("expected follow removed, got following=%v followers=%d", profile.Following, profile.FollowersCount)
+		}
+	})
+
+	t.Run("fails for non-existent user", func(t *testing.T) {
+		service, db := newTestProfileService(t)
+		defer db.Close()
+
+		userID := createProfileTestUser(t, db, "alice", "alice@example.com")
+
+		if _, err := service.BlockUser(context.Background(), userID, "nobody"); err != domain.ErrUserNotFound {
+			t.Errorf("expected ErrUserNotFound, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// DefaultTagsCacheTTL is how long GetAllTags results are cached by default
+const DefaultTagsCacheTTL = 60 * time.Second
+
+// tagCache holds the GetAllTags result for a short TTL. Tags change rarely
+// while GET /api/tags is requested on every page load, so a slightly stale
+// list is an acceptable trade for skipping the query. The cache is per
+// process; writes made by other instances show up once the entry expires.
+type tagCache struct {
+	mu      sync.RWMutex
+	tags    []string
+	expires time.Time
+	// generation is bumped by invalidate so a load that raced with a write
+	// doesn't store the list it read before the write
+	generation uint64
+	now        func() time.Time
+}
+
+func newTagCache() *tagCache {
+	return &tagCache{now: time.Now}
+}
+
+// get returns a copy of the cached tags, if present and unexpired, along with
+// the generation to pass to set after loading them
+func (c *tagCache) get() ([]string, uint64, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.tags == nil || !c.now().Before(c.expires) {
+		return nil, c.generation, false
+	}
+	return slices.Clone(c.tags), c.generation, true
+}
+
+// set caches tags for ttl unless the cache was invalidated since generation
+// was read
+func (c *tagCache) set(tags []string, generation uint64, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+	c.tags = slices.Clone(tags)
+	c.expires = c.now().Add(ttl)
+}
+
+// containsAll reports whether every tag is in the cached list; an empty cache
+// contains nothing, so callers fall back to invalidating
+func (c *tagCache) containsAll(tags []string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.tags == nil {
+		return len(tags) == 0
+	}
+	for _, tag := range tags {
+		if !slices.Contains(c.tags, tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// invalidate drops the cached tags so the next read reloads them
+func (c *tagCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.tags = nil
+	c.generation++
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/alexlee0213/realworld-conduit/backend/internal/domain"
+	"github.com/alexlee0213/realworld-conduit/backend/internal/repository"
+	"github.com/alexlee0213/realworld-conduit/backend/internal/util"
+)
+
+// DefaultMaxOffset is the default cap on pagination offsets
+const DefaultMaxOffset = 10000
+
+// validateOffset rejects pagination offsets beyond maxOffset before any query
+// runs, so huge offsets can't force pointless scans (maxOffset 0 disables it)
+func validateOffset(offset, maxOffset int) error {
+	if maxOffset > 0 && offset > maxOffset {
+		validationErrors := domain.NewValidationErrors()
+		validationErrors.Add("offset", "offset too large")
+		return validationErrors
+	}
+	return nil
+}
+
+// collectValidationErrors merges the field errors from every check into one
+// ValidationErrors, so a client sees all problems with its input at once
+// instead of fixing them one request at a time. Any other error is returned
+// unchanged.
+func collectValidationErrors(checks ...error) error {
+	validationErrors := domain.NewValidationErrors()
+	for _, err := range checks {
+		if err == nil {
+			continue
+		}
+		var fieldErrors *domain.ValidationErrors
+		if !errors.As(err, &fieldErrors) {
+			return err
+		}
+		validationErrors.Errors = append(validationErrors.Errors, fieldErrors.Errors...)
+	}
+
+	if validationErrors.HasErrors() {
+		return validationErrors
+	}
+
+	return nil
+}
+
+// checkAccountAge returns ErrAccountTooNew if the user signed up less than
+// minAge ago (minAge 0 disables the check)
+func checkAccountAge(ctx context.Context, userRepo repository.UserRepository, userID int64, minAge time.Duration) error {
+	if minAge <= 0 {
+		return nil
+	}
+
+	user, err := userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if time.Since(user.CreatedAt) < minAge {
+		return domain.ErrAccountTooNew
+	}
+
+	return nil
+}
+
+// validateCoverImage rejects an explicitly provided cover image that isn't an
+// absolute http or https URL (empty means none, or one taken from the body)
+func validateCoverImage(coverImage string) error {
+	coverImage = strings.TrimSpace(coverImage)
+	if coverImage == "" {
+		return nil
+	}
+
+	u, err := url.Parse(coverImage)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		validationErrors := domain.NewValidationErrors()
+		validationErrors.Add("coverImage", "must be a valid http or https URL")
+		return validationErrors
+	}
+	return nil
+}
+
+// addHTMLError records a validation error if a plain-text field contains HTML
+func addHTMLError(validationErrors *domain.ValidationErrors, field, value string) {
+	if util.ContainsHTMLTag(value) {
+		validationErrors.Add(field, "must not contain HTML tags")
+	}
+}
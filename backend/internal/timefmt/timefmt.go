@@ -0,0 +1,26 @@
+// Package timefmt provides the timestamp format used in API responses
+package timefmt
+
+import (
+	"time"
+)
+
+// RFC3339Millis is the RealWorld API timestamp layout: UTC with millisecond
+// precision, e.g. "2024-01-02T15:04:05.000Z"
+const RFC3339Millis = "2006-01-02T15:04:05.000Z"
+
+// FormatRFC3339Millis formats t in UTC with exactly three fractional digits.
+// Sub-millisecond precision is truncated.
+func FormatRFC3339Millis(t time.Time) string {
+	return t.UTC().Format(RFC3339Millis)
+}
+
+// ParseRFC3339 parses an RFC 3339 timestamp with any (or no) fractional
+// seconds and any offset, returning the time in UTC
+func ParseRFC3339(value string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.UTC(), nil
+}
@@ -0,0 +1,105 @@
+package timefmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatRFC3339Millis(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    time.Time
+		expected string
+	}{
+		{
+			name:     "pads whole seconds to milliseconds",
+			input:    time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC),
+			expected: "2024-01-02T15:04:05.000Z",
+		},
+		{
+			name:     "truncates sub-millisecond precision",
+			input:    time.Date(2024, 1, 2, 15, 4, 5, 123987654, time.UTC),
+			expected: "2024-01-02T15:04:05.123Z",
+		},
+		{
+			name:     "converts to UTC",
+			input:    time.Date(2024, 1, 2, 18, 4, 5, 500000000, time.FixedZone("UTC+3", 3*60*60)),
+			expected: "2024-01-02T15:04:05.500Z",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatRFC3339Millis(tt.input); got != tt.expected {
+				t.Errorf("FormatRFC3339Millis() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseRFC3339(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected time.Time
+	}{
+		{
+			name:     "millisecond precision",
+			input:    "2024-01-02T15:04:05.123Z",
+			expected: time.Date(2024, 1, 2, 15, 4, 5, 123000000, time.UTC),
+		},
+		{
+			name:     "no fractional seconds",
+			input:    "2024-01-02T15:04:05Z",
+			expected: time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		{
+			name:     "nanosecond precision",
+			input:    "2024-01-02T15:04:05.123456789Z",
+			expected: time.Date(2024, 1, 2, 15, 4, 5, 123456789, time.UTC),
+		},
+		{
+			name:     "offset is normalized to UTC",
+			input:    "2024-01-02T18:04:05.000+03:00",
+			expected: time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRFC3339(tt.input)
+			if err != nil {
+				t.Fatalf("ParseRFC3339() error = %v", err)
+			}
+			if !got.Equal(tt.expected) || got.Location() != time.UTC {
+				t.Errorf("ParseRFC3339() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	t.Run("rejects invalid input", func(t *testing.T) {
+		for _, input := range []string{"", "2024-01-02", "not a time", "2024-01-02 15:04:05"} {
+			if _, err := ParseRFC3339(input); err == nil {
+				t.Errorf("ParseRFC3339(%q) expected error", input)
+			}
+		}
+	})
+}
+
+func TestRoundTrip(t *testing.T) {
+	original := time.Date(2024, 6, 30, 23, 59, 59, 999999999, time.UTC)
+
+	formatted := FormatRFC3339Millis(original)
+	parsed, err := ParseRFC3339(formatted)
+	if err != nil {
+		t.Fatalf("ParseRFC3339() error = %v", err)
+	}
+
+	// Round-tripping keeps millisecond precision
+	if want := original.Truncate(time.Millisecond); !parsed.Equal(want) {
+		t.Errorf("round trip = %v, want %v", parsed, want)
+	}
+	if again := FormatRFC3339Millis(parsed); again != formatted {
+		t.Errorf("second format = %q, want %q", again, formatted)
+	}
+}
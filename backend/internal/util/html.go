@@ -0,0 +1,16 @@
+package util
+
+import (
+	"regexp"
+)
+
+// htmlTagRegex matches sequences a browser would parse as markup: opening or
+// closing tags, comments, doctypes and processing instructions. A bare "<"
+// followed by whitespace or a digit (as in "a < b" or "x<3") is not a tag.
+var htmlTagRegex = regexp.MustCompile(`<[/!?]?[a-zA-Z][^<>]*>|<!--`)
+
+// ContainsHTMLTag reports whether s contains anything that looks like an HTML tag
+// Example: "<script>alert(1)</script>" -> true, "a < b" -> false
+func ContainsHTMLTag(s string) bool {
+	return htmlTagRegex.MatchString(s)
+}
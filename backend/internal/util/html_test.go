@@ -0,0 +1,62 @@
+package util
+
+import (
+	"testing"
+)
+
+func TestContainsHTMLTag(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{
+			name:     "script tag",
+			input:    "Hello <script>alert(1)</script>",
+			expected: true,
+		},
+		{
+			name:     "tag with attributes",
+			input:    `<img src=x onerror="alert(1)">`,
+			expected: true,
+		},
+		{
+			name:     "closing tag",
+			input:    "text</div>",
+			expected: true,
+		},
+		{
+			name:     "html comment",
+			input:    "before <!-- hidden",
+			expected: true,
+		},
+		{
+			name:     "less-than in prose",
+			input:    "Why a < b matters",
+			expected: false,
+		},
+		{
+			name:     "comparison with numbers",
+			input:    "x<3 and y>2",
+			expected: false,
+		},
+		{
+			name:     "arrow",
+			input:    "input -> output <- feedback",
+			expected: false,
+		},
+		{
+			name:     "plain text",
+			input:    "Just a regular title",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ContainsHTMLTag(tt.input); got != tt.expected {
+				t.Errorf("ContainsHTMLTag(%q) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
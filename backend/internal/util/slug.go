@@ -1,6 +1,8 @@
 package util
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"regexp"
 	"strings"
 	"unicode"
@@ -10,15 +12,29 @@ import (
 	"golang.org/x/text/unicode/norm"
 )
 
+// maxSlugSuffixAttempts bounds the numeric suffixes tried before falling back
+// to a random one
+const maxSlugSuffixAttempts = 50
+
 var (
-	// nonAlphanumericRegex matches any character that is not alphanumeric or dash
-	nonAlphanumericRegex = regexp.MustCompile(`[^a-z0-9-]+`)
+	// nonAlphanumericRegex matches any character that is not a letter, number or
+	// dash. Non-Latin letters (e.g. Hangul) are kept so such titles still slugify.
+	nonAlphanumericRegex = regexp.MustCompile(`[^\p{L}\p{N}-]+`)
 	// multipleDashRegex matches multiple consecutive dashes
 	multipleDashRegex = regexp.MustCompile(`-+`)
+	// validSlugRegex matches lowercase alphanumeric words joined by single dashes
+	validSlugRegex = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
 )
 
+// IsValidSlug reports whether a user-supplied slug is lowercase alphanumeric
+// words separated by single dashes, e.g. "my-first-post"
+func IsValidSlug(slug string) bool {
+	return validSlugRegex.MatchString(slug)
+}
+
 // GenerateSlug converts a title to a URL-friendly slug
-// Example: "Hello World" -> "hello-world"
+// Example: "Hello World" -> "hello-world", "안녕하세요 세계" -> "안녕하세요-세계"
+// Returns an empty string when the title has no letters or numbers.
 func GenerateSlug(title string) string {
 	if title == "" {
 		return ""
@@ -47,11 +63,12 @@ func GenerateSlug(title string) string {
 }
 
 // GenerateUniqueSlug generates a unique slug by checking against existing slugs
-// The checkExists function returns true if the slug already exists
+// The checkExists function returns true if the slug already exists.
+// Titles with nothing usable (emoji or punctuation only) get a random slug.
 func GenerateUniqueSlug(title string, checkExists func(slug string) bool) string {
 	baseSlug := GenerateSlug(title)
 	if baseSlug == "" {
-		return ""
+		baseSlug = "article-" + randomSuffix()
 	}
 
 	// If the base slug doesn't exist, use it
@@ -60,15 +77,20 @@ func GenerateUniqueSlug(title string, checkExists func(slug string) bool) string
 	}
 
 	// Try adding a numeric suffix
-	for i := 1; i < 1000; i++ {
+	for i := 1; i <= maxSlugSuffixAttempts; i++ {
 		candidateSlug := baseSlug + "-" + itoa(i)
 		if !checkExists(candidateSlug) {
 			return candidateSlug
 		}
 	}
 
-	// Fallback: add timestamp (should never happen in practice)
-	return baseSlug + "-" + randomSuffix()
+	// Fallback: add a random suffix (should be rare in practice)
+	for {
+		candidateSlug := baseSlug + "-" + randomSuffix()
+		if !checkExists(candidateSlug) {
+			return candidateSlug
+		}
+	}
 }
 
 // normalizeUnicode removes accents and normalizes unicode characters
@@ -92,9 +114,11 @@ func itoa(n int) string {
 	return string(digits)
 }
 
-// randomSuffix generates a short random suffix for edge cases
+// randomSuffix generates a short random hex suffix for edge cases
 func randomSuffix() string {
-	// Use a simple timestamp-based approach
-	// In production, you might use crypto/rand
-	return "unique"
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		panic("util: crypto/rand unavailable: " + err.Error())
+	}
+	return hex.EncodeToString(b)
 }
@@ -1,7 +1,9 @@
 package util
 
 import (
+	"strings"
 	"testing"
+	"unicode"
 )
 
 func TestGenerateSlug(t *testing.T) {
@@ -55,6 +57,16 @@ func TestGenerateSlug(t *testing.T) {
 			title:    "Café au Lait",
 			expected: "cafe-au-lait",
 		},
+		{
+			name:     "korean title",
+			title:    "안녕하세요 세계",
+			expected: "안녕하세요-세계",
+		},
+		{
+			name:     "emoji only",
+			title:    "🎉🚀",
+			expected: "",
+		},
 		{
 			name:     "empty title",
 			title:    "",
@@ -147,3 +159,83 @@ func TestGenerateUniqueSlug(t *testing.T) {
 		})
 	}
 }
+
+func TestIsValidSlug(t *testing.T) {
+	tests := map[string]bool{
+		"hello-world":   true,
+		"post-2024":     true,
+		"a":             true,
+		"":              false,
+		"Hello-World":   false,
+		"hello_world":   false,
+		"hello world":   false,
+		"-hello":        false,
+		"hello-":        false,
+		"hello--world":  false,
+		"héllo":         false,
+		"hello/world":   false,
+		"../etc/passwd": false,
+	}
+
+	for slug, want := range tests {
+		if got := IsValidSlug(slug); got != want {
+			t.Errorf("IsValidSlug(%q) = %v, want %v", slug, got, want)
+		}
+	}
+}
+
+func TestGenerateUniqueSlug_RandomFallback(t *testing.T) {
+	// Every numeric suffix is taken, so a random suffix must be used
+	checkExists := func(slug string) bool {
+		if slug == "hello-world" {
+			return true
+		}
+		for i := 1; i <= maxSlugSuffixAttempts; i++ {
+			if slug == "hello-world-"+itoa(i) {
+				return true
+			}
+		}
+		return false
+	}
+
+	result := GenerateUniqueSlug("Hello World", checkExists)
+	if checkExists(result) {
+		t.Fatalf("GenerateUniqueSlug() = %q, but slug already exists", result)
+	}
+	if !IsValidSlug(result) || len(result) != len("hello-world-")+8 {
+		t.Errorf("GenerateUniqueSlug() = %q, want hello-world- followed by 8 hex characters", result)
+	}
+}
+
+func TestGenerateUniqueSlug_NonASCII(t *testing.T) {
+	tests := []struct {
+		name       string
+		title      string
+		wantPrefix string
+	}{
+		{name: "korean", title: "안녕하세요 세계", wantPrefix: "안녕하세요-세계"},
+		{name: "emoji only", title: "🎉🚀✨", wantPrefix: "article-"},
+		{name: "punctuation only", title: "!@#$%^&*()", wantPrefix: "article-"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := GenerateUniqueSlug(tt.title, func(string) bool { return false })
+
+			if result == "" {
+				t.Fatalf("GenerateUniqueSlug(%q) returned an empty slug", tt.title)
+			}
+			if !strings.HasPrefix(result, tt.wantPrefix) {
+				t.Errorf("GenerateUniqueSlug(%q) = %q, want prefix %q", tt.title, result, tt.wantPrefix)
+			}
+			if strings.HasPrefix(result, "-") || strings.HasSuffix(result, "-") {
+				t.Errorf("GenerateUniqueSlug(%q) = %q, has a leading or trailing dash", tt.title, result)
+			}
+			for _, r := range result {
+				if r != '-' && !unicode.IsLetter(r) && !unicode.IsNumber(r) {
+					t.Errorf("GenerateUniqueSlug(%q) = %q, contains URL-unsafe character %q", tt.title, result, r)
+				}
+			}
+		})
+	}
+}